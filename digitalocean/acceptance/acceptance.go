@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/sweep"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/mockapi"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +23,8 @@ var (
 	TestAccProvider          *schema.Provider
 	TestAccProviders         map[string]*schema.Provider
 	TestAccProviderFactories map[string]func() (*schema.Provider, error)
+
+	mockAPIOnce sync.Once
 )
 
 func init() {
@@ -35,6 +40,10 @@ func init() {
 }
 
 func TestAccPreCheck(t *testing.T) {
+	if os.Getenv("DIGITALOCEAN_MOCK") != "" {
+		startMockAPI()
+	}
+
 	if v := os.Getenv("DIGITALOCEAN_TOKEN"); v == "" {
 		t.Fatal("DIGITALOCEAN_TOKEN must be set for acceptance tests")
 	}
@@ -45,8 +54,19 @@ func TestAccPreCheck(t *testing.T) {
 	}
 }
 
+// startMockAPI points the provider at an in-memory mock of the API. The mock
+// is shared by all tests in the package and lives until the test binary exits.
+func startMockAPI() {
+	mockAPIOnce.Do(func() {
+		server := mockapi.NewServer()
+		os.Setenv("DIGITALOCEAN_API_URL", server.URL)
+		os.Setenv("DIGITALOCEAN_TOKEN", "mock-token")
+		os.Setenv("DIGITALOCEAN_HTTP_RETRY_MAX", "0")
+	})
+}
+
 func RandomTestName(additionalNames ...string) string {
-	prefix := TestNamePrefix
+	prefix := sweep.NamePrefix()
 	for _, n := range additionalNames {
 		prefix += "-" + strings.Replace(n, " ", "_", -1)
 	}
@@ -113,7 +113,7 @@ func takeSnapshotOfDroplet(snapName string, intSuffix int, droplet *godo.Droplet
 	if err != nil {
 		return err
 	}
-	util.WaitForAction(client, action)
+	util.WaitForAction(context.Background(), client, action)
 	return nil
 }
 
@@ -55,7 +55,7 @@ func DataSourceDigitalOceanAccount() *schema.Resource {
 func dataSourceDigitalOceanAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	account, _, err := client.Account.Get(context.Background())
+	account, _, err := client.Account.Get(ctx)
 	if err != nil {
 		return diag.Errorf("Error retrieving account: %s", err)
 	}
@@ -356,29 +356,34 @@ func appSpecHealthCheckSchema() map[string]*schema.Schema {
 			Description: "The route path used for the HTTP health check ping.",
 		},
 		"initial_delay_seconds": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The number of seconds to wait before beginning health checks.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The number of seconds to wait before beginning health checks.",
+			ValidateFunc: validation.IntBetween(0, 3600),
 		},
 		"period_seconds": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The number of seconds to wait between health checks.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The number of seconds to wait between health checks.",
+			ValidateFunc: validation.IntBetween(1, 300),
 		},
 		"timeout_seconds": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The number of seconds after which the check times out.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The number of seconds after which the check times out.",
+			ValidateFunc: validation.IntBetween(1, 120),
 		},
 		"success_threshold": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The number of successful health checks before considered healthy.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The number of successful health checks before considered healthy.",
+			ValidateFunc: validation.IntBetween(1, 50),
 		},
 		"failure_threshold": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The number of failed health checks before considered unhealthy.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The number of failed health checks before considered unhealthy.",
+			ValidateFunc: validation.IntBetween(1, 50),
 		},
 	}
 }
@@ -1076,7 +1081,9 @@ func flattenAppSpec(d *schema.ResourceData, spec *godo.AppSpec) []map[string]int
 
 		if len((*spec).Domains) > 0 {
 			r["domains"] = flattenAppDomainSpec((*spec).Domains)
-			if _, ok := d.GetOk("spec.0.domain"); ok {
+			// Both domain blocks are set when the spec is read for the first
+			// time, e.g. on import, so there is no diff whichever is used.
+			if _, ok := d.GetOk("spec.0.domain"); ok || d.Get("spec.#").(int) == 0 {
 				r["domain"] = flattenAppSpecDomains((*spec).Domains)
 			}
 		}
@@ -1510,6 +1517,11 @@ func flattenAppHealthCheck(check *godo.AppServiceSpecHealthCheck) []interface{}
 
 		r := make(map[string]interface{})
 		r["http_path"] = check.HTTPPath
+		// Apps created with the deprecated path field, e.g. through the API,
+		// perform HTTP health checks on it.
+		if check.HTTPPath == "" && check.Path != "" {
+			r["http_path"] = check.Path
+		}
 		r["initial_delay_seconds"] = check.InitialDelaySeconds
 		r["period_seconds"] = check.PeriodSeconds
 		r["timeout_seconds"] = check.TimeoutSeconds
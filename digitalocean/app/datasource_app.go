@@ -23,6 +23,7 @@ func DataSourceDigitalOceanApp() *schema.Resource {
 					Schema: appSpecSchema(false),
 				},
 			},
+			"build_config": appBuildConfigSchema(),
 			"default_ingress": {
 				Type:        schema.TypeString,
 				Computed:    true,
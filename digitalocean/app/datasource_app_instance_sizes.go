@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanAppInstanceSizes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"slug": {
+				Type:        schema.TypeString,
+				Description: "The slug of the instance size, used as the instance_size_slug of an app component.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The display name of the instance size.",
+			},
+			"cpu_type": {
+				Type:        schema.TypeString,
+				Description: "Whether the CPUs are SHARED or DEDICATED.",
+			},
+			"cpus": {
+				Type:        schema.TypeFloat,
+				Description: "The number of CPUs allocated to each instance.",
+			},
+			"memory_bytes": {
+				Type:        schema.TypeInt,
+				Description: "The amount of memory allocated to each instance in bytes.",
+			},
+			"price_monthly": {
+				Type:        schema.TypeFloat,
+				Description: "The monthly cost of an instance in US dollars.",
+			},
+			"price_per_second": {
+				Type:        schema.TypeFloat,
+				Description: "The cost of an instance per second in US dollars.",
+			},
+			"tier_slug": {
+				Type:        schema.TypeString,
+				Description: "The slug of the tier the instance size belongs to.",
+			},
+			"scalable": {
+				Type:        schema.TypeBool,
+				Description: "Whether components using the instance size can enable autoscaling.",
+			},
+			"single_instance_only": {
+				Type:        schema.TypeBool,
+				Description: "Whether components using the instance size are limited to a single instance.",
+			},
+			"bandwidth_allowance_gib": {
+				Type:        schema.TypeInt,
+				Description: "The bandwidth allowance of the instance size in GiB.",
+			},
+			"deprecated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instance size is intended to be deprecated.",
+			},
+		},
+		ResultAttributeName: "instance_sizes",
+		FlattenRecord:       flattenDigitalOceanAppInstanceSize,
+		GetRecords:          getDigitalOceanAppInstanceSizes,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanAppInstanceSizes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	sizes, _, err := client.Apps.ListInstanceSizes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving app instance sizes: %s", err)
+	}
+
+	records := make([]interface{}, 0, len(sizes))
+	for _, size := range sizes {
+		records = append(records, size)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanAppInstanceSize(rawSize, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	s := rawSize.(*godo.AppInstanceSize)
+
+	// The API returns numeric values as strings to preserve their precision.
+	cpus, err := parseAppInstanceSizeFloat(s.CPUs)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing cpus of instance size %s: %s", s.Slug, err)
+	}
+	memoryBytes, err := parseAppInstanceSizeInt(s.MemoryBytes)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing memory_bytes of instance size %s: %s", s.Slug, err)
+	}
+	priceMonthly, err := parseAppInstanceSizeFloat(s.USDPerMonth)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing usd_per_month of instance size %s: %s", s.Slug, err)
+	}
+	pricePerSecond, err := parseAppInstanceSizeFloat(s.USDPerSecond)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing usd_per_second of instance size %s: %s", s.Slug, err)
+	}
+	bandwidth, err := parseAppInstanceSizeInt(s.BandwidthAllowanceGib)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing bandwidth_allowance_gib of instance size %s: %s", s.Slug, err)
+	}
+
+	flattenedSize := map[string]interface{}{}
+	flattenedSize["slug"] = s.Slug
+	flattenedSize["name"] = s.Name
+	flattenedSize["cpu_type"] = string(s.CPUType)
+	flattenedSize["cpus"] = cpus
+	flattenedSize["memory_bytes"] = memoryBytes
+	flattenedSize["price_monthly"] = priceMonthly
+	flattenedSize["price_per_second"] = pricePerSecond
+	flattenedSize["tier_slug"] = s.TierSlug
+	flattenedSize["scalable"] = s.Scalable
+	flattenedSize["single_instance_only"] = s.SingleInstanceOnly
+	flattenedSize["bandwidth_allowance_gib"] = bandwidth
+	flattenedSize["deprecated"] = s.DeprecationIntent
+
+	return flattenedSize, nil
+}
+
+func parseAppInstanceSizeFloat(v string) (float64, error) {
+	if v == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(v, 64)
+}
+
+func parseAppInstanceSizeInt(v string) (int, error) {
+	if v == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(v)
+}
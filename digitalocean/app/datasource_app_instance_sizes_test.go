@@ -0,0 +1,55 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanAppInstanceSizes_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_app_instance_sizes" "all" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_app_instance_sizes.all", "instance_sizes.0.slug"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_app_instance_sizes.all", "instance_sizes.0.cpus"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_app_instance_sizes.all", "instance_sizes.0.memory_bytes"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_app_instance_sizes.all", "instance_sizes.0.price_monthly"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanAppInstanceSizes_WithFilterAndSort(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_app_instance_sizes" "scalable" {
+  filter {
+    key    = "scalable"
+    values = ["true"]
+  }
+
+  sort {
+    key       = "price_monthly"
+    direction = "asc"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_app_instance_sizes.scalable", "instance_sizes.0.scalable", "true"),
+				),
+			},
+		},
+	})
+}
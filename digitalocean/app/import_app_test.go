@@ -2,6 +2,7 @@ package app_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
@@ -28,3 +29,31 @@ func TestAccDigitalOceanApp_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDigitalOceanApp_importByName(t *testing.T) {
+	resourceName := "digitalocean_app.foobar"
+	appName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: testAccCheckDigitalOceanAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanAppConfig_basic, appName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     appName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: acceptance.RandomTestName(),
+				ExpectError:   regexp.MustCompile("no app found with name"),
+			},
+		},
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -20,7 +22,7 @@ func ResourceDigitalOceanApp() *schema.Resource {
 		UpdateContext: resourceDigitalOceanAppUpdate,
 		DeleteContext: resourceDigitalOceanAppDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDigitalOceanAppImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +44,31 @@ func ResourceDigitalOceanApp() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"buildpack": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Pins a buildpack used by the app to a major version, upgrading it if needed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+							Description:  "The ID of the buildpack, e.g. digitalocean/node",
+						},
+						"major_version": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The major version the buildpack is pinned to",
+						},
+					},
+				},
+			},
+
 			// Computed attributes
+			"build_config": appBuildConfigSchema(),
+
 			"default_ingress": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -127,7 +153,7 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] App create request: %#v", appCreateRequest)
-	app, _, err := client.Apps.Create(context.Background(), appCreateRequest)
+	app, _, err := client.Apps.Create(ctx, appCreateRequest)
 	if err != nil {
 		return diag.Errorf("Error creating App: %s", err)
 	}
@@ -135,11 +161,15 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(app.ID)
 	log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
 	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForAppDeployment(client, app.ID, timeout)
+	err = waitForAppDeployment(ctx, client, app.ID, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := pinAppBuildpacks(ctx, client, d, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] App created, ID: %s", d.Id())
 
 	return resourceDigitalOceanAppRead(ctx, d, meta)
@@ -148,7 +178,7 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	app, resp, err := client.Apps.Get(context.Background(), d.Id())
+	app, resp, err := client.Apps.Get(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[DEBUG] App (%s) was not found - removing from state", d.Id())
@@ -170,6 +200,29 @@ func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("dedicated_ips", appDedicatedIps(d, app))
 	}
 
+	if err := d.Set("build_config", flattenAppBuildConfig(app.BuildConfig)); err != nil {
+		return diag.Errorf("Error setting build_config: %#v", err)
+	}
+
+	var diags diag.Diagnostics
+
+	// Only the pinned buildpacks are tracked, showing when they no longer
+	// match their pinned major version.
+	if v, ok := d.GetOk("buildpack"); ok {
+		pinned, unused := appPinnedBuildpacks(v.(*schema.Set).List(), app.BuildConfig)
+		if err := d.Set("buildpack", pinned); err != nil {
+			return diag.Errorf("Error setting buildpack: %#v", err)
+		}
+
+		for _, id := range unused {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Pinned buildpack %s is not used by app: %s (%s)", id, app.Spec.Name, app.ID),
+				Detail:   "The buildpack is no longer listed in the build_config of the app, so it is not upgraded. Remove its buildpack block if the app no longer needs it.",
+			})
+		}
+	}
+
 	if err := d.Set("spec", flattenAppSpec(d, app.Spec)); err != nil {
 		return diag.Errorf("Error setting app spec: %#v", err)
 	}
@@ -182,10 +235,10 @@ func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, me
 			Summary:  fmt.Sprintf("No active deployment found for app: %s (%s)", app.Spec.Name, app.ID),
 		}
 		d.Set("active_deployment_id", "")
-		return diag.Diagnostics{deploymentWarning}
+		diags = append(diags, deploymentWarning)
 	}
 
-	return nil
+	return diags
 }
 
 func appDedicatedIps(d *schema.ResourceData, app *godo.App) []interface{} {
@@ -208,14 +261,14 @@ func resourceDigitalOceanAppUpdate(ctx context.Context, d *schema.ResourceData,
 		appUpdateRequest := &godo.AppUpdateRequest{}
 		appUpdateRequest.Spec = expandAppSpec(d.Get("spec").([]interface{}))
 
-		app, _, err := client.Apps.Update(context.Background(), d.Id(), appUpdateRequest)
+		app, _, err := client.Apps.Update(ctx, d.Id(), appUpdateRequest)
 		if err != nil {
 			return diag.Errorf("Error updating app (%s): %s", d.Id(), err)
 		}
 
 		log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
 		timeout := d.Timeout(schema.TimeoutCreate)
-		err = waitForAppDeployment(client, app.ID, timeout)
+		err = waitForAppDeployment(ctx, client, app.ID, timeout)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -223,14 +276,231 @@ func resourceDigitalOceanAppUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Updated app (%s)", app.ID)
 	}
 
+	if d.HasChanges("spec", "buildpack") {
+		if err := pinAppBuildpacks(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDigitalOceanAppRead(ctx, d, meta)
 }
 
+func appBuildConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The build configuration of the app, including the versions of the buildpacks used by its components",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"stack_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the version of the underlying Cloud Native Buildpacks stack",
+				},
+				"buildpacks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"version": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"major_version": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"latest": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func appBuildpacks(buildConfig *godo.AppBuildConfig) []*godo.Buildpack {
+	if buildConfig == nil || buildConfig.CNBVersioning == nil {
+		return nil
+	}
+
+	return buildConfig.CNBVersioning.Buildpacks
+}
+
+func flattenAppBuildConfig(buildConfig *godo.AppBuildConfig) []interface{} {
+	if buildConfig == nil || buildConfig.CNBVersioning == nil {
+		return nil
+	}
+
+	buildpacks := make([]interface{}, 0, len(buildConfig.CNBVersioning.Buildpacks))
+	for _, bp := range buildConfig.CNBVersioning.Buildpacks {
+		buildpacks = append(buildpacks, map[string]interface{}{
+			"id":            bp.ID,
+			"name":          bp.Name,
+			"version":       bp.Version,
+			"major_version": int(bp.MajorVersion),
+			"latest":        bp.Latest,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"stack_id":   buildConfig.CNBVersioning.StackID,
+			"buildpacks": buildpacks,
+		},
+	}
+}
+
+// appPinnedBuildpacks returns the current major versions of the pinned
+// buildpacks, along with the IDs of those which are no longer used by the
+// app. The pins of the unused buildpacks are kept as they are, as they would
+// otherwise show as changes which can not be applied.
+func appPinnedBuildpacks(pins []interface{}, buildConfig *godo.AppBuildConfig) ([]interface{}, []string) {
+	current := map[string]int{}
+	for _, bp := range appBuildpacks(buildConfig) {
+		current[bp.ID] = int(bp.MajorVersion)
+	}
+
+	pinned := make([]interface{}, 0, len(pins))
+	var unused []string
+	for _, rawPin := range pins {
+		pin := rawPin.(map[string]interface{})
+		id := pin["id"].(string)
+		majorVersion, ok := current[id]
+		if !ok {
+			unused = append(unused, id)
+			majorVersion = pin["major_version"].(int)
+		}
+
+		pinned = append(pinned, map[string]interface{}{
+			"id":            id,
+			"major_version": majorVersion,
+		})
+	}
+
+	return pinned, unused
+}
+
+// pinAppBuildpacks upgrades the buildpacks of the app which are not on their
+// pinned major version, and waits for the resulting deployments.
+func pinAppBuildpacks(ctx context.Context, client *godo.Client, d *schema.ResourceData, timeout time.Duration) error {
+	pins := d.Get("buildpack").(*schema.Set).List()
+	if len(pins) == 0 {
+		return nil
+	}
+
+	app, _, err := client.Apps.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading App: %s", err)
+	}
+
+	current := map[string]int32{}
+	for _, bp := range appBuildpacks(app.BuildConfig) {
+		current[bp.ID] = bp.MajorVersion
+	}
+
+	for _, rawPin := range pins {
+		pin := rawPin.(map[string]interface{})
+		id := pin["id"].(string)
+		majorVersion := int32(pin["major_version"].(int))
+
+		currentVersion, ok := current[id]
+		if !ok {
+			log.Printf("[WARN] Pinned buildpack %s is not used by the app (%s), skipping it", id, d.Id())
+			continue
+		}
+		if currentVersion == majorVersion {
+			continue
+		}
+
+		log.Printf("[INFO] Upgrading buildpack %s of app (%s) from major version %d to %d", id, d.Id(), currentVersion, majorVersion)
+		_, _, err := client.Apps.UpgradeBuildpack(ctx, d.Id(), godo.UpgradeBuildpackOptions{
+			BuildpackID:       id,
+			MajorVersion:      majorVersion,
+			TriggerDeployment: true,
+		})
+		if err != nil {
+			return fmt.Errorf("Error upgrading buildpack %s of app (%s) to major version %d: %s", id, d.Id(), majorVersion, err)
+		}
+
+		if err := waitForAppDeployment(ctx, client, d.Id(), timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appIDRe matches the UUIDs identifying apps, which cannot be app names as
+// those are limited to 32 characters.
+var appIDRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Apps can be imported using either their ID or their name.
+func resourceDigitalOceanAppImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if appIDRe.MatchString(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	name := d.Id()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var ids []string
+	for {
+		apps, resp, err := client.Apps.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing apps: %s", err)
+		}
+
+		for _, app := range apps {
+			if app.Spec != nil && app.Spec.Name == name {
+				ids = append(ids, app.ID)
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing apps: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no app found with name %s", name)
+	case 1:
+		d.SetId(ids[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("more than one app found with name %s, use the ID of one of them instead: %s", name, strings.Join(ids, ", "))
+	}
+}
+
 func resourceDigitalOceanAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting App: %s", d.Id())
-	_, err := client.Apps.Delete(context.Background(), d.Id())
+	_, err := client.Apps.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deletingApp: %s", err)
 	}
@@ -239,7 +509,7 @@ func resourceDigitalOceanAppDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration) error {
+func waitForAppDeployment(ctx context.Context, client *godo.Client, id string, timeout time.Duration) error {
 	tickerInterval := 10 //10s
 	timeoutSeconds := int(timeout.Seconds())
 	n := 0
@@ -261,7 +531,7 @@ func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration)
 			// already completed. So instead we need to list all of the
 			// deployments for the application.
 			opts := &godo.ListOptions{PerPage: 20}
-			deployments, _, err := client.Apps.ListDeployments(context.Background(), id, opts)
+			deployments, _, err := client.Apps.ListDeployments(ctx, id, opts)
 			if err != nil {
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
 			}
@@ -275,7 +545,7 @@ func waitForAppDeployment(client *godo.Client, id string, timeout time.Duration)
 				deploymentID = deployments[0].ID
 			}
 		} else {
-			deployment, _, err := client.Apps.GetDeployment(context.Background(), id, deploymentID)
+			deployment, _, err := client.Apps.GetDeployment(ctx, id, deploymentID)
 			if err != nil {
 				ticker.Stop()
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
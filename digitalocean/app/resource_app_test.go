@@ -3,13 +3,19 @@ package app_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/app"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -46,6 +52,75 @@ func TestAccDigitalOceanApp_Image(t *testing.T) {
 	})
 }
 
+func TestDigitalOceanAppReadPinnedBuildpacks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/apps/app-id" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(`{"app": {
+  "id": "app-id",
+  "spec": {"name": "sample"},
+  "active_deployment": {"id": "deployment-id"},
+  "build_config": {"cnb_versioning": {
+    "stack_id": "ubuntu-22",
+    "buildpacks": [
+      {"id": "digitalocean/node", "version": "2.1.0", "major_version": 2, "latest": true},
+      {"id": "digitalocean/procfile", "version": "1.0.3", "major_version": 1, "latest": true}
+    ]
+  }}
+}}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := app.ResourceDigitalOceanApp()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"buildpack": []interface{}{
+			map[string]interface{}{"id": "digitalocean/node", "major_version": 1},
+			map[string]interface{}{"id": "digitalocean/python", "major_version": 3},
+		},
+	})
+	d.SetId("app-id")
+
+	diags := r.ReadContext(context.Background(), d, meta)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	majorVersions := map[string]int{}
+	for _, pin := range d.Get("buildpack").(*schema.Set).List() {
+		pin := pin.(map[string]interface{})
+		majorVersions[pin["id"].(string)] = pin["major_version"].(int)
+	}
+	expected := map[string]int{"digitalocean/node": 2, "digitalocean/python": 3}
+	if !reflect.DeepEqual(majorVersions, expected) {
+		t.Errorf("Expected the current major version of the used buildpack and the unused pin as is, got %v", majorVersions)
+	}
+
+	// The pin of the buildpack which is no longer used is reported.
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "digitalocean/python") {
+		t.Errorf("Expected a warning about the unused buildpack, got %v", diags)
+	}
+
+	if stackID := d.Get("build_config.0.stack_id").(string); stackID != "ubuntu-22" {
+		t.Errorf("Expected stack_id ubuntu-22, got %q", stackID)
+	}
+	if n := d.Get("build_config.0.buildpacks.#").(int); n != 2 {
+		t.Errorf("Expected 2 buildpacks, got %d", n)
+	}
+}
+
 func TestAccDigitalOceanApp_Basic(t *testing.T) {
 	var app godo.App
 	appName := acceptance.RandomTestName()
@@ -69,6 +144,7 @@ func TestAccDigitalOceanApp_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "urn"),
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "updated_at"),
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "created_at"),
+					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "build_config.0.stack_id"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_app.foobar", "spec.0.alert.0.rule", "DEPLOYMENT_FAILED"),
 					resource.TestCheckResourceAttr(
@@ -92,6 +168,14 @@ func TestAccDigitalOceanApp_Basic(t *testing.T) {
 						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.timeout_seconds", "10"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.port", "1234"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.initial_delay_seconds", "5"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.period_seconds", "15"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.success_threshold", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_app.foobar", "spec.0.service.0.health_check.0.failure_threshold", "5"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_app.foobar", "spec.0.service.0.alert.0.value", "75"),
 					resource.TestCheckResourceAttr(
@@ -967,9 +1051,13 @@ resource "digitalocean_app" "foobar" {
       }
 
       health_check {
-        http_path       = "/"
-        timeout_seconds = 10
-        port            = 1234
+        http_path             = "/"
+        timeout_seconds       = 10
+        port                  = 1234
+        initial_delay_seconds = 5
+        period_seconds        = 15
+        success_threshold     = 2
+        failure_threshold     = 5
       }
 
       alert {
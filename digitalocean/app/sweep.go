@@ -34,7 +34,7 @@ func sweepApp(region string) error {
 	}
 
 	for _, app := range apps {
-		if strings.HasPrefix(app.Spec.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(app.Spec.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying app %s", app.Spec.Name)
 
 			if _, err := client.Apps.Delete(context.Background(), app.ID); err != nil {
@@ -0,0 +1,176 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const cdnRecordTTL = 3600
+
+// managedCertificateName returns the name used for the Let's Encrypt
+// certificate provisioned for a custom domain when manage_dns is enabled.
+func managedCertificateName(customDomain string) string {
+	return "cdn-" + strings.ReplaceAll(strings.ToLower(customDomain), ".", "-")
+}
+
+// findCDNZone returns the domain in the account hosting the custom domain.
+func findCDNZone(ctx context.Context, client *godo.Client, customDomain string) (string, error) {
+	zone, ok, err := certificate.FindDomainZone(ctx, client, customDomain)
+	if err != nil {
+		return "", fmt.Errorf("Error listing domains: %s", err)
+	}
+
+	if !ok {
+		return "", fmt.Errorf("`manage_dns` requires the zone for %q to be managed by DigitalOcean in the same account, but no matching domain was found", customDomain)
+	}
+
+	if strings.EqualFold(zone, strings.TrimSuffix(customDomain, ".")) {
+		return "", fmt.Errorf("`manage_dns` requires `custom_domain` to be a subdomain of %q as a CNAME record can not be created at the zone apex", zone)
+	}
+
+	return zone, nil
+}
+
+// provisionCDNCertificate creates a Let's Encrypt certificate for the custom
+// domain and waits for it to be verified. An existing certificate with the
+// same name, e.g. from a previously failed apply, is reused.
+func provisionCDNCertificate(ctx context.Context, client *godo.Client, customDomain string, timeout time.Duration) (*godo.Certificate, error) {
+	name := managedCertificateName(customDomain)
+
+	if _, err := certificate.FindCertificateByName(ctx, client, name); err != nil {
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+
+		log.Printf("[INFO] Creating Let's Encrypt certificate (%s) for CDN custom domain %s", name, customDomain)
+		_, _, err = client.Certificates.Create(ctx, &godo.CertificateRequest{
+			Name:     name,
+			Type:     "lets_encrypt",
+			DNSNames: []string{customDomain},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error creating certificate for %s: %s", customDomain, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"verified"},
+		Refresh: func() (interface{}, string, error) {
+			c, err := certificate.FindCertificateByName(ctx, client, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if c.State == "error" {
+				return nil, "", fmt.Errorf("certificate (%s) failed to be issued", name)
+			}
+
+			return c, c.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	verified, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for certificate (%s) to be verified: %s", name, err)
+	}
+
+	return verified.(*godo.Certificate), nil
+}
+
+// createCDNRecord creates a CNAME record in the zone pointing the custom domain
+// to the CDN endpoint, and reports whether it was created. An existing record
+// already pointing to the endpoint is used instead, but it is not created by
+// the CDN and so must not be deleted along with it. One pointing elsewhere is
+// left untouched and returns an error.
+func createCDNRecord(ctx context.Context, client *godo.Client, zone string, customDomain string, endpoint string) (int, bool, error) {
+	name := strings.TrimSuffix(strings.TrimSuffix(customDomain, "."), "."+zone)
+	target := endpoint + "."
+
+	records, _, err := client.Domains.RecordsByTypeAndName(ctx, zone, "CNAME", strings.TrimSuffix(customDomain, "."), nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("Error retrieving records for %s: %s", customDomain, err)
+	}
+
+	if len(records) > 0 {
+		if strings.TrimSuffix(records[0].Data, ".") == endpoint {
+			return records[0].ID, false, nil
+		}
+
+		return 0, false, fmt.Errorf("a CNAME record for %s already exists pointing to %s", customDomain, records[0].Data)
+	}
+
+	log.Printf("[INFO] Creating CNAME record %s.%s pointing to %s", name, zone, target)
+	record, _, err := client.Domains.CreateRecord(ctx, zone, &godo.DomainRecordEditRequest{
+		Type: "CNAME",
+		Name: name,
+		Data: target,
+		TTL:  cdnRecordTTL,
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("Error creating CNAME record for %s: %s", customDomain, err)
+	}
+
+	return record.ID, true, nil
+}
+
+// deleteCDNRecord removes the CNAME record created for the custom domain.
+func deleteCDNRecord(ctx context.Context, client *godo.Client, customDomain string, recordID int) error {
+	zone, ok, err := certificate.FindDomainZone(ctx, client, customDomain)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Printf("[DEBUG] Zone for %s not found, considering CNAME record deleted", customDomain)
+		return nil
+	}
+
+	resp, err := client.Domains.DeleteRecord(ctx, zone, recordID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("Error deleting CNAME record for %s: %s", customDomain, err)
+	}
+
+	return nil
+}
+
+// deleteCDNCertificate removes the certificate provisioned for the custom
+// domain, retrying while the CDN endpoint is still being released from it.
+func deleteCDNCertificate(ctx context.Context, client *godo.Client, name string) error {
+	cert, err := certificate.FindCertificateByName(ctx, client, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+
+	return util.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		resp, err := client.Certificates.Delete(ctx, cert.ID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			if util.IsDigitalOceanError(err, http.StatusForbidden, "Make sure the certificate is not in use before deleting it") {
+				log.Printf("[DEBUG] Received %s, retrying certificate deletion", err.Error())
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(fmt.Errorf("Error deleting certificate (%s): %s", name, err))
+		}
+
+		return nil
+	})
+}
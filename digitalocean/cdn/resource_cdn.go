@@ -2,6 +2,7 @@ package cdn
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"strings"
@@ -41,6 +42,28 @@ func ResourceDigitalOceanCDN() *schema.Resource {
 		},
 
 		Schema: resourceDigitalOceanCDNv1(),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.Get("manage_dns").(bool) {
+				return nil
+			}
+
+			if diff.NewValueKnown("custom_domain") && diff.Get("custom_domain").(string) == "" {
+				return fmt.Errorf("`custom_domain` is required when `manage_dns` is enabled")
+			}
+
+			// The certificate and CNAME record are provisioned for the custom
+			// domain when the CDN is created.
+			if diff.Id() != "" && diff.HasChange("custom_domain") {
+				return diff.ForceNew("custom_domain")
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -51,6 +74,28 @@ func resourceDigitalOceanCDNv1() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"manage_dns": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "If true, a Let's Encrypt certificate and a CNAME record for the custom domain are provisioned in the DigitalOcean managed zone",
+		},
+		"managed_certificate": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the certificate was provisioned by the CDN as a result of manage_dns",
+		},
+		"dns_record_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the CNAME record created for the custom domain when manage_dns is enabled",
+		},
+		"managed_dns_record": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the CNAME record was created by the CDN as a result of manage_dns, rather than already existing",
+		},
 	}
 
 	for k, v := range resourceDigitalOceanCDNv0().Schema {
@@ -116,7 +161,7 @@ func migrateCDNStateV0toV1(ctx context.Context, rawState map[string]interface{},
 	if certID != "" {
 		log.Println("[DEBUG] Migrating CDN schema from v0 to v1.")
 		client := meta.(*config.CombinedConfig).GodoClient()
-		cert, _, err := client.Certificates.Get(context.Background(), certID)
+		cert, _, err := client.Certificates.Get(ctx, certID)
 		if err != nil {
 			return rawState, err
 		}
@@ -149,7 +194,7 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 			if certName == needsCloudflareCert {
 				cdnRequest.CertificateID = needsCloudflareCert
 			} else {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := certificate.FindCertificateByName(ctx, client, certName)
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -158,17 +203,38 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	var zone string
+	if d.Get("manage_dns").(bool) {
+		var err error
+		zone, err = findCDNZone(ctx, client, cdnRequest.CustomDomain)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, hasCertName := d.GetOk("certificate_name")
+		_, hasCertID := d.GetOk("certificate_id")
+		if !hasCertName && !hasCertID {
+			cert, err := provisionCDNCertificate(ctx, client, cdnRequest.CustomDomain, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			cdnRequest.CertificateID = cert.ID
+			d.Set("managed_certificate", true)
+		}
+	}
+
 	if id, idOk := d.GetOk("certificate_id"); idOk && cdnRequest.CertificateID == "" {
 		// When the certificate type is lets_encrypt, the certificate
 		// ID will change when it's renewed, so we have to rely on the
 		// certificate name as the primary identifier instead.
 		certName := id.(string)
 		if certName != "" {
-			cert, err := certificate.FindCertificateByName(client, certName)
+			cert, err := certificate.FindCertificateByName(ctx, client, certName)
 			if err != nil {
 				if strings.Contains(err.Error(), "not found") {
 					log.Println("[DEBUG] Certificate not found looking up by name. Falling back to lookup by ID.")
-					cert, _, err = client.Certificates.Get(context.Background(), certName)
+					cert, _, err = client.Certificates.Get(ctx, certName)
 					if err != nil {
 						return diag.FromErr(err)
 					}
@@ -182,14 +248,32 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] CDN create request: %#v", cdnRequest)
-	cdn, _, err := client.CDNs.Create(context.Background(), cdnRequest)
+	cdn, _, err := client.CDNs.Create(ctx, cdnRequest)
 	if err != nil {
+		// The certificate would otherwise be left behind, as the CDN is not
+		// saved in the state.
+		if d.Get("managed_certificate").(bool) {
+			if certErr := deleteCDNCertificate(ctx, client, managedCertificateName(cdnRequest.CustomDomain)); certErr != nil {
+				log.Printf("[WARN] Unable to delete the certificate provisioned for %s: %s", cdnRequest.CustomDomain, certErr)
+			}
+		}
+
 		return diag.Errorf("Error creating CDN: %s", err)
 	}
 
 	d.SetId(cdn.ID)
 	log.Printf("[INFO] CDN created, ID: %s", d.Id())
 
+	if zone != "" {
+		recordID, created, err := createCDNRecord(ctx, client, zone, cdnRequest.CustomDomain, cdn.Endpoint)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("dns_record_id", recordID)
+		d.Set("managed_dns_record", created)
+	}
+
 	return resourceDigitalOceanCDNRead(ctx, d, meta)
 }
 
@@ -216,7 +300,7 @@ func resourceDigitalOceanCDNRead(ctx context.Context, d *schema.ResourceData, me
 		// When the certificate type is lets_encrypt, the certificate
 		// ID will change when it's renewed, so we have to rely on the
 		// certificate name as the primary identifier instead.
-		cert, _, err := client.Certificates.Get(context.Background(), cdn.CertificateID)
+		cert, _, err := client.Certificates.Get(ctx, cdn.CertificateID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -239,7 +323,7 @@ func getCDNWithRetryBackoff(ctx context.Context, client *godo.Client, id string)
 		timeout = 30 * time.Second
 		err     error
 	)
-	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+	err = util.RetryContext(ctx, timeout, func() *resource.RetryError {
 		cdn, resp, err = client.CDNs.Get(ctx, id)
 		if err != nil {
 			if util.IsDigitalOceanError(err, http.StatusNotFound, "") {
@@ -247,7 +331,7 @@ func getCDNWithRetryBackoff(ctx context.Context, client *godo.Client, id string)
 				return resource.RetryableError(err)
 			}
 
-			if util.IsDigitalOceanError(err, http.StatusTooManyRequests, "") {
+			if util.DigitalOceanConflict(err) == util.RateLimitConflict {
 				log.Printf("[DEBUG] Received %s, backing off", err.Error())
 				time.Sleep(10 * time.Second)
 				return resource.RetryableError(err)
@@ -274,7 +358,7 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 		ttlUpdateRequest := &godo.CDNUpdateTTLRequest{
 			TTL: uint32(d.Get("ttl").(int)),
 		}
-		_, _, err := client.CDNs.UpdateTTL(context.Background(), d.Id(), ttlUpdateRequest)
+		_, _, err := client.CDNs.UpdateTTL(ctx, d.Id(), ttlUpdateRequest)
 
 		if err != nil {
 			return diag.Errorf("Error updating CDN TTL: %s", err)
@@ -292,7 +376,7 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 			if certName == needsCloudflareCert {
 				cdnUpdateRequest.CertificateID = needsCloudflareCert
 			} else {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := certificate.FindCertificateByName(ctx, client, certName)
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -301,7 +385,7 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
-		_, _, err := client.CDNs.UpdateCustomDomain(context.Background(), d.Id(), cdnUpdateRequest)
+		_, _, err := client.CDNs.UpdateCustomDomain(ctx, d.Id(), cdnUpdateRequest)
 
 		if err != nil {
 			return diag.Errorf("Error updating CDN custom domain: %s", err)
@@ -318,10 +402,10 @@ func resourceDigitalOceanCDNDelete(ctx context.Context, d *schema.ResourceData,
 	resourceID := d.Id()
 
 	timeout := 30 * time.Second
-	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		_, err := client.CDNs.Delete(context.Background(), resourceID)
+	err := util.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := client.CDNs.Delete(ctx, resourceID)
 		if err != nil {
-			if util.IsDigitalOceanError(err, http.StatusTooManyRequests, "") {
+			if util.DigitalOceanConflict(err) == util.RateLimitConflict {
 				log.Printf("[DEBUG] Received %s, backing off", err.Error())
 				time.Sleep(10 * time.Second)
 				return resource.RetryableError(err)
@@ -336,6 +420,21 @@ func resourceDigitalOceanCDNDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error deleting CDN: %s", err)
 	}
 
+	if d.Get("manage_dns").(bool) {
+		customDomain := d.Get("custom_domain").(string)
+		if recordID := d.Get("dns_record_id").(int); recordID != 0 && d.Get("managed_dns_record").(bool) {
+			if err := deleteCDNRecord(ctx, client, customDomain, recordID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.Get("managed_certificate").(bool) {
+			if err := deleteCDNCertificate(ctx, client, managedCertificateName(customDomain)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	d.SetId("")
 	log.Printf("[INFO] CDN deleted, ID: %s", resourceID)
 
@@ -3,17 +3,57 @@ package cdn_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/cdn"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const originSuffix = ".ams3.digitaloceanspaces.com"
 
+func TestDigitalOceanCDNDeleteKeepsExistingRecord(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/v2/cdn/endpoints/cdn-id" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	meta, err := (&config.Config{Token: "12345", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The CNAME record already pointed to the CDN when it was created, so it
+	// is not deleted along with it.
+	r := cdn.ResourceDigitalOceanCDN()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"origin":        "foo.ams3.digitaloceanspaces.com",
+		"custom_domain": "cdn.example.com",
+		"manage_dns":    true,
+	})
+	d.SetId("cdn-id")
+	d.Set("dns_record_id", 123)
+	d.Set("managed_dns_record", false)
+
+	if diags := r.DeleteContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+}
+
 func TestAccDigitalOceanCDN_Create(t *testing.T) {
 
 	bucketName := generateBucketName()
@@ -179,6 +219,55 @@ func TestAccDigitalOceanCDN_CustomDomain(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCDN_ManageDNS(t *testing.T) {
+	domain := os.Getenv("DO_TEST_SUBDOMAIN")
+	if domain == "" {
+		t.Skip("Test requires an active DO manage sub domain. Set DO_TEST_SUBDOMAIN")
+	}
+
+	bucketName := generateBucketName()
+	cdnCreateConfig := fmt.Sprintf(testAccCheckDigitalOceanCDNConfig_ManageDNS, bucketName, domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCDNDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: cdnCreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanCDNExists("digitalocean_cdn.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_cdn.foobar", "custom_domain", domain),
+					resource.TestCheckResourceAttr("digitalocean_cdn.foobar", "manage_dns", "true"),
+					resource.TestCheckResourceAttr("digitalocean_cdn.foobar", "managed_certificate", "true"),
+					resource.TestCheckResourceAttr("digitalocean_cdn.foobar", "certificate_name",
+						"cdn-"+strings.ReplaceAll(domain, ".", "-")),
+					resource.TestCheckResourceAttrSet("digitalocean_cdn.foobar", "dns_record_id"),
+					resource.TestCheckResourceAttr("digitalocean_cdn.foobar", "managed_dns_record", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanCDN_ManageDNSWithoutCustomDomain(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCDNDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_cdn" "foobar" {
+  origin     = "example.ams3.digitaloceanspaces.com"
+  manage_dns = true
+}`,
+				ExpectError: regexp.MustCompile("`custom_domain` is required when `manage_dns` is enabled"),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanCDNDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -252,6 +341,19 @@ resource "digitalocean_cdn" "foobar" {
   custom_domain    = "%s"
 }`
 
+const testAccCheckDigitalOceanCDNConfig_ManageDNS = `
+resource "digitalocean_spaces_bucket" "bucket" {
+  name   = "%s"
+  region = "ams3"
+  acl    = "public-read"
+}
+
+resource "digitalocean_cdn" "foobar" {
+  origin        = digitalocean_spaces_bucket.bucket.bucket_domain_name
+  custom_domain = "%s"
+  manage_dns    = true
+}`
+
 const testAccCheckDigitalOceanCDNConfig_Create_with_TTL = `
 resource "digitalocean_spaces_bucket" "bucket" {
   name   = "%s"
@@ -34,7 +34,7 @@ func sweepCDN(region string) error {
 	}
 
 	for _, c := range cdns {
-		if strings.HasPrefix(c.Origin, sweep.TestNamePrefix) {
+		if strings.HasPrefix(c.Origin, sweep.NamePrefix()) {
 			log.Printf("Destroying CDN %s", c.Origin)
 
 			if _, err := client.CDNs.Delete(context.Background(), c.ID); err != nil {
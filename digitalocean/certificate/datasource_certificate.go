@@ -71,7 +71,7 @@ func dataSourceDigitalOceanCertificateRead(ctx context.Context, d *schema.Resour
 	// ID will change when it's renewed, so we have to rely on the
 	// certificate name as the primary identifier instead.
 	name := d.Get("name").(string)
-	cert, err := FindCertificateByName(client, name)
+	cert, err := FindCertificateByName(ctx, client, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -91,8 +91,8 @@ func dataSourceDigitalOceanCertificateRead(ctx context.Context, d *schema.Resour
 	return nil
 }
 
-func FindCertificateByName(client *godo.Client, name string) (*godo.Certificate, error) {
-	cert, _, err := client.Certificates.ListByName(context.Background(), name, nil)
+func FindCertificateByName(ctx context.Context, client *godo.Client, name string) (*godo.Certificate, error) {
+	cert, _, err := client.Certificates.ListByName(ctx, name, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving certificates: %s", err)
 	}
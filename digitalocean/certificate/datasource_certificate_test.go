@@ -1,6 +1,7 @@
 package certificate_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -55,7 +56,7 @@ func testAccCheckDataSourceDigitalOceanCertificateExists(n string, cert *godo.Ce
 
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
-		foundCertificate, err := certificate.FindCertificateByName(client, rs.Primary.ID)
+		foundCertificate, err := certificate.FindCertificateByName(context.Background(), client, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -107,7 +107,7 @@ func resourceDigitalOceanCertificateV0() *schema.Resource {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.NoZeroValues,
+					ValidateFunc: validation.All(validation.NoZeroValues, validateCertificateDomain),
 				},
 				Optional:      true,
 				ForceNew:      true,
@@ -200,9 +200,14 @@ func resourceDigitalOceanCertificateCreate(ctx context.Context, d *schema.Resour
 		}
 	} else if certificateType == "lets_encrypt" {
 
-		if _, ok := d.GetOk("domains"); !ok {
+		v, ok := d.GetOk("domains")
+		if !ok {
 			return diag.Errorf("`domains` is required for when type is `lets_encrypt`")
 		}
+
+		if diags := checkWildcardDomainZones(ctx, client, v.(*schema.Set).List()); diags.HasError() {
+			return diags
+		}
 	}
 
 	log.Printf("[INFO] Create a Certificate Request")
@@ -213,7 +218,7 @@ func resourceDigitalOceanCertificateCreate(ctx context.Context, d *schema.Resour
 	}
 
 	log.Printf("[DEBUG] Certificate Create: %#v", certReq)
-	cert, _, err := client.Certificates.Create(context.Background(), certReq)
+	cert, _, err := client.Certificates.Create(ctx, certReq)
 	if err != nil {
 		return diag.Errorf("Error creating Certificate: %s", err)
 	}
@@ -231,7 +236,7 @@ func resourceDigitalOceanCertificateCreate(ctx context.Context, d *schema.Resour
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"pending"},
 		Target:     []string{"verified"},
-		Refresh:    newCertificateStateRefreshFunc(d, meta),
+		Refresh:    newCertificateStateRefreshFunc(ctx, d, meta),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -251,7 +256,7 @@ func resourceDigitalOceanCertificateRead(ctx context.Context, d *schema.Resource
 	// ID will change when it's renewed, so we have to rely on the
 	// certificate name as the primary identifier instead.
 	log.Printf("[INFO] Reading the details of the Certificate %s", d.Id())
-	cert, err := FindCertificateByName(client, d.Id())
+	cert, err := FindCertificateByName(ctx, client, d.Id())
 	// check if the certificate no longer exists.
 	if cert == nil && strings.Contains(err.Error(), "not found") {
 		log.Printf("[WARN] DigitalOcean Certificate (%s) not found", d.Id())
@@ -282,7 +287,7 @@ func resourceDigitalOceanCertificateDelete(ctx context.Context, d *schema.Resour
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting Certificate: %s", d.Id())
-	cert, err := FindCertificateByName(client, d.Id())
+	cert, err := FindCertificateByName(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("Error retrieving Certificate: %s", err)
 	}
@@ -291,8 +296,8 @@ func resourceDigitalOceanCertificateDelete(ctx context.Context, d *schema.Resour
 	}
 
 	timeout := 30 * time.Second
-	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		_, err = client.Certificates.Delete(context.Background(), cert.ID)
+	err = util.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err = client.Certificates.Delete(ctx, cert.ID)
 		if err != nil {
 			if util.IsDigitalOceanError(err, http.StatusForbidden, "Make sure the certificate is not in use before deleting it") {
 				log.Printf("[DEBUG] Received %s, retrying certificate deletion", err.Error())
@@ -313,6 +318,119 @@ func resourceDigitalOceanCertificateDelete(ctx context.Context, d *schema.Resour
 
 }
 
+// validateCertificateDomain ensures wildcards are only used as the left-most
+// label of a domain, e.g. *.example.com.
+func validateCertificateDomain(v interface{}, k string) ([]string, []error) {
+	domain := v.(string)
+	if !strings.Contains(domain, "*") {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(domain, "*.") || strings.Count(domain, "*") > 1 || len(domain) <= 2 {
+		return nil, []error{fmt.Errorf("%q must only use a wildcard as the left-most label, e.g. *.example.com, got: %s", k, domain)}
+	}
+
+	return nil, nil
+}
+
+// checkWildcardDomainZones verifies that the DNS zone of each wildcard domain
+// is managed by DigitalOcean in the same account. Let's Encrypt wildcard
+// certificates are validated using DNS challenges, so issuance fails if the
+// zone is hosted elsewhere.
+func checkWildcardDomainZones(ctx context.Context, client *godo.Client, domains []interface{}) diag.Diagnostics {
+	var wildcards []string
+	for _, v := range domains {
+		if domain := v.(string); strings.HasPrefix(domain, "*.") {
+			wildcards = append(wildcards, domain)
+		}
+	}
+
+	if len(wildcards) == 0 {
+		return nil
+	}
+
+	zones, err := listDomainZones(ctx, client)
+	if err != nil {
+		return diag.Errorf("Error listing domains to validate wildcard certificate domains: %s", err)
+	}
+
+	var diags diag.Diagnostics
+	for _, domain := range wildcards {
+		name := strings.TrimPrefix(domain, "*.")
+		if _, ok := findDomainZone(zones, name); !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "DNS zone for wildcard certificate domain not found",
+				Detail: fmt.Sprintf("Let's Encrypt wildcard certificates require DNS validation, so the zone for %q must be managed "+
+					"by DigitalOcean in the same account. No domain matching %q was found in this account. "+
+					"Add the zone using the digitalocean_domain resource and delegate it to DigitalOcean's name servers.", domain, name),
+			})
+		}
+	}
+
+	return diags
+}
+
+// listDomainZones returns the names of all domains in the account.
+func listDomainZones(ctx context.Context, client *godo.Client) ([]string, error) {
+	zones := []string{}
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		domains, resp, err := client.Domains.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range domains {
+			zones = append(zones, d.Name)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	return zones, nil
+}
+
+// FindDomainZone returns the domain in the account hosting the DNS zone which
+// name belongs to, if any.
+func FindDomainZone(ctx context.Context, client *godo.Client, name string) (string, bool, error) {
+	zones, err := listDomainZones(ctx, client)
+	if err != nil {
+		return "", false, err
+	}
+
+	zone, ok := findDomainZone(zones, name)
+	return zone, ok, nil
+}
+
+// findDomainZone returns the most specific zone which name belongs to.
+func findDomainZone(zones []string, name string) (string, bool) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	match := ""
+	for _, zone := range zones {
+		zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+		if (name == zone || strings.HasSuffix(name, "."+zone)) && len(zone) > len(match) {
+			match = zone
+		}
+	}
+
+	return match, match != ""
+}
+
 func expandDigitalOceanCertificateDomains(domains []interface{}) []string {
 	expandedDomains := make([]string, len(domains))
 	for i, v := range domains {
@@ -337,13 +455,13 @@ func flattenDigitalOceanCertificateDomains(domains []string) *schema.Set {
 	return flattenedDomains
 }
 
-func newCertificateStateRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+func newCertificateStateRefreshFunc(ctx context.Context, d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	client := meta.(*config.CombinedConfig).GodoClient()
 	return func() (interface{}, string, error) {
 
 		// Retrieve the certificate properties
 		uuid := d.Get("uuid").(string)
-		cert, _, err := client.Certificates.Get(context.Background(), uuid)
+		cert, _, err := client.Certificates.Get(ctx, uuid)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error retrieving certificate: %s", err)
 		}
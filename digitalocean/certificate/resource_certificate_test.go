@@ -96,6 +96,14 @@ func TestAccDigitalOceanCertificate_ExpectedErrors(t *testing.T) {
 				Config:      testAccCheckDigitalOceanCertificateConfig_noDomains(name),
 				ExpectError: regexp.MustCompile("`domains` is required for when type is `lets_encrypt`"),
 			},
+			{
+				Config:      testAccCheckDigitalOceanCertificateConfig_letsEncrypt(name, "foo.*.example.com"),
+				ExpectError: regexp.MustCompile("must only use a wildcard as the left-most label"),
+			},
+			{
+				Config:      testAccCheckDigitalOceanCertificateConfig_letsEncrypt(name, fmt.Sprintf("*.%s.com", acceptance.RandomTestName())),
+				ExpectError: regexp.MustCompile("DNS zone for wildcard certificate domain not found"),
+			},
 		},
 	})
 }
@@ -108,7 +116,7 @@ func testAccCheckDigitalOceanCertificateDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := certificate.FindCertificateByName(client, rs.Primary.ID)
+		_, err := certificate.FindCertificateByName(context.Background(), client, rs.Primary.ID)
 
 		if err != nil && !strings.Contains(err.Error(), "not found") {
 			return fmt.Errorf(
@@ -133,7 +141,7 @@ func testAccCheckDigitalOceanCertificateExists(n string, cert *godo.Certificate)
 
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
-		c, err := certificate.FindCertificateByName(client, rs.Primary.ID)
+		c, err := certificate.FindCertificateByName(context.Background(), client, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -193,3 +201,12 @@ resource "digitalocean_certificate" "foobar" {
   type = "lets_encrypt"
 }`, name)
 }
+
+func testAccCheckDigitalOceanCertificateConfig_letsEncrypt(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_certificate" "foobar" {
+  name    = "%s"
+  type    = "lets_encrypt"
+  domains = ["%s"]
+}`, name, domain)
+}
@@ -7,12 +7,15 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/telemetry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"golang.org/x/oauth2"
 )
@@ -28,6 +31,20 @@ type Config struct {
 	HTTPRetryMax      int
 	HTTPRetryWaitMax  float64
 	HTTPRetryWaitMin  float64
+	APITimeout        int
+	ActionConcurrency int
+	ReadOnly          bool
+	PageSize          int
+
+	PreflightCheck       string
+	PreflightStatusURL   string
+	PreflightWaitTimeout int
+
+	ResourceNamePrefix string
+
+	ExcludeSensitiveOutputs bool
+	CreateMissingTags       bool
+	MetricsEndpoint         string
 }
 
 type CombinedConfig struct {
@@ -35,10 +52,46 @@ type CombinedConfig struct {
 	spacesEndpointTemplate *template.Template
 	accessID               string
 	secretKey              string
+	actionSlots            chan struct{}
+	dropletActionLocks     *dropletLocks
+	readOnly               bool
+	excludeSensitive       bool
+	createMissingTags      bool
+	namePrefix             string
+	statusURL              string
+	telemetry              *telemetry.Recorder
+	sizes                  sizesCache
+	userImages             userImagesCache
 }
 
 func (c *CombinedConfig) GodoClient() *godo.Client { return c.client }
 
+// ReadOnly reports whether the provider is configured to refuse any changes
+// to infrastructure.
+func (c *CombinedConfig) ReadOnly() bool { return c.readOnly }
+
+// ExcludeSensitiveOutputs reports whether computed sensitive attributes
+// should be omitted from the state.
+func (c *CombinedConfig) ExcludeSensitiveOutputs() bool { return c.excludeSensitive }
+
+// CreateMissingTags reports whether tags referenced by resources should be
+// created before the resources are.
+func (c *CombinedConfig) CreateMissingTags() bool { return c.createMissingTags }
+
+// PrefixName returns the name of a resource to be created with the provider's
+// resource_name_prefix prepended to it.
+func (c *CombinedConfig) PrefixName(name string) string { return c.namePrefix + name }
+
+// UnprefixName returns the name of a resource read from the API without the
+// provider's resource_name_prefix, so that it matches the configuration.
+func (c *CombinedConfig) UnprefixName(name string) string {
+	return strings.TrimPrefix(name, c.namePrefix)
+}
+
+// Telemetry returns the recorder of the spans exported to the provider's
+// metrics_endpoint, or nil if it is not set.
+func (c *CombinedConfig) Telemetry() *telemetry.Recorder { return c.telemetry }
+
 func (c *CombinedConfig) SpacesClient(region string) (*session.Session, error) {
 	if c.accessID == "" || c.secretKey == "" {
 		err := fmt.Errorf("Spaces credentials not configured")
@@ -68,6 +121,15 @@ func (c *CombinedConfig) SpacesClient(region string) (*session.Session, error) {
 
 // Client() returns a new client for accessing digital ocean.
 func (c *Config) Client() (*CombinedConfig, error) {
+	statusURL := c.PreflightStatusURL
+	if statusURL == "" {
+		statusURL = DefaultStatusURL
+	}
+	timeout := time.Duration(c.PreflightWaitTimeout) * time.Second
+	if err := preflightCheck(context.Background(), statusURL, c.PreflightCheck, timeout); err != nil {
+		return nil, err
+	}
+
 	tokenSrc := oauth2.StaticTokenSource(&oauth2.Token{
 		AccessToken: c.Token,
 	})
@@ -78,6 +140,12 @@ func (c *Config) Client() (*CombinedConfig, error) {
 
 	client = oauth2.NewClient(context.Background(), tokenSrc)
 
+	// The timeout applies to each attempt when retries are enabled, while the
+	// contexts of the resources' operations bound all of them.
+	if c.APITimeout > 0 {
+		client.Timeout = time.Duration(c.APITimeout) * time.Second
+	}
+
 	if c.HTTPRetryMax > 0 {
 		retryConfig := godo.RetryConfig{
 			RetryMax:     c.HTTPRetryMax,
@@ -96,31 +164,101 @@ func (c *Config) Client() (*CombinedConfig, error) {
 	}
 
 	godoClient, err := godo.New(client, godoOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var recorder *telemetry.Recorder
+	if c.MetricsEndpoint != "" {
+		recorder = telemetry.New(c.MetricsEndpoint, c.TerraformVersion)
+		recorder.Instrument(godoClient.HTTPClient)
+	}
+
 	clientTransport := logging.NewTransport("DigitalOcean", godoClient.HTTPClient.Transport)
 
 	godoClient.HTTPClient.Transport = clientTransport
 
-	if err != nil {
-		return nil, err
+	if c.PageSize > 0 {
+		godoClient.HTTPClient.Transport = &pageSizeTransport{pageSize: c.PageSize, base: clientTransport}
 	}
 
+	godoClient.HTTPClient.Transport = &requestIDTransport{base: godoClient.HTTPClient.Transport}
+
 	apiURL, err := url.Parse(c.APIEndpoint)
 	if err != nil {
 		return nil, err
 	}
 	godoClient.BaseURL = apiURL
 
+	combined, err := NewCombinedConfig(c, godoClient)
+	if err != nil {
+		return nil, err
+	}
+	combined.telemetry = recorder
+
+	return combined, nil
+}
+
+// NewCombinedConfig returns the configuration of the provider making the API
+// requests with the given godo client as is, e.g. to embed the provider in
+// other tools controlling the HTTP layer with a custom transport,
+// instrumentation, or a fake API. Unlike Client, the settings used to build
+// the godo client, such as the token, the API endpoint, the retries, the rate
+// limit, and the page size, are ignored, and neither the preflight check nor
+// the metrics_endpoint are handled.
+func NewCombinedConfig(c *Config, godoClient *godo.Client) (*CombinedConfig, error) {
+	if godoClient == nil {
+		return nil, fmt.Errorf("a godo client is required")
+	}
+
 	spacesEndpointTemplate, err := template.New("spaces").Parse(c.SpacesAPIEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse spaces_endpoint '%s' as template: %s", c.SpacesAPIEndpoint, err)
 	}
 
+	statusURL := c.PreflightStatusURL
+	if statusURL == "" {
+		statusURL = DefaultStatusURL
+	}
+
 	log.Printf("[INFO] DigitalOcean Client configured for URL: %s", godoClient.BaseURL.String())
 
-	return &CombinedConfig{
+	combined := &CombinedConfig{
 		client:                 godoClient,
 		spacesEndpointTemplate: spacesEndpointTemplate,
 		accessID:               c.AccessID,
 		secretKey:              c.SecretKey,
-	}, nil
+		readOnly:               c.ReadOnly,
+		excludeSensitive:       c.ExcludeSensitiveOutputs,
+		createMissingTags:      c.CreateMissingTags,
+		namePrefix:             c.ResourceNamePrefix,
+		statusURL:              statusURL,
+	}
+
+	if c.ActionConcurrency > 0 {
+		combined.actionSlots = make(chan struct{}, c.ActionConcurrency)
+		combined.dropletActionLocks = &dropletLocks{locks: map[int]chan struct{}{}}
+	}
+
+	return combined, nil
+}
+
+// pageSizeTransport sets the number of items per page of the paginated list
+// requests, i.e. those with a page or per_page query parameter, to the
+// provider's page_size.
+type pageSizeTransport struct {
+	pageSize int
+	base     http.RoundTripper
+}
+
+func (t *pageSizeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	if req.Method == http.MethodGet && (query.Has("page") || query.Has("per_page")) {
+		query.Set("per_page", strconv.Itoa(t.pageSize))
+
+		req = req.Clone(req.Context())
+		req.URL.RawQuery = query.Encode()
+	}
+
+	return t.base.RoundTrip(req)
 }
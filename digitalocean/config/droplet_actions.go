@@ -0,0 +1,81 @@
+package config
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// dropletLocks holds a lock for each Droplet actions are run against. The
+// locks are channels rather than mutexes so that waiting for them can be
+// cancelled.
+type dropletLocks struct {
+	mu    sync.Mutex
+	locks map[int]chan struct{}
+}
+
+func (l *dropletLocks) get(dropletID int) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, ok := l.locks[dropletID]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		l.locks[dropletID] = lock
+	}
+
+	return lock
+}
+
+// LockDropletActions serializes actions against the given Droplets across
+// resources and limits the number of Droplet actions in flight to the
+// provider's action_concurrency. The locks of several Droplets, e.g. both
+// Droplets a reserved IP is moved between, are taken in ascending order of
+// their IDs so that resources locking overlapping Droplets do not deadlock.
+// The returned function must be called to release the locks. An error is
+// returned if the context is done before the locks are acquired. When
+// action_concurrency is not set, it is a no-op.
+func (c *CombinedConfig) LockDropletActions(ctx context.Context, dropletIDs ...int) (func(), error) {
+	if c.actionSlots == nil {
+		return func() {}, nil
+	}
+
+	ids := append([]int(nil), dropletIDs...)
+	sort.Ints(ids)
+
+	var held []chan struct{}
+	release := func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			<-held[i]
+		}
+	}
+
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+
+		lock := c.dropletActionLocks.get(id)
+		select {
+		case lock <- struct{}{}:
+			held = append(held, lock)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	// A single action slot is taken however many Droplets are locked, as
+	// taking one per Droplet could deadlock with an action_concurrency of 1.
+	select {
+	case c.actionSlots <- struct{}{}:
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+
+	return func() {
+		<-c.actionSlots
+		release()
+	}, nil
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/digitalocean/godo"
+)
+
+// userImagesCache holds the custom images and snapshots of the account,
+// which are listed at most once per provider process unless invalidated.
+type userImagesCache struct {
+	mu     sync.Mutex
+	images []godo.Image
+}
+
+// UserImages returns the custom images and snapshots of the account. They are
+// cached, as they are looked up by both the plan and the refresh of every
+// image retention policy. The cache must be invalidated before images are
+// deleted based on it.
+func (c *CombinedConfig) UserImages(ctx context.Context) ([]godo.Image, error) {
+	c.userImages.mu.Lock()
+	defer c.userImages.mu.Unlock()
+
+	if c.userImages.images != nil {
+		return c.userImages.images, nil
+	}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	images := []godo.Image{}
+	for {
+		page, resp, err := c.client.Images.ListUser(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving images: %s", err)
+		}
+
+		images = append(images, page...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		current, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving images: %s", err)
+		}
+
+		opts.Page = current + 1
+	}
+
+	c.userImages.images = images
+	return images, nil
+}
+
+// InvalidateUserImages drops the cached images, e.g. once images have been
+// deleted, so that they are listed again by the next call to UserImages.
+func (c *CombinedConfig) InvalidateUserImages() {
+	c.userImages.mu.Lock()
+	defer c.userImages.mu.Unlock()
+
+	c.userImages.images = nil
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+const (
+	// DefaultStatusURL is the status page API reporting incidents of the
+	// DigitalOcean platform.
+	DefaultStatusURL = "https://status.digitalocean.com/api/v2/status.json"
+
+	// preflightPollInterval is the time between status checks while waiting
+	// for an incident to be resolved.
+	preflightPollInterval = 30 * time.Second
+)
+
+// platformStatus is the overall status reported by the status page API.
+type platformStatus struct {
+	Status struct {
+		Indicator   string `json:"indicator"`
+		Description string `json:"description"`
+	} `json:"status"`
+}
+
+// PlatformStatusSummary is the summary reported by the status page API,
+// including the status of each component of the platform.
+type PlatformStatusSummary struct {
+	Page struct {
+		ID        string `json:"id"`
+		UpdatedAt string `json:"updated_at"`
+	} `json:"page"`
+	Status struct {
+		Indicator   string `json:"indicator"`
+		Description string `json:"description"`
+	} `json:"status"`
+	Components []PlatformComponent `json:"components"`
+}
+
+// PlatformComponent is a component of the status page, e.g. the Droplets of
+// a region. Components may be grouped, in which case the group is a component
+// itself referenced by the GroupID of its members.
+type PlatformComponent struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	GroupID     string `json:"group_id"`
+	Group       bool   `json:"group"`
+}
+
+// PlatformStatusSummary returns the summary of the status page configured by
+// the preflight_status_url, whose summary is served next to its status.
+func (c *CombinedConfig) PlatformStatusSummary(ctx context.Context) (*PlatformStatusSummary, error) {
+	summaryURL, err := statusSummaryURL(c.statusURL)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &PlatformStatusSummary{}
+	if err := getStatusPage(ctx, &http.Client{Timeout: 10 * time.Second}, summaryURL, summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// statusSummaryURL returns the URL of the summary of a status page from the
+// URL of its status, replacing the status.json path segment of Statuspage
+// APIs with summary.json.
+func statusSummaryURL(statusURL string) (string, error) {
+	u, err := url.Parse(statusURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid status page URL %q: %s", statusURL, err)
+	}
+
+	if path.Base(u.Path) != "status.json" {
+		return "", fmt.Errorf("the summary of the status page %q is unknown, as its path does not end with status.json", statusURL)
+	}
+
+	u.Path = path.Join(path.Dir(u.Path), "summary.json")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// platformDegraded reports whether the indicator of the status page, one of
+// none, minor, major, or critical, reports an incident likely to make API
+// requests fail.
+func platformDegraded(indicator string) bool {
+	return indicator == "major" || indicator == "critical"
+}
+
+// preflightCheck checks the status of the DigitalOcean platform before any
+// API request is made. With the fail mode, an error is returned if there is
+// an ongoing major incident. With the wait mode, it waits for the incident to
+// be resolved, up to the timeout. If the status page is unreachable, the check
+// is skipped so that it never prevents the provider from being used.
+func preflightCheck(ctx context.Context, statusURL, mode string, timeout time.Duration) error {
+	if mode == "" || mode == "off" {
+		return nil
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		status, err := getPlatformStatus(ctx, httpClient, statusURL)
+		if err != nil {
+			log.Printf("[WARN] Unable to check the status of the DigitalOcean platform, skipping preflight check: %s", err)
+			return nil
+		}
+
+		indicator := status.Status.Indicator
+		if !platformDegraded(indicator) {
+			if indicator != "none" {
+				log.Printf("[WARN] DigitalOcean platform status: %s", status.Status.Description)
+			}
+			return nil
+		}
+
+		if mode != "wait" || time.Now().After(deadline) {
+			return fmt.Errorf("the DigitalOcean platform reports an ongoing incident (%s: %s), see https://status.digitalocean.com for details", indicator, status.Status.Description)
+		}
+
+		log.Printf("[INFO] DigitalOcean platform reports an ongoing incident (%s: %s), waiting for it to be resolved", indicator, status.Status.Description)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(preflightPollInterval):
+		}
+	}
+}
+
+func getPlatformStatus(ctx context.Context, httpClient *http.Client, statusURL string) (*platformStatus, error) {
+	status := &platformStatus{}
+	if err := getStatusPage(ctx, httpClient, statusURL, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func getStatusPage(ctx context.Context, httpClient *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
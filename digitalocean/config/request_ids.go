@@ -0,0 +1,69 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+const headerRequestID = "x-request-id"
+
+type requestIDKey struct{}
+
+// requestID is the ID of the last API request made with a context, if it
+// failed.
+type requestID struct {
+	mu sync.Mutex
+	id string
+}
+
+func (r *requestID) set(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.id = id
+}
+
+func (r *requestID) get() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.id
+}
+
+// WithRequestID returns a context recording the ID returned by the API in the
+// x-request-id header of the last request made with it if that request failed,
+// along with a function returning the recorded ID. A successful request resets
+// the ID, so that failures tolerated by the caller, e.g. a 404 while checking
+// whether a resource exists or a 422 retried until it succeeds, are not
+// reported. Only the requests made by the client returned by Config.Client
+// with the context are recorded.
+func WithRequestID(ctx context.Context) (context.Context, func() string) {
+	id := &requestID{}
+	return context.WithValue(ctx, requestIDKey{}, id), id.get
+}
+
+// requestIDTransport records the request ID of the failed responses in the
+// context of their requests, if created by WithRequestID, and resets it on
+// successful responses. It sees the final response of a request once retries
+// are exhausted.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp == nil {
+		return resp, err
+	}
+
+	if id, ok := req.Context().Value(requestIDKey{}).(*requestID); ok {
+		if resp.StatusCode < http.StatusBadRequest {
+			id.set("")
+		} else {
+			id.set(resp.Header.Get(headerRequestID))
+		}
+	}
+
+	return resp, err
+}
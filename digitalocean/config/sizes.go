@@ -0,0 +1,57 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/digitalocean/godo"
+)
+
+// sizesCache holds the Droplet sizes, which are listed at most once per
+// provider process.
+type sizesCache struct {
+	mu    sync.Mutex
+	sizes []godo.Size
+}
+
+// Sizes returns the Droplet sizes. They are cached for the lifetime of the
+// provider, as they are looked up by the plan and refresh of every resource
+// whose price depends on a size.
+func (c *CombinedConfig) Sizes(ctx context.Context) ([]godo.Size, error) {
+	c.sizes.mu.Lock()
+	defer c.sizes.mu.Unlock()
+
+	if c.sizes.sizes != nil {
+		return c.sizes.sizes, nil
+	}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	sizes := []godo.Size{}
+	for {
+		page, resp, err := c.client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		sizes = append(sizes, page...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		current, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = current + 1
+	}
+
+	c.sizes.sizes = sizes
+	return sizes, nil
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func connectionPoolsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the connection pool",
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the database cluster the connection pool belongs to",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the connection pool",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Description: "user of the connection pool",
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Description: "transaction mode of the connection pool",
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Description: "size of the connection pool",
+		},
+		"db_name": {
+			Type:        schema.TypeString,
+			Description: "name of the connection pool's default database",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "hostname of the connection pool",
+		},
+		"private_host": {
+			Type:        schema.TypeString,
+			Description: "private hostname of the connection pool",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "port of the connection pool",
+		},
+		"uri": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "full URI for connecting to the connection pool",
+		},
+		"private_uri": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "full private URI for connecting to the connection pool",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "password of the connection pool's user",
+		},
+	}
+}
+
+func getDigitalOceanConnectionPools(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	var allPools []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		pools, resp, err := client.Databases.ListPools(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving connection pools: %s", err)
+		}
+
+		for _, pool := range pools {
+			allPools = append(allPools, pool)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving connection pools: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allPools, nil
+}
+
+func flattenDigitalOceanConnectionPool(rawPool interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	pool, ok := rawPool.(godo.DatabasePool)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to godo.DatabasePool")
+	}
+
+	flattenedPool := map[string]interface{}{
+		"id":           createConnectionPoolID(clusterID, pool.Name),
+		"cluster_id":   clusterID,
+		"name":         pool.Name,
+		"user":         pool.User,
+		"mode":         pool.Mode,
+		"size":         pool.Size,
+		"db_name":      pool.Database,
+		"host":         "",
+		"private_host": "",
+		"port":         0,
+		"uri":          "",
+		"private_uri":  "",
+		"password":     "",
+	}
+
+	if pool.Connection != nil {
+		uri, err := buildPoolConnectionURI(pool.Connection)
+		if err != nil {
+			return nil, err
+		}
+
+		flattenedPool["host"] = pool.Connection.Host
+		flattenedPool["port"] = pool.Connection.Port
+		flattenedPool["password"] = pool.Connection.Password
+		flattenedPool["uri"] = uri
+	}
+
+	if pool.PrivateConnection != nil {
+		privateURI, err := buildPoolConnectionURI(pool.PrivateConnection)
+		if err != nil {
+			return nil, err
+		}
+
+		flattenedPool["private_host"] = pool.PrivateConnection.Host
+		flattenedPool["private_uri"] = privateURI
+	}
+
+	return flattenedPool, nil
+}
+
+// buildPoolConnectionURI ensures the connection's password is included in
+// its URI using the credentials returned alongside the connection itself.
+func buildPoolConnectionURI(conn *godo.DatabaseConnection) (string, error) {
+	uri, err := url.Parse(conn.URI)
+	if err != nil {
+		return "", err
+	}
+
+	if conn.Password != "" {
+		uri.User = url.UserPassword(conn.User, conn.Password)
+	}
+
+	return uri.String(), nil
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const databaseUserPath = "v2/databases/%s/users/%s"
+
+// databasePostgresUserSettings are the settings of PostgreSQL users, which
+// are not supported by godo yet.
+type databasePostgresUserSettings struct {
+	// PGAllowReplication is whether the role has the REPLICATION attribute.
+	PGAllowReplication *bool `json:"pg_allow_replication,omitempty"`
+}
+
+type databasePostgresUserSettingsRequest struct {
+	Settings *databasePostgresUserSettings `json:"settings"`
+}
+
+type databaseUserRawRoot struct {
+	User json.RawMessage `json:"user"`
+}
+
+// getDatabaseUser retrieves a database user along with its PostgreSQL
+// settings, which are nil for the users of other engines.
+func getDatabaseUser(ctx context.Context, client *godo.Client, clusterID, name string) (*godo.DatabaseUser, *databasePostgresUserSettings, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(databaseUserPath, clusterID, name), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	root := new(databaseUserRawRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	user := new(godo.DatabaseUser)
+	if err := json.Unmarshal(root.User, user); err != nil {
+		return nil, nil, resp, err
+	}
+
+	pgUser := new(databasePostgresUserSettingsRequest)
+	if err := json.Unmarshal(root.User, pgUser); err != nil {
+		return nil, nil, resp, err
+	}
+
+	var settings *databasePostgresUserSettings
+	if pgUser.Settings != nil && pgUser.Settings.PGAllowReplication != nil {
+		settings = pgUser.Settings
+	}
+
+	return user, settings, resp, nil
+}
+
+// updateDatabaseUserPostgresSettings updates the settings of a PostgreSQL user.
+func updateDatabaseUserPostgresSettings(ctx context.Context, client *godo.Client, clusterID, name string, settings *databasePostgresUserSettings) error {
+	req, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf(databaseUserPath, clusterID, name), &databasePostgresUserSettingsRequest{
+		Settings: settings,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
@@ -32,7 +32,7 @@ func dataSourceDigitalOceanDatabaseCARead(ctx context.Context, d *schema.Resourc
 	clusterID := d.Get("cluster_id").(string)
 	d.SetId(clusterID)
 
-	ca, _, err := client.Databases.GetCA(context.Background(), clusterID)
+	ca, _, err := client.Databases.GetCA(ctx, clusterID)
 	if err != nil {
 		return diag.Errorf("Error retrieving database CA certificate: %s", err)
 	}
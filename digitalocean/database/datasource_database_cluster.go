@@ -178,7 +178,7 @@ func dataSourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Re
 	var databaseList []godo.Database
 
 	for {
-		databases, resp, err := client.Databases.List(context.Background(), opts)
+		databases, resp, err := client.Databases.List(ctx, opts)
 		if err != nil {
 			return diag.Errorf("Error retrieving DatabaseClusters: %s", err)
 		}
@@ -0,0 +1,57 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseConnectionPools_Basic(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+	poolName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, poolName)
+	datasourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatasourceDatabaseConnectionPoolsConfigBasic, poolName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.all", "pools.#", "1"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "id",
+						"data.digitalocean_database_connection_pools.all", "pools.0.id"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "name",
+						"data.digitalocean_database_connection_pools.all", "pools.0.name"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "mode",
+						"data.digitalocean_database_connection_pools.all", "pools.0.mode"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "size",
+						"data.digitalocean_database_connection_pools.all", "pools.0.size"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "db_name",
+						"data.digitalocean_database_connection_pools.all", "pools.0.db_name"),
+					resource.TestCheckResourceAttrPair("digitalocean_database_connection_pool.pool-01", "uri",
+						"data.digitalocean_database_connection_pools.all", "pools.0.uri"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_connection_pools.all", "pools.0.private_uri"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatasourceDatabaseConnectionPoolsConfigBasic = `
+data "digitalocean_database_connection_pools" "all" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}`
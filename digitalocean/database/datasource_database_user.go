@@ -46,6 +46,10 @@ func DataSourceDigitalOceanDatabaseUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pg_allow_replication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"settings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -69,7 +73,7 @@ func dataSourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resou
 	clusterID := d.Get("cluster_id").(string)
 	name := d.Get("name").(string)
 
-	user, resp, err := client.Databases.GetUser(context.Background(), clusterID, name)
+	user, pgSettings, resp, err := getDatabaseUser(ctx, client, clusterID, name)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return diag.Errorf("Database user not found: %s", err)
@@ -85,6 +89,10 @@ func dataSourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resou
 		d.Set("mysql_auth_plugin", user.MySQLSettings.AuthPlugin)
 	}
 
+	if pgSettings != nil {
+		d.Set("pg_allow_replication", *pgSettings.PGAllowReplication)
+	}
+
 	if user.AccessCert != "" {
 		d.Set("access_cert", user.AccessCert)
 	}
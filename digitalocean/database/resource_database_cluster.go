@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -12,8 +13,10 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -21,6 +24,7 @@ import (
 const (
 	mysqlDBEngineSlug = "mysql"
 	redisDBEngineSlug = "redis"
+	kafkaDBEngineSlug = "kafka"
 )
 
 func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
@@ -38,7 +42,7 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: util.ValidateDatabaseClusterName,
 			},
 
 			"engine": {
@@ -136,6 +140,13 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"require_private_network": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether private_network_uuid must be set, placing the database cluster in a known VPC; the public hostname of the cluster remains enabled",
+			},
+
 			"project_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -219,6 +230,27 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "the CA certificate used to verify the TLS connection to the database cluster",
+			},
+
+			"kafka_access_cert": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "the client certificate of the default user of a Kafka cluster",
+			},
+
+			"kafka_access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "the client key of the default user of a Kafka cluster",
+			},
+
 			"urn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -226,6 +258,8 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 
 			"tags": tag.TagsSchema(),
 
+			"tags_authoritative": tag.TagsAuthoritativeSchema(),
+
 			"backup_restore": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -249,15 +283,44 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"trusted_sources": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "the only sources allowed to connect to the cluster, managing its firewall rules; conflicts with digitalocean_database_firewall",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ip_addr",
+								"droplet",
+								"k8s",
+								"tag",
+								"app",
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			transitionVersionToRequired(),
 			validateExclusiveAttributes(),
+			validatePrivateNetworking(),
+			validateVersionUpgrade(),
 		),
 	}
 }
@@ -298,6 +361,109 @@ func validateExclusiveAttributes() schema.CustomizeDiffFunc {
 	})
 }
 
+// validatePrivateNetworking fails the plan of clusters with
+// require_private_network = true which are not explicitly placed in a VPC. The API does not allow the
+// public hostname of a cluster to be disabled, so this ensures that clients
+// can at least use its private hostname in a known VPC.
+func validatePrivateNetworking() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if !diff.Get("require_private_network").(bool) {
+			return nil
+		}
+
+		if diff.GetRawConfig().GetAttr("private_network_uuid").IsNull() {
+			return fmt.Errorf("private_network_uuid must be set when require_private_network is true")
+		}
+
+		return nil
+	})
+}
+
+// validateVersionUpgrade checks the major version upgrades during the plan, as
+// the API only rejects them once the cluster is being updated.
+func validateVersionUpgrade() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || diff.HasChange("engine") || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+			return nil
+		}
+
+		engine := diff.Get("engine").(string)
+		old, new := diff.GetChange("version")
+		if old.(string) == "" {
+			return nil
+		}
+
+		if compareDatabaseVersions(new.(string), old.(string)) < 0 {
+			return fmt.Errorf("The %s version of the database cluster cannot be downgraded from %s to %s", engine, old, new)
+		}
+
+		client := v.(*config.CombinedConfig).GodoClient()
+		options, _, err := client.Databases.ListOptions(ctx)
+		if err != nil {
+			log.Printf("[WARN] Unable to retrieve the database options to check the upgrade to version %s: %s", new, err)
+			return nil
+		}
+
+		versions := databaseEngineVersions(options, engine)
+		if versions == nil {
+			return nil
+		}
+		for _, version := range versions {
+			if version == new.(string) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("The database cluster cannot be upgraded to %s version %s, the available versions are: %s",
+			engine, new, strings.Join(versions, ", "))
+	})
+}
+
+// databaseEngineVersions returns the versions available for the engine, or nil
+// if the engine is unknown.
+func databaseEngineVersions(options *godo.DatabaseOptions, engine string) []string {
+	switch engine {
+	case "pg":
+		return options.PostgresSQLOptions.Versions
+	case mysqlDBEngineSlug:
+		return options.MySQLOptions.Versions
+	case redisDBEngineSlug:
+		return options.RedisOptions.Versions
+	case "mongodb":
+		return options.MongoDBOptions.Versions
+	case kafkaDBEngineSlug:
+		return options.KafkaOptions.Versions
+	case "opensearch":
+		return options.OpensearchOptions.Versions
+	default:
+		return nil
+	}
+}
+
+// compareDatabaseVersions compares two versions such as "8" or "3.7"
+// component by component, returning -1, 0 or 1.
+func compareDatabaseVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
 func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -331,7 +497,7 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("[DEBUG] database cluster create configuration: %#v", opts)
-	database, _, err := client.Databases.Create(context.Background(), opts)
+	database, _, err := client.Databases.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating database cluster: %s", err)
 	}
@@ -344,7 +510,7 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 	d.SetId(database.ID)
 	log.Printf("[INFO] database cluster Name: %s", database.Name)
 
-	database, err = waitForDatabaseCluster(client, d, "online")
+	database, err = waitForDatabaseCluster(ctx, client, d, "online")
 	if err != nil {
 		d.SetId("")
 		return diag.Errorf("Error creating database cluster: %s", err)
@@ -353,7 +519,7 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 	if v, ok := d.GetOk("maintenance_window"); ok {
 		opts := expandMaintWindowOpts(v.([]interface{}))
 
-		resp, err := client.Databases.UpdateMaintenance(context.Background(), d.Id(), opts)
+		resp, err := client.Databases.UpdateMaintenance(ctx, d.Id(), opts)
 		if err != nil {
 			// If the database is somehow already destroyed, mark as
 			// successfully gone
@@ -367,25 +533,51 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 	}
 
 	if policy, ok := d.GetOk("eviction_policy"); ok {
-		_, err := client.Databases.SetEvictionPolicy(context.Background(), d.Id(), policy.(string))
+		_, err := client.Databases.SetEvictionPolicy(ctx, d.Id(), policy.(string))
 		if err != nil {
 			return diag.Errorf("Error adding eviction policy for database cluster: %s", err)
 		}
 	}
 
 	if mode, ok := d.GetOk("sql_mode"); ok {
-		_, err := client.Databases.SetSQLMode(context.Background(), d.Id(), mode.(string))
+		_, err := client.Databases.SetSQLMode(ctx, d.Id(), mode.(string))
 		if err != nil {
 			return diag.Errorf("Error adding SQL mode for database cluster: %s", err)
 		}
 	}
 
+	if v, ok := d.GetOk("trusted_sources"); ok {
+		rules := expandDatabaseTrustedSources(v.(*schema.Set).List())
+		if _, err := client.Databases.UpdateFirewallRules(ctx, d.Id(), rules); err != nil {
+			return diag.Errorf("Error adding trusted sources for database cluster: %s", err)
+		}
+	}
+
+	// The CA certificate may not be available as soon as the cluster is
+	// online, so wait for it to not miss it in the first read.
+	err = util.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		if _, resp, err := client.Databases.GetCA(ctx, d.Id()); err != nil {
+			if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode >= http.StatusInternalServerError) {
+				log.Printf("[DEBUG] CA certificate of database cluster (%s) is not available yet: %s", d.Id(), err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("Error retrieving CA certificate for database cluster: %s", err)
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	// Changes of the size, e.g. to or from dedicated CPU plans, and of the node
+	// count are made by a single resize.
 	if d.HasChanges("size", "node_count", "storage_size_mib") {
 		opts := &godo.DatabaseResizeRequest{
 			SizeSlug: d.Get("size").(string),
@@ -401,7 +593,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 				opts.StorageSizeMib = v
 			}
 		}
-		resp, err := client.Databases.Resize(context.Background(), d.Id(), opts)
+		resp, err := client.Databases.Resize(ctx, d.Id(), opts)
 		if err != nil {
 			// If the database is somehow already destroyed, mark as
 			// successfully gone
@@ -413,7 +605,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error resizing database cluster: %s", err)
 		}
 
-		_, err = waitForDatabaseCluster(client, d, "online")
+		err = waitForDatabaseClusterResize(ctx, client, d, opts)
 		if err != nil {
 			return diag.Errorf("Error resizing database cluster: %s", err)
 		}
@@ -424,7 +616,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			Region: d.Get("region").(string),
 		}
 
-		resp, err := client.Databases.Migrate(context.Background(), d.Id(), opts)
+		resp, err := client.Databases.Migrate(ctx, d.Id(), opts)
 		if err != nil {
 			// If the database is somehow already destroyed, mark as
 			// successfully gone
@@ -436,7 +628,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
 
-		_, err = waitForDatabaseCluster(client, d, "online")
+		_, err = waitForDatabaseCluster(ctx, client, d, "online")
 		if err != nil {
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
@@ -445,7 +637,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 	if d.HasChange("maintenance_window") {
 		opts := expandMaintWindowOpts(d.Get("maintenance_window").([]interface{}))
 
-		resp, err := client.Databases.UpdateMaintenance(context.Background(), d.Id(), opts)
+		resp, err := client.Databases.UpdateMaintenance(ctx, d.Id(), opts)
 		if err != nil {
 			// If the database is somehow already destroyed, mark as
 			// successfully gone
@@ -460,13 +652,13 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 
 	if d.HasChange("eviction_policy") {
 		if policy, ok := d.GetOk("eviction_policy"); ok {
-			_, err := client.Databases.SetEvictionPolicy(context.Background(), d.Id(), policy.(string))
+			_, err := client.Databases.SetEvictionPolicy(ctx, d.Id(), policy.(string))
 			if err != nil {
 				return diag.Errorf("Error updating eviction policy for database cluster: %s", err)
 			}
 		} else {
 			// If the eviction policy is completely removed from the config, set to noeviction
-			_, err := client.Databases.SetEvictionPolicy(context.Background(), d.Id(), godo.EvictionPolicyNoEviction)
+			_, err := client.Databases.SetEvictionPolicy(ctx, d.Id(), godo.EvictionPolicyNoEviction)
 			if err != nil {
 				return diag.Errorf("Error updating eviction policy for database cluster: %s", err)
 			}
@@ -474,7 +666,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 	}
 
 	if d.HasChange("sql_mode") {
-		_, err := client.Databases.SetSQLMode(context.Background(), d.Id(), d.Get("sql_mode").(string))
+		_, err := client.Databases.SetSQLMode(ctx, d.Id(), d.Get("sql_mode").(string))
 		if err != nil {
 			return diag.Errorf("Error updating SQL mode for database cluster: %s", err)
 		}
@@ -482,26 +674,40 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 
 	if d.HasChange("version") {
 		upgradeVersionReq := &godo.UpgradeVersionRequest{Version: d.Get("version").(string)}
-		_, err := client.Databases.UpgradeMajorVersion(context.Background(), d.Id(), upgradeVersionReq)
+		_, err := client.Databases.UpgradeMajorVersion(ctx, d.Id(), upgradeVersionReq)
 		if err != nil {
 			return diag.Errorf("Error upgrading version for database cluster: %s", err)
 		}
+
+		err = waitForDatabaseClusterUpgrade(ctx, client, d, upgradeVersionReq.Version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("tags") {
-		err := tag.SetTags(client, d, godo.DatabaseResourceType)
+		err := tag.SetTags(ctx, client, d, godo.DatabaseResourceType)
 		if err != nil {
 			return diag.Errorf("Error updating tags: %s", err)
 		}
 	}
 
+	// Removing all the trusted sources removes the firewall rules, allowing
+	// connections from anywhere again.
+	if d.HasChange("trusted_sources") {
+		rules := expandDatabaseTrustedSources(d.Get("trusted_sources").(*schema.Set).List())
+		if _, err := client.Databases.UpdateFirewallRules(ctx, d.Id(), rules); err != nil {
+			return diag.Errorf("Error updating trusted sources for database cluster: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	database, resp, err := client.Databases.Get(context.Background(), d.Id())
+	database, resp, err := client.Databases.Get(ctx, d.Id())
 	if err != nil {
 		// If the database is somehow already destroyed, mark as
 		// successfully gone
@@ -520,7 +726,7 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	d.Set("region", database.RegionSlug)
 	d.Set("node_count", database.NumNodes)
 	d.Set("storage_size_mib", strconv.FormatUint(database.StorageSizeMib, 10))
-	d.Set("tags", tag.FlattenTags(database.Tags))
+	tag.SetManagedTags(d, database.Tags)
 
 	if _, ok := d.GetOk("maintenance_window"); ok {
 		if err := d.Set("maintenance_window", flattenMaintWindowOpts(*database.MaintenanceWindow)); err != nil {
@@ -529,7 +735,7 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	}
 
 	if _, ok := d.GetOk("eviction_policy"); ok {
-		policy, _, err := client.Databases.GetEvictionPolicy(context.Background(), d.Id())
+		policy, _, err := client.Databases.GetEvictionPolicy(ctx, d.Id())
 		if err != nil {
 			return diag.Errorf("Error retrieving eviction policy for database cluster: %s", err)
 		}
@@ -538,7 +744,7 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	}
 
 	if _, ok := d.GetOk("sql_mode"); ok {
-		mode, _, err := client.Databases.GetSQLMode(context.Background(), d.Id())
+		mode, _, err := client.Databases.GetSQLMode(ctx, d.Id())
 		if err != nil {
 			return diag.Errorf("Error retrieving SQL mode for database cluster: %s", err)
 		}
@@ -546,6 +752,17 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 		d.Set("sql_mode", mode)
 	}
 
+	if _, ok := d.GetOk("trusted_sources"); ok {
+		rules, _, err := client.Databases.GetFirewallRules(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving trusted sources for database cluster: %s", err)
+		}
+
+		if err := d.Set("trusted_sources", flattenDatabaseTrustedSources(rules)); err != nil {
+			return diag.Errorf("[DEBUG] Error setting trusted_sources - error: %#v", err)
+		}
+	}
+
 	// Computed values
 	err = setDatabaseConnectionInfo(database, d)
 	if err != nil {
@@ -557,18 +774,36 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("Error setting ui connection info for database cluster: %s", err)
 	}
 
+	// The TLS info rarely changes, so failing to refresh it keeps the values
+	// in the state rather than failing the refresh.
+	var diags diag.Diagnostics
+	if err := setDatabaseTLSInfo(ctx, client, database, d); err != nil {
+		log.Printf("[WARN] Unable to refresh TLS info of database cluster (%s): %s", d.Id(), err)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to refresh the TLS info of the database cluster",
+			Detail:   fmt.Sprintf("The previous ca_certificate, kafka_access_cert, and kafka_access_key are kept: %s", err),
+		})
+	}
+
 	d.Set("urn", database.URN())
 	d.Set("private_network_uuid", database.PrivateNetworkUUID)
 	d.Set("project_id", database.ProjectID)
 
-	return nil
+	// require_private_network is not returned by the API, so it is only set
+	// to its default when importing.
+	if _, ok := d.GetOkExists("require_private_network"); !ok {
+		d.Set("require_private_network", false)
+	}
+
+	return diags
 }
 
 func resourceDigitalOceanDatabaseClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting database cluster: %s", d.Id())
-	_, err := client.Databases.Delete(context.Background(), d.Id())
+	_, err := client.Databases.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting database cluster: %s", err)
 	}
@@ -577,7 +812,7 @@ func resourceDigitalOceanDatabaseClusterDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
-func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status string) (*godo.Database, error) {
+func waitForDatabaseCluster(ctx context.Context, client *godo.Client, d *schema.ResourceData, status string) (*godo.Database, error) {
 	var (
 		tickerInterval = 15 * time.Second
 		timeoutSeconds = d.Timeout(schema.TimeoutCreate).Seconds()
@@ -587,7 +822,7 @@ func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status
 	)
 
 	for range ticker.C {
-		database, resp, err := client.Databases.Get(context.Background(), d.Id())
+		database, resp, err := client.Databases.Get(ctx, d.Id())
 		if resp.StatusCode == 404 {
 			continue
 		}
@@ -613,6 +848,87 @@ func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status
 	return nil, fmt.Errorf("Timeout waiting to database cluster to become %s", status)
 }
 
+// waitForDatabaseClusterResize waits for the cluster to be online with the
+// requested size and number of nodes. The cluster may still be reported as
+// online for a moment after the resize was requested, so its status alone
+// does not tell whether the resize finished.
+func waitForDatabaseClusterResize(ctx context.Context, client *godo.Client, d *schema.ResourceData, opts *godo.DatabaseResizeRequest) error {
+	var (
+		tickerInterval = 15 * time.Second
+		timeoutSeconds = d.Timeout(schema.TimeoutUpdate).Seconds()
+		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		n              = 0
+		ticker         = time.NewTicker(tickerInterval)
+	)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		database, resp, err := client.Databases.Get(ctx, d.Id())
+		if resp != nil && resp.StatusCode == 404 {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.Status == "online" && database.SizeSlug == opts.SizeSlug && database.NumNodes == opts.NumNodes &&
+			(opts.StorageSizeMib == 0 || database.StorageSizeMib == opts.StorageSizeMib) {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Waiting for database cluster (%s) to be resized, status: %s, size: %s, nodes: %d",
+			d.Id(), database.Status, database.SizeSlug, database.NumNodes)
+
+		if n >= timeout {
+			break
+		}
+
+		n++
+	}
+
+	return fmt.Errorf("Timeout waiting for database cluster to be resized to %s with %d nodes", opts.SizeSlug, opts.NumNodes)
+}
+
+// waitForDatabaseClusterUpgrade waits for the cluster to be back online with
+// the requested version.
+func waitForDatabaseClusterUpgrade(ctx context.Context, client *godo.Client, d *schema.ResourceData, version string) error {
+	var (
+		tickerInterval = 15 * time.Second
+		timeoutSeconds = d.Timeout(schema.TimeoutUpdate).Seconds()
+		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		n              = 0
+		ticker         = time.NewTicker(tickerInterval)
+	)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		database, resp, err := client.Databases.Get(ctx, d.Id())
+		if resp != nil && resp.StatusCode == 404 {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.Status == "online" && database.VersionSlug == version {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Waiting for database cluster (%s) to be upgraded, status: %s, version: %s",
+			d.Id(), database.Status, database.VersionSlug)
+
+		if n >= timeout {
+			break
+		}
+
+		n++
+	}
+
+	return fmt.Errorf("Timeout waiting for database cluster to be upgraded to version %s", version)
+}
+
 func expandMaintWindowOpts(config []interface{}) *godo.DatabaseUpdateMaintenanceRequest {
 	maintWindowOpts := &godo.DatabaseUpdateMaintenanceRequest{}
 	configMap := config[0].(map[string]interface{})
@@ -711,6 +1027,55 @@ func buildDBPrivateURI(conn *godo.DatabaseConnection, d *schema.ResourceData) (s
 	return buildDBConnectionURI(conn, d)
 }
 
+// setDatabaseTLSInfo sets the CA certificate of the cluster and, for Kafka,
+// the client certificate and key of the default user.
+func setDatabaseTLSInfo(ctx context.Context, client *godo.Client, database *godo.Database, d *schema.ResourceData) error {
+	ca, _, err := client.Databases.GetCA(ctx, database.ID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CA certificate: %s", err)
+	}
+	d.Set("ca_certificate", string(ca.Certificate))
+
+	if database.EngineSlug == kafkaDBEngineSlug && database.Connection != nil && database.Connection.User != "" {
+		user, _, err := client.Databases.GetUser(ctx, database.ID, database.Connection.User)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Kafka user %s: %s", database.Connection.User, err)
+		}
+
+		d.Set("kafka_access_cert", user.AccessCert)
+		d.Set("kafka_access_key", user.AccessKey)
+	}
+
+	return nil
+}
+
+func expandDatabaseTrustedSources(config []interface{}) *godo.DatabaseUpdateFirewallRulesRequest {
+	rules := make([]*godo.DatabaseFirewallRule, 0, len(config))
+	for _, raw := range config {
+		source := raw.(map[string]interface{})
+		rules = append(rules, &godo.DatabaseFirewallRule{
+			Type:  source["type"].(string),
+			Value: source["value"].(string),
+		})
+	}
+
+	return &godo.DatabaseUpdateFirewallRulesRequest{
+		Rules: rules,
+	}
+}
+
+func flattenDatabaseTrustedSources(rules []godo.DatabaseFirewallRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"type":  rule.Type,
+			"value": rule.Value,
+		})
+	}
+
+	return result
+}
+
 func expandBackupRestore(config []interface{}) *godo.DatabaseBackupRestore {
 	backupRestoreConfig := config[0].(map[string]interface{})
 
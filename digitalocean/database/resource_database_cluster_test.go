@@ -3,6 +3,8 @@ package database_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"regexp"
 	"testing"
@@ -11,10 +13,61 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/database"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDigitalOceanDatabaseClusterReadTLSInfoUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/databases/cluster-id":
+			w.Write([]byte(`{"database": {"id": "cluster-id", "name": "foo", "engine": "pg", "version": "16", "num_nodes": 1, "size": "db-s-1vcpu-1gb", "region": "nyc1", "status": "online"}}`))
+		case "/v2/databases/cluster-id/ca":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"id": "service_unavailable", "message": "unavailable"}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := database.ResourceDigitalOceanDatabaseCluster()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       "foo",
+		"engine":     "pg",
+		"size":       "db-s-1vcpu-1gb",
+		"region":     "nyc1",
+		"node_count": 1,
+	})
+	d.SetId("cluster-id")
+	d.Set("ca_certificate", "previous-ca")
+
+	diags := r.ReadContext(context.Background(), d, meta)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected a warning, got %v", diags)
+	}
+	if ca := d.Get("ca_certificate").(string); ca != "previous-ca" {
+		t.Errorf("Expected the previous CA certificate to be kept, got %q", ca)
+	}
+}
+
 func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
@@ -57,6 +110,10 @@ func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 						"digitalocean_database_cluster.foobar", "project_id"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_cluster.foobar", "storage_size_mib"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "ca_certificate"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "kafka_access_cert", ""),
 					testAccCheckDigitalOceanDatabaseClusterURIPassword(
 						"digitalocean_database_cluster.foobar", "uri"),
 					testAccCheckDigitalOceanDatabaseClusterURIPassword(
@@ -107,6 +164,44 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_ResizeSizeAndNodeCount(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigDedicatedCPU, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "size", "gd-2vcpu-8gb"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "node_count", "2"),
+					func(s *terraform.State) error {
+						if database.SizeSlug != "gd-2vcpu-8gb" || database.NumNodes != 2 {
+							return fmt.Errorf("expected the database cluster to be resized, got %s with %d nodes", database.SizeSlug, database.NumNodes)
+						}
+						if database.Status != "online" {
+							return fmt.Errorf("expected the database cluster to be online, got %s", database.Status)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_WithAdditionalStorage(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
@@ -251,6 +346,22 @@ func TestAccDigitalOceanDatabaseCluster_CheckSQLModeSupport(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_CheckRequirePrivateNetwork(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigRequirePrivateNetwork, databaseName),
+				ExpectError: regexp.MustCompile(`private_network_uuid must be set when require_private_network is true`),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_RedisNoVersion(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
@@ -403,6 +514,56 @@ func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_TrustedSources(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigTrustedSources, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "trusted_sources.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_database_cluster.foobar", "trusted_sources.*",
+						map[string]string{
+							"type":  "ip_addr",
+							"value": "192.168.1.1",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_database_cluster.foobar", "trusted_sources.*",
+						map[string]string{
+							"type":  "tag",
+							"value": "production",
+						},
+					),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigTrustedSourcesUpdate, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "trusted_sources.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_database_cluster.foobar", "trusted_sources.*",
+						map[string]string{
+							"type":  "ip_addr",
+							"value": "192.168.1.2",
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_WithVPC(t *testing.T) {
 	var database godo.Database
 	vpcName := acceptance.RandomTestName()
@@ -503,6 +664,34 @@ func TestAccDigitalOceanDatabaseCluster_MongoDBPassword(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_KafkaTLS(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterKafka, databaseName, "3.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists(
+						"digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "engine", "kafka"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "ca_certificate"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "kafka_access_cert"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "kafka_access_key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_Upgrade(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
@@ -536,6 +725,14 @@ func TestAccDigitalOceanDatabaseCluster_Upgrade(t *testing.T) {
 						"digitalocean_database_cluster.foobar", "version", latestPGVersion),
 				),
 			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", previousPGVersion),
+				ExpectError: regexp.MustCompile("cannot be downgraded from 15 to 14"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigCustomVersion, databaseName, "pg", "99"),
+				ExpectError: regexp.MustCompile("cannot be upgraded to pg version 99"),
+			},
 		},
 	})
 }
@@ -720,6 +917,28 @@ resource "digitalocean_database_cluster" "foobar" {
   tags       = ["production"]
 }`
 
+const testAccCheckDigitalOceanDatabaseClusterConfigRequirePrivateNetwork = `
+resource "digitalocean_database_cluster" "foobar" {
+  name                    = "%s"
+  engine                  = "pg"
+  version                 = "15"
+  size                    = "db-s-1vcpu-1gb"
+  region                  = "nyc1"
+  node_count              = 1
+  require_private_network = true
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigDedicatedCPU = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "gd-2vcpu-8gb"
+  region     = "nyc1"
+  node_count = 2
+  tags       = ["production"]
+}`
+
 const testAccCheckDigitalOceanDatabaseClusterConfigWithBackupRestore = `
 resource "digitalocean_database_cluster" "foobar_backup" {
   name       = "%s"
@@ -971,3 +1190,38 @@ resource "digitalocean_database_cluster" "foobar" {
   node_count = 1
   project_id = digitalocean_project.foobar.id
 }`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigTrustedSources = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+
+  trusted_sources {
+    type  = "ip_addr"
+    value = "192.168.1.1"
+  }
+
+  trusted_sources {
+    type  = "tag"
+    value = "production"
+  }
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigTrustedSourcesUpdate = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+
+  trusted_sources {
+    type  = "ip_addr"
+    value = "192.168.1.2"
+  }
+}`
@@ -26,9 +26,19 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"cluster_id", "replica_id"},
+			},
+
+			"replica_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"cluster_id", "replica_id"},
+				Description:  "the UUID of the read-only replica to create the connection pool for",
 			},
 
 			"name": {
@@ -108,7 +118,7 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 func resourceDigitalOceanDatabaseConnectionPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	clusterID := d.Get("cluster_id").(string)
+	clusterID := connectionPoolClusterID(d)
 	opts := &godo.DatabaseCreatePoolRequest{
 		Name:     d.Get("name").(string),
 		User:     d.Get("user").(string),
@@ -118,7 +128,7 @@ func resourceDigitalOceanDatabaseConnectionPoolCreate(ctx context.Context, d *sc
 	}
 
 	log.Printf("[DEBUG] DatabaseConnectionPool create configuration: %#v", opts)
-	pool, _, err := client.Databases.CreatePool(context.Background(), clusterID, opts)
+	pool, _, err := client.Databases.CreatePool(ctx, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseConnectionPool: %s", err)
 	}
@@ -138,7 +148,7 @@ func resourceDigitalOceanDatabaseConnectionPoolRead(ctx context.Context, d *sche
 	client := meta.(*config.CombinedConfig).GodoClient()
 	clusterID, poolName := splitConnectionPoolID(d.Id())
 
-	pool, resp, err := client.Databases.GetPool(context.Background(), clusterID, poolName)
+	pool, resp, err := client.Databases.GetPool(ctx, clusterID, poolName)
 	if err != nil {
 		// If the pool is somehow already destroyed, mark as
 		// successfully gone
@@ -151,7 +161,11 @@ func resourceDigitalOceanDatabaseConnectionPoolRead(ctx context.Context, d *sche
 	}
 
 	d.SetId(createConnectionPoolID(clusterID, pool.Name))
-	d.Set("cluster_id", clusterID)
+	if _, ok := d.GetOk("replica_id"); ok {
+		d.Set("replica_id", clusterID)
+	} else {
+		d.Set("cluster_id", clusterID)
+	}
 	d.Set("name", pool.Name)
 	d.Set("user", pool.User)
 	d.Set("mode", pool.Mode)
@@ -198,13 +212,19 @@ func setConnectionPoolInfo(pool *godo.DatabasePool, d *schema.ResourceData) erro
 }
 
 func resourceDigitalOceanDatabaseConnectionPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if strings.Contains(d.Id(), ",") {
-		s := strings.Split(d.Id(), ",")
+	s := strings.Split(d.Id(), ",")
+	switch {
+	case len(s) == 3 && s[0] == "replica":
+		d.SetId(createConnectionPoolID(s[1], s[2]))
+		d.Set("replica_id", s[1])
+		d.Set("name", s[2])
+	case len(s) == 2:
 		d.SetId(createConnectionPoolID(s[0], s[1]))
 		d.Set("cluster_id", s[0])
 		d.Set("name", s[1])
-	} else {
-		return nil, errors.New("must use the ID of the source database cluster and the name of the connection pool joined with a comma (e.g. `id,name`)")
+	default:
+		return nil, errors.New("must use the ID of the source database cluster and the name of the connection pool joined with a comma (e.g. `id,name`), " +
+			"or for the pool of a read-only replica, `replica` followed by the UUID of the replica and the name of the pool (e.g. `replica,uuid,name`)")
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -215,7 +235,7 @@ func resourceDigitalOceanDatabaseConnectionPoolDelete(ctx context.Context, d *sc
 	clusterID, poolName := splitConnectionPoolID(d.Id())
 
 	log.Printf("[INFO] Deleting DatabaseConnectionPool: %s", poolName)
-	_, err := client.Databases.DeletePool(context.Background(), clusterID, poolName)
+	_, err := client.Databases.DeletePool(ctx, clusterID, poolName)
 	if err != nil {
 		return diag.Errorf("Error deleting DatabaseConnectionPool: %s", err)
 	}
@@ -224,6 +244,17 @@ func resourceDigitalOceanDatabaseConnectionPoolDelete(ctx context.Context, d *sc
 	return nil
 }
 
+// connectionPoolClusterID returns the ID of the cluster or read-only replica
+// the connection pool belongs to. The pools of a replica are managed using the
+// replica's UUID in place of the cluster ID.
+func connectionPoolClusterID(d *schema.ResourceData) string {
+	if replicaID, ok := d.GetOk("replica_id"); ok {
+		return replicaID.(string)
+	}
+
+	return d.Get("cluster_id").(string)
+}
+
 func createConnectionPoolID(clusterID string, poolName string) string {
 	return fmt.Sprintf("%s/%s", clusterID, poolName)
 }
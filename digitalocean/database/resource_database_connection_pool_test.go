@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -109,6 +110,52 @@ func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseConnectionPool_Replica(t *testing.T) {
+	var databaseConnectionPool godo.DatabasePool
+	databaseName := acceptance.RandomTestName()
+	replicaName := acceptance.RandomTestName("replica")
+	databaseConnectionPoolName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigReplica, databaseName, replicaName, databaseConnectionPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
+					testAccCheckDigitalOceanDatabaseConnectionPoolAttributes(&databaseConnectionPool, databaseConnectionPoolName),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_connection_pool.pool-01", "replica_id",
+						"digitalocean_database_replica.read-01", "uuid"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "cluster_id", ""),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "mode", "transaction"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_connection_pool.pool-01", "host"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_connection_pool.pool-01", "uri"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_database_connection_pool.pool-01",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["digitalocean_database_connection_pool.pool-01"]
+					if !ok {
+						return "", fmt.Errorf("Not found: digitalocean_database_connection_pool.pool-01")
+					}
+
+					return fmt.Sprintf("replica,%s,%s", rs.Primary.Attributes["replica_id"], rs.Primary.Attributes["name"]), nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseConnectionPool_BadModeName(t *testing.T) {
 	databaseName := acceptance.RandomTestName()
 	databaseConnectionPoolName := acceptance.RandomTestName()
@@ -133,7 +180,8 @@ func testAccCheckDigitalOceanDatabaseConnectionPoolDestroy(s *terraform.State) e
 		if rs.Type != "digitalocean_database_connection_pool" {
 			continue
 		}
-		clusterId := rs.Primary.Attributes["cluster_id"]
+		// The ID holds the ID of the cluster or the UUID of the replica
+		clusterId := strings.Split(rs.Primary.ID, "/")[0]
 		name := rs.Primary.Attributes["name"]
 		// Try to find the database connection_pool
 		_, _, err := client.Databases.GetPool(context.Background(), clusterId, name)
@@ -159,7 +207,7 @@ func testAccCheckDigitalOceanDatabaseConnectionPoolExists(n string, database *go
 		}
 
 		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
-		clusterId := rs.Primary.Attributes["cluster_id"]
+		clusterId := strings.Split(rs.Primary.ID, "/")[0]
 		name := rs.Primary.Attributes["name"]
 
 		foundDatabaseConnectionPool, _, err := client.Databases.GetPool(context.Background(), clusterId, name)
@@ -262,3 +310,29 @@ resource "digitalocean_database_connection_pool" "pool-01" {
   size       = 10
   db_name    = "defaultdb"
 }`
+
+const testAccCheckDigitalOceanDatabaseConnectionPoolConfigReplica = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "digitalocean_database_replica" "read-01" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+  region     = "nyc1"
+  size       = "db-s-1vcpu-1gb"
+}
+
+resource "digitalocean_database_connection_pool" "pool-01" {
+  replica_id = digitalocean_database_replica.read-01.uuid
+  name       = "%s"
+  mode       = "transaction"
+  size       = 10
+  db_name    = "defaultdb"
+  user       = "doadmin"
+}`
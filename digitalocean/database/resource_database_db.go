@@ -49,7 +49,7 @@ func resourceDigitalOceanDatabaseDBCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Database DB create configuration: %#v", opts)
-	db, _, err := client.Databases.CreateDB(context.Background(), clusterID, opts)
+	db, _, err := client.Databases.CreateDB(ctx, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Database DB: %s", err)
 	}
@@ -66,7 +66,7 @@ func resourceDigitalOceanDatabaseDBRead(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 
 	// Check if the database DB still exists
-	_, resp, err := client.Databases.GetDB(context.Background(), clusterID, name)
+	_, resp, err := client.Databases.GetDB(ctx, clusterID, name)
 	if err != nil {
 		// If the database DB is somehow already destroyed, mark as
 		// successfully gone
@@ -87,7 +87,7 @@ func resourceDigitalOceanDatabaseDBDelete(ctx context.Context, d *schema.Resourc
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting Database DB: %s", d.Id())
-	_, err := client.Databases.DeleteDB(context.Background(), clusterID, name)
+	_, err := client.Databases.DeleteDB(ctx, clusterID, name)
 	if err != nil {
 		return diag.Errorf("Error deleting Database DB: %s", err)
 	}
@@ -76,7 +76,7 @@ func resourceDigitalOceanDatabaseFirewallCreate(ctx context.Context, d *schema.R
 
 	rules := buildDatabaseFirewallRequest(d.Get("rule").(*schema.Set).List())
 
-	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &rules)
+	_, err := client.Databases.UpdateFirewallRules(ctx, clusterID, &rules)
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseFirewall: %s", err)
 	}
@@ -90,7 +90,7 @@ func resourceDigitalOceanDatabaseFirewallRead(ctx context.Context, d *schema.Res
 	client := meta.(*config.CombinedConfig).GodoClient()
 	clusterID := d.Get("cluster_id").(string)
 
-	rules, resp, err := client.Databases.GetFirewallRules(context.TODO(), clusterID)
+	rules, resp, err := client.Databases.GetFirewallRules(ctx, clusterID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -113,7 +113,7 @@ func resourceDigitalOceanDatabaseFirewallUpdate(ctx context.Context, d *schema.R
 
 	rules := buildDatabaseFirewallRequest(d.Get("rule").(*schema.Set).List())
 
-	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &rules)
+	_, err := client.Databases.UpdateFirewallRules(ctx, clusterID, &rules)
 	if err != nil {
 		return diag.Errorf("Error updating DatabaseFirewall: %s", err)
 	}
@@ -130,7 +130,7 @@ func resourceDigitalOceanDatabaseFirewallDelete(ctx context.Context, d *schema.R
 		Rules: []*godo.DatabaseFirewallRule{},
 	}
 
-	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &req)
+	_, err := client.Databases.UpdateFirewallRules(ctx, clusterID, &req)
 	if err != nil {
 		return diag.Errorf("Error deleting DatabaseFirewall: %s", err)
 	}
@@ -309,7 +309,7 @@ func resourceDigitalOceanDatabaseKafkaTopicCreate(ctx context.Context, d *schema
 	}
 
 	log.Printf("[DEBUG] Database kafka topic create configuration: %#v", opts)
-	topic, _, err := client.Databases.CreateTopic(context.Background(), clusterID, opts)
+	topic, _, err := client.Databases.CreateTopic(ctx, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating database kafka topic: %s", err)
 	}
@@ -338,7 +338,7 @@ func resourceDigitalOceanDatabaseKafkaTopicUpdate(ctx context.Context, d *schema
 	}
 
 	log.Printf("[DEBUG] Database kafka topic update configuration: %#v", opts)
-	_, err := client.Databases.UpdateTopic(context.Background(), clusterID, topicName, opts)
+	_, err := client.Databases.UpdateTopic(ctx, clusterID, topicName, opts)
 	if err != nil {
 		return diag.Errorf("Error updating database kafka topic: %s", err)
 	}
@@ -0,0 +1,221 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanDatabaseKafkaTopicRetention manages the retention of
+// several existing topics of a Kafka cluster, e.g. topics created by
+// applications rather than by Terraform.
+func ResourceDigitalOceanDatabaseKafkaTopicRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseKafkaTopicRetentionUpdate,
+		ReadContext:   resourceDigitalOceanDatabaseKafkaTopicRetentionRead,
+		UpdateContext: resourceDigitalOceanDatabaseKafkaTopicRetentionUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseKafkaTopicRetentionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanDatabaseKafkaTopicRetentionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			// A list rather than a set, as the retention which is not set is
+			// computed from the topic and would change the hash of set items.
+			"topic": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"retention_bytes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateInt64(),
+						},
+						"retention_ms": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateInt64(),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: validateKafkaTopicRetentionNames,
+	}
+}
+
+// validateKafkaTopicRetentionNames checks each topic is only listed once, as
+// the topics are identified by their name.
+func validateKafkaTopicRetentionNames(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	names := map[string]bool{}
+	for i, raw := range diff.Get("topic").([]interface{}) {
+		if !diff.NewValueKnown(fmt.Sprintf("topic.%d.name", i)) {
+			continue
+		}
+
+		name := raw.(map[string]interface{})["name"].(string)
+		if names[name] {
+			return fmt.Errorf("kafka topic %s is listed more than once", name)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseKafkaTopicRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	for _, raw := range d.Get("topic").([]interface{}) {
+		t := raw.(map[string]interface{})
+		name := t["name"].(string)
+
+		// The rest of the topic is sent unchanged, so that only its retention
+		// is updated.
+		topic, _, err := client.Databases.GetTopic(ctx, clusterID, name)
+		if err != nil {
+			return diag.Errorf("Error retrieving kafka topic (%s): %s", name, err)
+		}
+
+		topicConfig := topic.Config
+		if topicConfig == nil {
+			topicConfig = &godo.TopicConfig{}
+		}
+		if v, _ := t["retention_bytes"].(string); v != "" {
+			bytes, _ := strconv.ParseInt(v, 10, 64)
+			topicConfig.RetentionBytes = godo.PtrTo(bytes)
+		}
+		if v, _ := t["retention_ms"].(string); v != "" {
+			ms, _ := strconv.ParseInt(v, 10, 64)
+			topicConfig.RetentionMS = godo.PtrTo(ms)
+		}
+
+		opts := &godo.DatabaseUpdateTopicRequest{
+			ReplicationFactor: topic.ReplicationFactor,
+			Config:            topicConfig,
+		}
+		if len(topic.Partitions) > 0 {
+			opts.PartitionCount = godo.PtrTo(uint32(len(topic.Partitions)))
+		}
+
+		log.Printf("[DEBUG] Database kafka topic (%s) retention update configuration: %#v", name, opts)
+		if _, err := client.Databases.UpdateTopic(ctx, clusterID, name, opts); err != nil {
+			return diag.Errorf("Error updating retention of kafka topic (%s): %s", name, err)
+		}
+	}
+
+	d.SetId(makeKafkaTopicRetentionID(clusterID))
+
+	return resourceDigitalOceanDatabaseKafkaTopicRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseKafkaTopicRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	topics := []map[string]interface{}{}
+	for _, raw := range d.Get("topic").([]interface{}) {
+		name := raw.(map[string]interface{})["name"].(string)
+
+		topic, resp, err := client.Databases.GetTopic(ctx, clusterID, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("[WARN] Kafka topic (%s) not found, removing it from the retention of cluster (%s)", name, clusterID)
+				continue
+			}
+
+			return diag.Errorf("Error retrieving kafka topic (%s): %s", name, err)
+		}
+
+		topics = append(topics, flattenKafkaTopicRetention(topic))
+	}
+
+	if len(topics) == 0 {
+		log.Printf("[WARN] No kafka topic of the retention (%s) found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("topic", topics); err != nil {
+		return diag.Errorf("Error setting topic: %s", err)
+	}
+
+	return nil
+}
+
+// The retention of the topics is left as is, as there is no way to know what
+// it was before it was managed.
+func resourceDigitalOceanDatabaseKafkaTopicRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// The retention of all the topics of the cluster is imported.
+func resourceDigitalOceanDatabaseKafkaTopicRetentionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := strings.TrimSuffix(d.Id(), "/topic-retention")
+	topics, _, err := client.Databases.ListTopics(ctx, clusterID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing kafka topics of cluster (%s): %s", clusterID, err)
+	}
+
+	names := []map[string]interface{}{}
+	for _, topic := range topics {
+		names = append(names, map[string]interface{}{"name": topic.Name})
+	}
+
+	d.SetId(makeKafkaTopicRetentionID(clusterID))
+	d.Set("cluster_id", clusterID)
+	d.Set("topic", names)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenKafkaTopicRetention(topic *godo.DatabaseTopic) map[string]interface{} {
+	item := map[string]interface{}{
+		"name":            topic.Name,
+		"retention_bytes": "",
+		"retention_ms":    "",
+	}
+
+	if topic.Config != nil {
+		if topic.Config.RetentionBytes != nil {
+			item["retention_bytes"] = strconv.FormatInt(*topic.Config.RetentionBytes, 10)
+		}
+		if topic.Config.RetentionMS != nil {
+			item["retention_ms"] = strconv.FormatInt(*topic.Config.RetentionMS, 10)
+		}
+	}
+
+	return item
+}
+
+func makeKafkaTopicRetentionID(clusterID string) string {
+	return fmt.Sprintf("%s/topic-retention", clusterID)
+}
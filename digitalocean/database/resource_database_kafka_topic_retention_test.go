@@ -0,0 +1,124 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/database"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDigitalOceanDatabaseKafkaTopicRetentionDiff(t *testing.T) {
+	r := database.ResourceDigitalOceanDatabaseKafkaTopicRetention()
+	state := &terraform.InstanceState{
+		ID: "cluster-id/topic-retention",
+		Attributes: map[string]string{
+			"id":                      "cluster-id/topic-retention",
+			"cluster_id":              "cluster-id",
+			"topic.#":                 "2",
+			"topic.0.name":            "topic-a",
+			"topic.0.retention_bytes": "1073741824",
+			"topic.0.retention_ms":    "86400000",
+			"topic.1.name":            "topic-b",
+			"topic.1.retention_bytes": "-1",
+			"topic.1.retention_ms":    "3600000",
+		},
+	}
+
+	// The retention_bytes of topic-b is not set, so its current value is kept.
+	conf := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_id": "cluster-id",
+		"topic": []interface{}{
+			map[string]interface{}{"name": "topic-a", "retention_bytes": "1073741824", "retention_ms": "86400000"},
+			map[string]interface{}{"name": "topic-b", "retention_ms": "3600000"},
+		},
+	})
+	diff, err := r.Diff(context.Background(), state, conf, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no diff, got %#v", diff.Attributes)
+	}
+
+	conf = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_id": "cluster-id",
+		"topic": []interface{}{
+			map[string]interface{}{"name": "topic-a", "retention_ms": "86400000"},
+			map[string]interface{}{"name": "topic-a", "retention_ms": "3600000"},
+		},
+	})
+	_, err = r.Diff(context.Background(), state, conf, nil)
+	if err == nil || !regexp.MustCompile("kafka topic topic-a is listed more than once").MatchString(err.Error()) {
+		t.Errorf("Expected an error for the duplicate topic, got %v", err)
+	}
+}
+
+func TestAccDigitalOceanDatabaseKafkaTopicRetention(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterKafka, name, "3.5")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseKafkaTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseKafkaTopicRetentionConfig, dbConfig, "1073741824", "86400000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.0.name", "topic-a"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.0.retention_bytes", "1073741824"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.0.retention_ms", "86400000"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.1.name", "topic-b"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.1.retention_bytes"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.1.retention_ms", "3600000"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseKafkaTopicRetentionConfig, dbConfig, "2147483648", "172800000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.0.retention_bytes", "2147483648"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_kafka_topic_retention.foobar", "topic.0.retention_ms", "172800000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseKafkaTopicRetentionConfig = `
+%s
+
+resource "digitalocean_database_kafka_topic" "foobar" {
+  for_each   = toset(["topic-a", "topic-b"])
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = each.key
+}
+
+resource "digitalocean_database_kafka_topic_retention" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  topic {
+    name            = digitalocean_database_kafka_topic.foobar["topic-a"].name
+    retention_bytes = %s
+    retention_ms    = %s
+  }
+
+  topic {
+    name         = digitalocean_database_kafka_topic.foobar["topic-b"].name
+    retention_ms = 3600000
+  }
+}`
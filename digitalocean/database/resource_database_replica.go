@@ -161,8 +161,8 @@ func resourceDigitalOceanDatabaseReplicaCreate(ctx context.Context, d *schema.Re
 
 	// Retry requests that fail w. Failed Precondition (412). New DBs can be marked ready while
 	// first backup is still being created.
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		rc, resp, err := client.Databases.CreateReplica(context.Background(), clusterId, opts)
+	err := util.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		rc, resp, err := client.Databases.CreateReplica(ctx, clusterId, opts)
 		if err != nil {
 			if resp.StatusCode == 412 {
 				return resource.RetryableError(err)
@@ -184,7 +184,7 @@ func resourceDigitalOceanDatabaseReplicaCreate(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error building connection URI: %s", err)
 	}
 
-	replica, err := waitForDatabaseReplica(client, clusterId, "online", replicaCluster.Name)
+	replica, err := waitForDatabaseReplica(ctx, client, clusterId, "online", replicaCluster.Name)
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseReplica: %s", err)
 	}
@@ -207,7 +207,7 @@ func resourceDigitalOceanDatabaseReplicaRead(ctx context.Context, d *schema.Reso
 	client := meta.(*config.CombinedConfig).GodoClient()
 	clusterId := d.Get("cluster_id").(string)
 	name := d.Get("name").(string)
-	replica, resp, err := client.Databases.GetReplica(context.Background(), clusterId, name)
+	replica, resp, err := client.Databases.GetReplica(ctx, clusterId, name)
 	if err != nil {
 		// If the database is somehow already destroyed, mark as
 		// successfully gone
@@ -287,7 +287,7 @@ func resourceDigitalOceanDatabaseReplicaUpdate(ctx context.Context, d *schema.Re
 			}
 		}
 
-		resp, err := client.Databases.Resize(context.Background(), replicaID, opts)
+		resp, err := client.Databases.Resize(ctx, replicaID, opts)
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				d.SetId("")
@@ -297,7 +297,7 @@ func resourceDigitalOceanDatabaseReplicaUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error resizing database replica: %s", err)
 		}
 
-		_, err = waitForDatabaseReplica(client, clusterID, "online", replicaName)
+		_, err = waitForDatabaseReplica(ctx, client, clusterID, "online", replicaName)
 		if err != nil {
 			return diag.Errorf("Error resizing database replica: %s", err)
 		}
@@ -325,7 +325,7 @@ func resourceDigitalOceanDatabaseReplicaDelete(ctx context.Context, d *schema.Re
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting DatabaseReplica: %s", d.Id())
-	_, err := client.Databases.DeleteReplica(context.Background(), clusterId, name)
+	_, err := client.Databases.DeleteReplica(ctx, clusterId, name)
 	if err != nil {
 		return diag.Errorf("Error deleting DatabaseReplica: %s", err)
 	}
@@ -338,13 +338,13 @@ func makeReplicaId(clusterId string, replicaName string) string {
 	return fmt.Sprintf("%s/replicas/%s", clusterId, replicaName)
 }
 
-func waitForDatabaseReplica(client *godo.Client, cluster_id, status, name string) (*godo.DatabaseReplica, error) {
+func waitForDatabaseReplica(ctx context.Context, client *godo.Client, cluster_id, status, name string) (*godo.DatabaseReplica, error) {
 	ticker := time.NewTicker(15 * time.Second)
 	timeout := 120
 	n := 0
 
 	for range ticker.C {
-		replica, resp, err := client.Databases.GetReplica(context.Background(), cluster_id, name)
+		replica, resp, err := client.Databases.GetReplica(ctx, cluster_id, name)
 		if resp.StatusCode == 404 {
 			continue
 		}
@@ -27,6 +27,8 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 			State: resourceDigitalOceanDatabaseUserImport,
 		},
 
+		CustomizeDiff: resourceDigitalOceanDatabaseUserDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -65,6 +67,17 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 					},
 				},
 			},
+			"pg_allow_replication": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "whether the PostgreSQL user can initiate streaming replication, i.e. has the REPLICATION role attribute",
+			},
+			"rotate_credentials": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "an arbitrary value which, when changed, resets the credentials of the user",
+			},
 			"role": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -138,7 +151,7 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 	defer mutexKV.Unlock(key)
 
 	log.Printf("[DEBUG] Database User create configuration: %#v", opts)
-	user, _, err := client.Databases.CreateUser(context.Background(), clusterID, opts)
+	user, _, err := client.Databases.CreateUser(ctx, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Database User: %s", err)
 	}
@@ -153,6 +166,18 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 
 	setDatabaseUserAttributes(d, user)
 
+	if v, ok := d.GetOkExists("pg_allow_replication"); ok {
+		allowReplication := v.(bool)
+		err := updateDatabaseUserPostgresSettings(ctx, client, clusterID, user.Name, &databasePostgresUserSettings{
+			PGAllowReplication: &allowReplication,
+		})
+		if err != nil {
+			return diag.Errorf("Error updating pg_allow_replication for Database User: %s", err)
+		}
+
+		return resourceDigitalOceanDatabaseUserRead(ctx, d, meta)
+	}
+
 	return nil
 }
 
@@ -162,7 +187,7 @@ func resourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resourc
 	name := d.Get("name").(string)
 
 	// Check if the database user still exists
-	user, resp, err := client.Databases.GetUser(context.Background(), clusterID, name)
+	user, pgSettings, resp, err := getDatabaseUser(ctx, client, clusterID, name)
 	if err != nil {
 		// If the database user is somehow already destroyed, mark as
 		// successfully gone
@@ -176,6 +201,11 @@ func resourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resourc
 
 	setDatabaseUserAttributes(d, user)
 
+	// Reading the role attributes back shows changes made with ALTER ROLE.
+	if pgSettings != nil {
+		d.Set("pg_allow_replication", *pgSettings.PGAllowReplication)
+	}
+
 	return nil
 }
 
@@ -206,9 +236,41 @@ func setDatabaseUserAttributes(d *schema.ResourceData, user *godo.DatabaseUser)
 	}
 }
 
+// The credentials of the user are unknown when planning to rotate them.
+func resourceDigitalOceanDatabaseUserDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("rotate_credentials") {
+		return nil
+	}
+
+	for _, k := range []string{"password", "access_cert", "access_key"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if d.HasChange("rotate_credentials") {
+		authReq := &godo.DatabaseResetUserAuthRequest{}
+		if v := d.Get("mysql_auth_plugin").(string); v != "" {
+			authReq.MySQLSettings = &godo.DatabaseMySQLUserSettings{
+				AuthPlugin: v,
+			}
+		}
+
+		user, _, err := client.Databases.ResetUserAuth(ctx, d.Get("cluster_id").(string), d.Get("name").(string), authReq)
+		if err != nil {
+			return diag.Errorf("Error rotating credentials for DatabaseUser: %s", err)
+		}
+
+		// The new credentials may only be returned when they are reset.
+		setDatabaseUserAttributes(d, user)
+	}
+
 	if d.HasChange("mysql_auth_plugin") {
 		authReq := &godo.DatabaseResetUserAuthRequest{}
 		if d.Get("mysql_auth_plugin").(string) != "" {
@@ -222,7 +284,7 @@ func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.Resou
 			}
 		}
 
-		_, _, err := client.Databases.ResetUserAuth(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), authReq)
+		_, _, err := client.Databases.ResetUserAuth(ctx, d.Get("cluster_id").(string), d.Get("name").(string), authReq)
 		if err != nil {
 			return diag.Errorf("Error updating mysql_auth_plugin for DatabaseUser: %s", err)
 		}
@@ -232,11 +294,20 @@ func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.Resou
 		if v, ok := d.GetOk("settings"); ok {
 			updateReq.Settings = expandUserSettings(v.([]interface{}))
 		}
-		_, _, err := client.Databases.UpdateUser(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), updateReq)
+		_, _, err := client.Databases.UpdateUser(ctx, d.Get("cluster_id").(string), d.Get("name").(string), updateReq)
 		if err != nil {
 			return diag.Errorf("Error updating settings for DatabaseUser: %s", err)
 		}
 	}
+	if d.HasChange("pg_allow_replication") {
+		allowReplication := d.Get("pg_allow_replication").(bool)
+		err := updateDatabaseUserPostgresSettings(ctx, client, d.Get("cluster_id").(string), d.Get("name").(string), &databasePostgresUserSettings{
+			PGAllowReplication: &allowReplication,
+		})
+		if err != nil {
+			return diag.Errorf("Error updating pg_allow_replication for DatabaseUser: %s", err)
+		}
+	}
 
 	return resourceDigitalOceanDatabaseUserRead(ctx, d, meta)
 }
@@ -252,7 +323,7 @@ func resourceDigitalOceanDatabaseUserDelete(ctx context.Context, d *schema.Resou
 	defer mutexKV.Unlock(key)
 
 	log.Printf("[INFO] Deleting Database User: %s", d.Id())
-	_, err := client.Databases.DeleteUser(context.Background(), clusterID, name)
+	_, err := client.Databases.DeleteUser(ctx, clusterID, name)
 	if err != nil {
 		return diag.Errorf("Error deleting Database User: %s", err)
 	}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/database"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -183,6 +186,80 @@ func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 	})
 }
 
+func TestDigitalOceanDatabaseUserReadPostgresSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/databases/cluster-id/users/replicator" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// The REPLICATION attribute was granted outside of Terraform.
+		w.Write([]byte(`{"user": {"name": "replicator", "role": "normal", "settings": {"pg_allow_replication": true}}}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := database.ResourceDigitalOceanDatabaseUser()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster_id":           "cluster-id",
+		"name":                 "replicator",
+		"pg_allow_replication": false,
+	})
+	d.SetId("cluster-id/user/replicator")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if !d.Get("pg_allow_replication").(bool) {
+		t.Errorf("Expected pg_allow_replication to be read back as true")
+	}
+	if role := d.Get("role").(string); role != "normal" {
+		t.Errorf("Expected role normal, got %q", role)
+	}
+}
+
+func TestAccDigitalOceanDatabaseUser_PostgresReplication(t *testing.T) {
+	var databaseUser godo.DatabaseUser
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigPostgresReplication, databaseClusterName, databaseUserName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "pg_allow_replication", "true"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_user.foobar_user", "password"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigPostgresReplication, databaseClusterName, databaseUserName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "pg_allow_replication", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseUser_KafkaACLs(t *testing.T) {
 	var databaseUser godo.DatabaseUser
 	databaseClusterName := acceptance.RandomTestName()
@@ -260,6 +337,49 @@ func TestAccDigitalOceanDatabaseUser_KafkaACLs(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseUser_KafkaRotateCredentials(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+
+	var accessCert string
+	saveAccessCert := func(value string) error {
+		accessCert = value
+		return nil
+	}
+	checkAccessCertRotated := func(value string) error {
+		if value == "" || value == accessCert {
+			return fmt.Errorf("Expected access_cert to be rotated")
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaRotate, databaseClusterName, databaseUserName, "2024-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_user.foobar_user", "access_key"),
+					resource.TestCheckResourceAttrWith(
+						"digitalocean_database_user.foobar_user", "access_cert", saveAccessCert),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigKafkaRotate, databaseClusterName, databaseUserName, "2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "settings.0.acl.0.topic", "topic-1"),
+					resource.TestCheckResourceAttrWith(
+						"digitalocean_database_user.foobar_user", "access_cert", checkAccessCertRotated),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseUserDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -373,6 +493,22 @@ resource "digitalocean_database_user" "foobar_user" {
   name       = "%s"
 }`
 
+const testAccCheckDigitalOceanDatabaseUserConfigPostgresReplication = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id           = digitalocean_database_cluster.foobar.id
+  name                 = "%s"
+  pg_allow_replication = %t
+}`
+
 const testAccCheckDigitalOceanDatabaseUserConfigMongo = `
 resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
@@ -528,3 +664,25 @@ resource "digitalocean_database_user" "foobar_user" {
   cluster_id = digitalocean_database_cluster.foobar.id
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDatabaseUserConfigKafkaRotate = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "kafka"
+  version    = "3.5"
+  size       = "db-s-2vcpu-2gb"
+  region     = "nyc1"
+  node_count = 3
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id         = digitalocean_database_cluster.foobar.id
+  name               = "%s"
+  rotate_credentials = "%s"
+  settings {
+    acl {
+      topic      = "topic-1"
+      permission = "produce"
+    }
+  }
+}`
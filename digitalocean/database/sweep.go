@@ -34,7 +34,7 @@ func testSweepDatabaseCluster(region string) error {
 	}
 
 	for _, db := range databases {
-		if strings.HasPrefix(db.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(db.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying database cluster %s", db.Name)
 
 			if _, err := client.Databases.Delete(context.Background(), db.ID); err != nil {
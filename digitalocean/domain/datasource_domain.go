@@ -45,7 +45,7 @@ func dataSourceDigitalOceanDomainRead(ctx context.Context, d *schema.ResourceDat
 
 	name := d.Get("name").(string)
 
-	domain, resp, err := client.Domains.Get(context.Background(), name)
+	domain, resp, err := client.Domains.Get(ctx, name)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return diag.Errorf("domain not found: %s", err)
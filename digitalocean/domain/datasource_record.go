@@ -79,7 +79,7 @@ func dataSourceDigitalOceanRecordRead(ctx context.Context, d *schema.ResourceDat
 	domain := d.Get("domain").(string)
 	name := d.Get("name").(string)
 
-	record, err := findRecordByName(client, domain, name)
+	record, err := findRecordByName(ctx, client, domain, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,14 +98,14 @@ func dataSourceDigitalOceanRecordRead(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func findRecordByName(client *godo.Client, domain, name string) (*godo.DomainRecord, error) {
+func findRecordByName(ctx context.Context, client *godo.Client, domain, name string) (*godo.DomainRecord, error) {
 	opts := &godo.ListOptions{
 		Page:    1,
 		PerPage: 200,
 	}
 
 	for {
-		records, resp, err := client.Domains.Records(context.Background(), domain, opts)
+		records, resp, err := client.Domains.Records(ctx, domain, opts)
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				return nil, fmt.Errorf("domain not found: %s", err)
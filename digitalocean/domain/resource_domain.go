@@ -6,6 +6,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,6 +16,7 @@ func ResourceDigitalOceanDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDomainCreate,
 		ReadContext:   resourceDigitalOceanDomainRead,
+		UpdateContext: resourceDigitalOceanDomainUpdate,
 		DeleteContext: resourceDigitalOceanDomainDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -41,6 +43,7 @@ func ResourceDigitalOceanDomain() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"project_id": project.ProjectIDSchema(),
 		},
 	}
 }
@@ -59,7 +62,7 @@ func resourceDigitalOceanDomainCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] Domain create configuration: %#v", opts)
-	domain, _, err := client.Domains.Create(context.Background(), opts)
+	domain, _, err := client.Domains.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Domain: %s", err)
 	}
@@ -67,13 +70,19 @@ func resourceDigitalOceanDomainCreate(ctx context.Context, d *schema.ResourceDat
 	d.SetId(domain.Name)
 	log.Printf("[INFO] Domain Name: %s", domain.Name)
 
+	if v, ok := d.GetOk("project_id"); ok {
+		if err := project.AssignResource(ctx, client, v.(string), domain.URN()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDigitalOceanDomainRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	domain, resp, err := client.Domains.Get(context.Background(), d.Id())
+	domain, resp, err := client.Domains.Get(ctx, d.Id())
 	if err != nil {
 		// If the domain is somehow already destroyed, mark as
 		// successfully gone
@@ -89,14 +98,29 @@ func resourceDigitalOceanDomainRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("urn", domain.URN())
 	d.Set("ttl", domain.TTL)
 
+	if err := project.ReadProjectID(ctx, client, d, domain.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
+// Only the project_id of a domain can be updated.
+func resourceDigitalOceanDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := project.UpdateProjectID(ctx, client, d, godo.Domain{Name: d.Id()}.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanDomainRead(ctx, d, meta)
+}
+
 func resourceDigitalOceanDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting Domain: %s", d.Id())
-	_, err := client.Domains.Delete(context.Background(), d.Id())
+	_, err := client.Domains.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting Domain: %s", err)
 	}
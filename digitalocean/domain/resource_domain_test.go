@@ -3,11 +3,14 @@ package domain_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -64,6 +67,118 @@ func TestAccDigitalOceanDomain_WithoutIp(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDomain_ProjectID(t *testing.T) {
+	domainName := acceptance.RandomTestName() + ".com"
+	projectName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDomainConfig_projectID, projectName, projectName, domainName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDomainInProject("digitalocean_project.first", "digitalocean_domain.foobar"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDomainConfig_projectID, projectName, projectName, domainName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDomainInProject("digitalocean_project.second", "digitalocean_domain.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func TestDigitalOceanDomainProjectID(t *testing.T) {
+	var assignedTo string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/domains/example.com":
+			w.Write([]byte(`{"domain": {"name": "example.com", "ttl": 1800}}`))
+		case r.URL.Path == "/v2/projects/project-id/resources":
+			// The domain was moved to another project outside of Terraform.
+			w.Write([]byte(`{"resources": [{"urn": "do:droplet:1"}], "links": {}}`))
+		case r.URL.Path == "/v2/projects/default":
+			w.Write([]byte(`{"project": {"id": "default-id", "is_default": true}}`))
+		case r.URL.Path == "/v2/projects/default-id/resources" && r.Method == http.MethodPost:
+			assignedTo = "default-id"
+			w.Write([]byte(`{"resources": [{"urn": "do:domain:example.com"}]}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := domain.ResourceDigitalOceanDomain()
+	state := &terraform.InstanceState{
+		ID: "example.com",
+		Attributes: map[string]string{
+			"id":         "example.com",
+			"name":       "example.com",
+			"project_id": "project-id",
+		},
+	}
+
+	refreshed, diags := r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if projectID := refreshed.Attributes["project_id"]; projectID != "" {
+		t.Errorf("Expected the project_id to be cleared, got %q", projectID)
+	}
+
+	// Removing the project_id moves the domain back to the default project.
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example.com",
+	}), meta)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if assignedTo != "default-id" {
+		t.Errorf("Expected the domain to be assigned to the default project, got %q", assignedTo)
+	}
+}
+
+func testAccCheckDigitalOceanDomainInProject(project, domain string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		projectID := s.RootModule().Resources[project].Primary.ID
+		urn := s.RootModule().Resources[domain].Primary.Attributes["urn"]
+
+		resources, _, err := client.Projects.ListResources(context.Background(), projectID, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range resources {
+			if r.URN == urn {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Domain %s not found in project %s", urn, projectID)
+	}
+}
+
 func testAccCheckDigitalOceanDomainDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -134,3 +249,17 @@ const testAccCheckDigitalOceanDomainConfig_withoutIp = `
 resource "digitalocean_domain" "foobar" {
   name = "%s"
 }`
+
+const testAccCheckDigitalOceanDomainConfig_projectID = `
+resource "digitalocean_project" "first" {
+  name = "%s-first"
+}
+
+resource "digitalocean_project" "second" {
+  name = "%s-second"
+}
+
+resource "digitalocean_domain" "foobar" {
+  name       = "%s"
+  project_id = digitalocean_project.%s.id
+}`
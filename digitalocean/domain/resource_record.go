@@ -2,8 +2,10 @@ package domain
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 
@@ -34,11 +36,14 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 					"AAAA",
 					"CAA",
 					"CNAME",
+					"HTTPS",
 					"MX",
 					"NS",
 					"TXT",
 					"SRV",
 					"SOA",
+					"SVCB",
+					"TLSA",
 				}, false),
 			},
 
@@ -91,7 +96,21 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					domain := d.Get("domain").(string) + "."
 
-					return (old == "@" && new == domain) || (old == new+"."+domain)
+					if (old == "@" && new == domain) || (old == new+"."+domain) {
+						return true
+					}
+
+					switch d.Get("type").(string) {
+					case "CAA":
+						// The trailing dot is added to CAA values when read, but
+						// they are never relative to the domain.
+						return old == new+"."
+					case "TLSA":
+						// The certificate association data is hex encoded.
+						return strings.EqualFold(old, new)
+					}
+
+					return false
 				},
 			},
 
@@ -115,41 +134,16 @@ func ResourceDigitalOceanRecord() *schema.Resource {
 					"iodef",
 				}, false),
 			},
-		},
-
-		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
-			recordType := diff.Get("type").(string)
-
-			_, hasPriority := diff.GetOkExists("priority")
-			if recordType == "MX" {
-				if !hasPriority {
-					return fmt.Errorf("`priority` is required for when type is `MX`")
-				}
-			}
-
-			_, hasWeight := diff.GetOkExists("weight")
-			if recordType == "SRV" {
-				if !hasPriority {
-					return fmt.Errorf("`priority` is required for when type is `SRV`")
-				}
-				if !hasWeight {
-					return fmt.Errorf("`weight` is required for when type is `SRV`")
-				}
-			}
 
-			_, hasFlags := diff.GetOkExists("flags")
-			_, hasTag := diff.GetOk("tag")
-			if recordType == "CAA" {
-				if !hasFlags {
-					return fmt.Errorf("`flags` is required for when type is `CAA`")
-				}
-				if !hasTag {
-					return fmt.Errorf("`tag` is required for when type is `CAA`")
-				}
-			}
-
-			return nil
+			"allow_overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Adopt an existing record with the same name, type, and value instead of creating a new one",
+			},
 		},
+
+		CustomizeDiff: resourceDigitalOceanRecordDiff,
 	}
 }
 
@@ -162,14 +156,28 @@ func resourceDigitalOceanRecordCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	newRecord.Type = d.Get("type").(string)
+	domain := d.Get("domain").(string)
+
+	if d.Get("allow_overwrite").(bool) {
+		existing, err := findExistingRecord(ctx, client, domain, newRecord)
+		if err != nil {
+			return diag.Errorf("Error looking up existing records: %s", err)
+		}
+
+		if existing != nil {
+			log.Printf("[INFO] Adopting existing record %d with configuration: %#v", existing.ID, newRecord)
+			_, _, err = client.Domains.EditRecord(ctx, domain, existing.ID, newRecord)
+			if err != nil {
+				return diag.Errorf("Failed to update existing record %d: %s", existing.ID, err)
+			}
 
-	_, hasPort := d.GetOkExists("port")
-	if newRecord.Type == "SRV" && !hasPort {
-		return diag.Errorf("`port` is required for when type is `SRV`")
+			d.SetId(strconv.Itoa(existing.ID))
+			return resourceDigitalOceanRecordRead(ctx, d, meta)
+		}
 	}
 
 	log.Printf("[DEBUG] record create configuration: %#v", newRecord)
-	rec, _, err := client.Domains.CreateRecord(context.Background(), d.Get("domain").(string), newRecord)
+	rec, _, err := client.Domains.CreateRecord(ctx, domain, newRecord)
 	if err != nil {
 		return diag.Errorf("Failed to create record: %s", err)
 	}
@@ -189,7 +197,7 @@ func resourceDigitalOceanRecordRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("invalid record ID: %v", err)
 	}
 
-	rec, resp, err := client.Domains.Record(context.Background(), domain, id)
+	rec, resp, err := client.Domains.Record(ctx, domain, id)
 	if err != nil {
 		// If the record is somehow already destroyed, mark as
 		// successfully gone
@@ -269,7 +277,7 @@ func resourceDigitalOceanRecordUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] record update configuration: %#v", editRecord)
-	_, _, err = client.Domains.EditRecord(context.Background(), domain, id, editRecord)
+	_, _, err = client.Domains.EditRecord(ctx, domain, id, editRecord)
 	if err != nil {
 		return diag.Errorf("Failed to update record: %s", err)
 	}
@@ -288,7 +296,7 @@ func resourceDigitalOceanRecordDelete(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[INFO] Deleting record: %s, %d", domain, id)
 
-	resp, delErr := client.Domains.DeleteRecord(context.Background(), domain, id)
+	resp, delErr := client.Domains.DeleteRecord(ctx, domain, id)
 	if delErr != nil {
 		// If the record is somehow already destroyed, mark as
 		// successfully gone
@@ -302,6 +310,105 @@ func resourceDigitalOceanRecordDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourceDigitalOceanRecordDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	recordType := diff.Get("type").(string)
+
+	_, hasPriority := diff.GetOkExists("priority")
+	if recordType == "MX" {
+		if !hasPriority {
+			return fmt.Errorf("`priority` is required for when type is `MX`")
+		}
+	}
+
+	_, hasWeight := diff.GetOkExists("weight")
+	_, hasPort := diff.GetOkExists("port")
+	if recordType == "SRV" {
+		if !hasPort {
+			return fmt.Errorf("`port` is required for when type is `SRV`")
+		}
+		if !hasPriority {
+			return fmt.Errorf("`priority` is required for when type is `SRV`")
+		}
+		if !hasWeight {
+			return fmt.Errorf("`weight` is required for when type is `SRV`")
+		}
+	}
+
+	_, hasFlags := diff.GetOkExists("flags")
+	_, hasTag := diff.GetOk("tag")
+	if recordType == "CAA" {
+		if !hasFlags {
+			return fmt.Errorf("`flags` is required for when type is `CAA`")
+		}
+		if !hasTag {
+			return fmt.Errorf("`tag` is required for when type is `CAA`")
+		}
+	}
+
+	if !diff.NewValueKnown("value") || !diff.NewValueKnown("tag") {
+		return nil
+	}
+
+	return ValidateRecordValue(recordType, diff.Get("tag").(string), diff.Get("value").(string))
+}
+
+// ValidateRecordValue checks that the value of a record is valid for its type.
+// The tag is only used for CAA records.
+func ValidateRecordValue(recordType, tag, value string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("`value` must be an IPv4 address for when type is `A`, got %q", value)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("`value` must be an IPv6 address for when type is `AAAA`, got %q", value)
+		}
+	case "NS", "SRV":
+		// Unlike CNAME and MX records, the API does not resolve "@" to the
+		// domain for these types, which need a hostname.
+		if value == "@" {
+			return fmt.Errorf("`value` must be a hostname for when type is `%s`, use the fully qualified domain name with a trailing dot instead of `@`", recordType)
+		}
+	case "CAA":
+		isURL := strings.HasPrefix(value, "mailto:") || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")
+		if tag == "iodef" && !isURL {
+			return fmt.Errorf("`value` must be a mailto:, http:// or https:// URL for when tag is `iodef`, got %q", value)
+		}
+		if tag != "iodef" && (isURL || value == "@") {
+			return fmt.Errorf("`value` must be the domain name of a certificate authority or `;` for when tag is `%s`, got %q", tag, value)
+		}
+	case "HTTPS", "SVCB":
+		// e.g. "1 . alpn=h2,h3" or "0 www.example.com."
+		fields := strings.Fields(value)
+		if len(fields) < 2 {
+			return fmt.Errorf("`value` must contain the priority and target, followed by the parameters, for when type is `%s`, got %q", recordType, value)
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 16); err != nil {
+			return fmt.Errorf("`value` must start with a priority between 0 and 65535 for when type is `%s`, got %q", recordType, fields[0])
+		}
+		if fields[0] == "0" && len(fields) > 2 {
+			return fmt.Errorf("`value` must not contain parameters for when type is `%s` and priority is 0, got %q", recordType, value)
+		}
+	case "TLSA":
+		// e.g. "3 1 1 0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3"
+		fields := strings.Fields(value)
+		if len(fields) != 4 {
+			return fmt.Errorf("`value` must contain the usage, selector, matching type and certificate association data for when type is `TLSA`, got %q", value)
+		}
+		for i, max := range []uint64{3, 1, 2} {
+			if n, err := strconv.ParseUint(fields[i], 10, 8); err != nil || n > max {
+				return fmt.Errorf("`value` must contain a %s between 0 and %d for when type is `TLSA`, got %q", []string{"usage", "selector", "matching type"}[i], max, fields[i])
+			}
+		}
+		if _, err := hex.DecodeString(fields[3]); err != nil {
+			return fmt.Errorf("`value` must contain hex encoded certificate association data for when type is `TLSA`: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func expandDigitalOceanRecordResource(d *schema.ResourceData) (*godo.DomainRecordEditRequest, error) {
 	record := &godo.DomainRecordEditRequest{
 		Name: d.Get("name").(string),
@@ -330,6 +437,90 @@ func expandDigitalOceanRecordResource(d *schema.ResourceData) (*godo.DomainRecor
 	return record, nil
 }
 
+// findExistingRecord returns the existing record of the domain with the name,
+// type, and value of the given record, as well as its priority, port, weight,
+// flags, and tag where relevant. Records of the types which may have several
+// values, e.g. round-robin A records, are only adopted if they are the same as
+// the configured one so that the records of other resources are left alone.
+// As there is at most one CNAME record of a name, it is adopted whatever its
+// value. It returns nil if there is no such record.
+func findExistingRecord(ctx context.Context, client *godo.Client, domain string, record *godo.DomainRecordEditRequest) (*godo.DomainRecord, error) {
+	name := ConstructFqdn(record.Name, domain)
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	existing := []godo.DomainRecord{}
+	for {
+		records, resp, err := client.Domains.RecordsByTypeAndName(ctx, domain, record.Type, name, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		existing = append(existing, records...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	for i := range existing {
+		if recordMatches(&existing[i], record, domain) {
+			return &existing[i], nil
+		}
+	}
+
+	if record.Type == "CNAME" && len(existing) == 1 {
+		return &existing[0], nil
+	}
+
+	return nil, nil
+}
+
+// recordMatches reports whether the record read from the API has the
+// configured value and, for the types which have them, the same priority,
+// port, weight, flags, and tag.
+func recordMatches(existing *godo.DomainRecord, record *godo.DomainRecordEditRequest, domain string) bool {
+	if !recordValueMatches(existing.Data, record.Data, domain) {
+		return false
+	}
+
+	switch record.Type {
+	case "MX":
+		return existing.Priority == record.Priority
+	case "SRV":
+		return existing.Priority == record.Priority && existing.Port == record.Port && existing.Weight == record.Weight
+	case "CAA":
+		return existing.Flags == record.Flags && strings.EqualFold(existing.Tag, record.Tag)
+	}
+
+	return true
+}
+
+// recordValueMatches reports whether the value of a record read from the API
+// is the configured one, which may be relative to the domain.
+func recordValueMatches(data, value, domain string) bool {
+	normalize := func(v string) string {
+		v = strings.ToLower(strings.TrimSuffix(v, "."))
+		if v == "@" {
+			return strings.ToLower(domain)
+		}
+		return v
+	}
+
+	data, value = normalize(data), normalize(value)
+
+	return data == value || data == value+"."+strings.ToLower(domain)
+}
+
 func ConstructFqdn(name, domain string) string {
 	if name == "@" {
 		return domain
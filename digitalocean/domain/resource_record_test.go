@@ -3,6 +3,8 @@ package domain_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,6 +15,7 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -38,6 +41,54 @@ func TestDigitalOceanRecordConstructFqdn(t *testing.T) {
 	}
 }
 
+func TestDigitalOceanRecordValidateRecordValue(t *testing.T) {
+	cases := []struct {
+		recordType, tag, value string
+		valid                  bool
+	}{
+		{"A", "", "192.168.0.10", true},
+		{"A", "", "2001:db8::1", false},
+		{"A", "", "www.example.com.", false},
+		{"AAAA", "", "2001:db8::1", true},
+		{"AAAA", "", "192.168.0.10", false},
+		{"CNAME", "", "@", true},
+		{"MX", "", "@", true},
+		{"MX", "", "mail.example.com.", true},
+		{"NS", "", "ns1.digitalocean.com.", true},
+		{"NS", "", "@", false},
+		{"SRV", "", "srv.example.com", true},
+		{"SRV", "", "@", false},
+		{"CAA", "issue", "letsencrypt.org.", true},
+		{"CAA", "issuewild", ";", true},
+		{"CAA", "issue", "mailto:caa@example.com", false},
+		{"CAA", "iodef", "mailto:caa@example.com", true},
+		{"CAA", "iodef", "https://example.com/caa", true},
+		{"CAA", "iodef", "letsencrypt.org.", false},
+		{"TXT", "", "@", true},
+		{"HTTPS", "", "1 . alpn=h2,h3", true},
+		{"HTTPS", "", "0 www.example.com.", true},
+		{"HTTPS", "", "0 www.example.com. alpn=h2", false},
+		{"SVCB", "", "1 svc.example.com. port=8443", true},
+		{"SVCB", "", "svc.example.com.", false},
+		{"SVCB", "", "70000 svc.example.com.", false},
+		{"TLSA", "", "3 1 1 0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3", true},
+		{"TLSA", "", "3 1 1 0B9FA5A59EED715C", true},
+		{"TLSA", "", "4 1 1 0b9fa5a59eed715c", false},
+		{"TLSA", "", "3 1 1 not-hex", false},
+		{"TLSA", "", "3 1 0b9fa5a59eed715c", false},
+	}
+
+	for _, tc := range cases {
+		err := domain.ValidateRecordValue(tc.recordType, tc.tag, tc.value)
+		if tc.valid && err != nil {
+			t.Fatalf("expected %s record value %q to be valid, got: %s", tc.recordType, tc.value, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("expected %s record value %q to be invalid", tc.recordType, tc.value)
+		}
+	}
+}
+
 func TestAccDigitalOceanRecord_Basic(t *testing.T) {
 	var record godo.DomainRecord
 	domain := acceptance.RandomTestName() + ".com"
@@ -66,6 +117,103 @@ func TestAccDigitalOceanRecord_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanRecord_AllowOverwrite(t *testing.T) {
+	var record godo.DomainRecord
+	domain := acceptance.RandomTestName("record") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The apex A record created along with the domain is adopted
+				// and updated instead of adding another one.
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordConfig_allow_overwrite, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanRecordExists("digitalocean_record.foobar", &record),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record.foobar", "value", "192.168.0.10"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record.foobar", "ttl", "300"),
+					testAccCheckDigitalOceanRecordCount(domain, "A", domain, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestDigitalOceanRecordAllowOverwrite_RoundRobin(t *testing.T) {
+	var created, edited bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com/records":
+			w.Write([]byte(`{"domain_records": [{"id": 1, "type": "A", "name": "www", "data": "192.0.2.1", "ttl": 1800}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/domains/example.com/records":
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"domain_record": {"id": 2, "type": "A", "name": "www", "data": "192.0.2.2", "ttl": 1800}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com/records/2":
+			w.Write([]byte(`{"domain_record": {"id": 2, "type": "A", "name": "www", "data": "192.0.2.2", "ttl": 1800}}`))
+		case r.Method == http.MethodPut:
+			edited = true
+			w.Write([]byte(`{"domain_record": {"id": 1, "type": "A", "name": "www", "data": "192.0.2.2", "ttl": 1800}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := domain.ResourceDigitalOceanRecord()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"domain":          "example.com",
+		"name":            "www",
+		"type":            "A",
+		"value":           "192.0.2.2",
+		"allow_overwrite": true,
+	})
+
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	// The A record with another value belongs to another round-robin record.
+	if !created || edited {
+		t.Errorf("Expected a new record to be created, got created = %t and edited = %t", created, edited)
+	}
+	if d.Id() != "2" {
+		t.Errorf("Expected the ID of the new record, got %q", d.Id())
+	}
+}
+
+func testAccCheckDigitalOceanRecordCount(domain, recordType, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		records, _, err := client.Domains.RecordsByTypeAndName(context.Background(), domain, recordType, name, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(records) != expected {
+			return fmt.Errorf("Expected %d %s records named %s, got %d", expected, recordType, name, len(records))
+		}
+
+		return nil
+	}
+}
+
 func TestAccDigitalOceanRecord_BasicFullName(t *testing.T) {
 	var record godo.DomainRecord
 	domain := acceptance.RandomTestName("record") + ".com"
@@ -642,6 +790,29 @@ func TestAccDigitalOceanRecord_ExpectedErrors(t *testing.T) {
   type  = "CAA"
   value = "letsencrypt.org."
   flags = 1
+}`
+		nsAt = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  name  = "sub"
+  type  = "NS"
+  value = "@"
+}`
+		aInvalid = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  name  = "www"
+  type  = "A"
+  value = "www.example.com."
+}`
+		caaIodefInvalid = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  name  = "@"
+  type  = "CAA"
+  value = "letsencrypt.org."
+  tag   = "iodef"
+  flags = 0
 }`
 	)
 
@@ -674,6 +845,18 @@ func TestAccDigitalOceanRecord_ExpectedErrors(t *testing.T) {
 				Config:      caaNoTag,
 				ExpectError: regexp.MustCompile("`tag` is required for when type is `CAA`"),
 			},
+			{
+				Config:      nsAt,
+				ExpectError: regexp.MustCompile("`value` must be a hostname for when type is `NS`"),
+			},
+			{
+				Config:      aInvalid,
+				ExpectError: regexp.MustCompile("`value` must be an IPv4 address for when type is `A`"),
+			},
+			{
+				Config:      caaIodefInvalid,
+				ExpectError: regexp.MustCompile("`value` must be a mailto:, http:// or https:// URL for when tag is `iodef`"),
+			},
 		},
 	})
 }
@@ -784,6 +967,22 @@ resource "digitalocean_record" "foobar" {
   type  = "A"
 }`
 
+const testAccCheckDigitalOceanRecordConfig_allow_overwrite = `
+resource "digitalocean_domain" "foobar" {
+  name       = "%s"
+  ip_address = "192.168.0.10"
+}
+
+resource "digitalocean_record" "foobar" {
+  domain = digitalocean_domain.foobar.name
+
+  name            = "@"
+  value           = "192.168.0.10"
+  type            = "A"
+  ttl             = 300
+  allow_overwrite = true
+}`
+
 const testAccCheckDigitalOceanRecordConfig_basic_full_name = `
 resource "digitalocean_domain" "foobar" {
   name       = "%s"
@@ -34,7 +34,7 @@ func sweepDomain(region string) error {
 	}
 
 	for _, d := range domains {
-		if strings.HasPrefix(d.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(d.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying domain %s", d.Name)
 
 			if _, err := client.Domains.Delete(context.Background(), d.Name); err != nil {
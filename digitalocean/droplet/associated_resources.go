@@ -0,0 +1,134 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dropletAssociatedResourcesPath = "v2/droplets/%d/destroy_with_associated_resources"
+
+// dropletAssociatedResource represents a resource associated with a Droplet
+// which can be destroyed along with it.
+type dropletAssociatedResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Cost string `json:"cost,omitempty"`
+}
+
+// dropletAssociatedResources represents the resources associated with a
+// Droplet as returned by the API.
+type dropletAssociatedResources struct {
+	ReservedIPs     []dropletAssociatedResource `json:"reserved_ips"`
+	FloatingIPs     []dropletAssociatedResource `json:"floating_ips"`
+	Snapshots       []dropletAssociatedResource `json:"snapshots"`
+	Volumes         []dropletAssociatedResource `json:"volumes"`
+	VolumeSnapshots []dropletAssociatedResource `json:"volume_snapshots"`
+}
+
+// dropletAssociatedResourcesStatus represents the status of a request to
+// destroy a Droplet along with its associated resources.
+type dropletAssociatedResourcesStatus struct {
+	CompletedAt string `json:"completed_at"`
+	Failures    int    `json:"failures"`
+}
+
+// listDropletAssociatedResources returns the resources which would be
+// destroyed along with the Droplet.
+func listDropletAssociatedResources(ctx context.Context, client *godo.Client, id int) (*dropletAssociatedResources, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(dropletAssociatedResourcesPath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := new(dropletAssociatedResources)
+	if _, err := client.Do(ctx, req, resources); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// destroyDropletWithAssociatedResources destroys the Droplet along with all of
+// its associated resources and waits for the request to complete.
+func destroyDropletWithAssociatedResources(ctx context.Context, client *godo.Client, id int, timeout time.Duration) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(dropletAssociatedResourcesPath+"/dangerous", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Dangerous", "true")
+
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"in-progress"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(dropletAssociatedResourcesPath+"/status", id), nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := new(dropletAssociatedResourcesStatus)
+			if _, err := client.Do(ctx, req, status); err != nil {
+				return nil, "", err
+			}
+
+			if status.CompletedAt == "" {
+				return status, "in-progress", nil
+			}
+
+			if status.Failures > 0 {
+				return nil, "", fmt.Errorf("%d associated resource(s) failed to be destroyed", status.Failures)
+			}
+
+			return status, "completed", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	return resp, err
+}
+
+// dropletAssociatedResourcesWarning returns a warning describing the resources
+// which will be destroyed along with the Droplet.
+func dropletAssociatedResourcesWarning(id int, resources *dropletAssociatedResources) diag.Diagnostics {
+	var descriptions []string
+	add := func(kind string, rs []dropletAssociatedResource) {
+		for _, r := range rs {
+			descriptions = append(descriptions, fmt.Sprintf("%s %s (%s)", kind, r.Name, r.ID))
+		}
+	}
+	add("reserved IP", resources.ReservedIPs)
+	add("floating IP", resources.FloatingIPs)
+	add("snapshot", resources.Snapshots)
+	add("volume", resources.Volumes)
+	add("volume snapshot", resources.VolumeSnapshots)
+
+	if len(descriptions) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Droplet (%d) associated resources: %v", id, descriptions)
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Droplet associated resources will be destroyed",
+			Detail: fmt.Sprintf("destroy_associated_resources is enabled for Droplet %d. The following resources will be destroyed along with it:\n  - %s",
+				id, strings.Join(descriptions, "\n  - ")),
+		},
+	}
+}
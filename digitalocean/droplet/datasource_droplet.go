@@ -3,6 +3,7 @@ package droplet
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 
 	"github.com/digitalocean/godo"
@@ -20,9 +21,9 @@ func DataSourceDigitalOceanDroplet() *schema.Resource {
 		f.Computed = true
 	}
 
-	recordSchema["id"].ExactlyOneOf = []string{"id", "tag", "name"}
+	recordSchema["id"].ExactlyOneOf = []string{"id", "tag", "name", "ip_address"}
 	recordSchema["id"].Optional = true
-	recordSchema["name"].ExactlyOneOf = []string{"id", "tag", "name"}
+	recordSchema["name"].ExactlyOneOf = []string{"id", "tag", "name", "ip_address"}
 	recordSchema["name"].Optional = true
 
 	recordSchema["tag"] = &schema.Schema{
@@ -30,7 +31,15 @@ func DataSourceDigitalOceanDroplet() *schema.Resource {
 		Optional:     true,
 		Description:  "unique tag of the Droplet",
 		ValidateFunc: validation.NoZeroValues,
-		ExactlyOneOf: []string{"id", "tag", "name"},
+		ExactlyOneOf: []string{"id", "tag", "name", "ip_address"},
+	}
+
+	recordSchema["ip_address"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "a public or private IPv4 or IPv6 address of the Droplet",
+		ValidateFunc: validation.IsIPAddress,
+		ExactlyOneOf: []string{"id", "tag", "name", "ip_address"},
 	}
 
 	return &schema.Resource{
@@ -45,7 +54,7 @@ func dataSourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceDa
 	var foundDroplet godo.Droplet
 
 	if id, ok := d.GetOk("id"); ok {
-		droplet, _, err := client.Droplets.Get(context.Background(), id.(int))
+		droplet, _, err := client.Droplets.Get(ctx, id.(int))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -75,9 +84,21 @@ func dataSourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceDa
 			return diag.FromErr(err)
 		}
 
+		foundDroplet = *droplet
+	} else if v, ok := d.GetOk("ip_address"); ok {
+		dropletList, err := getDigitalOceanDroplets(meta, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		droplet, err := findDropletByIPAddress(dropletList, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		foundDroplet = *droplet
 	} else {
-		return diag.Errorf("Error: specify either a name, tag, or id to use to look up the droplet")
+		return diag.Errorf("Error: specify either a name, tag, ip_address, or id to use to look up the droplet")
 	}
 
 	flattenedDroplet, err := flattenDigitalOceanDroplet(foundDroplet, meta, nil)
@@ -128,3 +149,42 @@ func findDropletByTag(droplets []interface{}, tag string) (*godo.Droplet, error)
 	}
 	return nil, fmt.Errorf("too many droplets found with tag %s (found %d, expected 1)", tag, len(results))
 }
+
+func findDropletByIPAddress(droplets []interface{}, address string) (*godo.Droplet, error) {
+	ip := net.ParseIP(address)
+	results := make([]godo.Droplet, 0)
+	for _, d := range droplets {
+		droplet := d.(godo.Droplet)
+		if dropletHasIPAddress(droplet, ip) {
+			results = append(results, droplet)
+		}
+	}
+	if len(results) == 1 {
+		return &results[0], nil
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no droplet found with IP address %s", address)
+	}
+	return nil, fmt.Errorf("too many droplets found with IP address %s (found %d, expected 1)", address, len(results))
+}
+
+// dropletHasIPAddress reports whether any of the public or private IPv4 or
+// IPv6 addresses of the Droplet is the given address.
+func dropletHasIPAddress(droplet godo.Droplet, ip net.IP) bool {
+	if droplet.Networks == nil {
+		return false
+	}
+
+	for _, v4 := range droplet.Networks.V4 {
+		if ip.Equal(net.ParseIP(v4.IPAddress)) {
+			return true
+		}
+	}
+	for _, v6 := range droplet.Networks.V6 {
+		if ip.Equal(net.ParseIP(v6.IPAddress)) {
+			return true
+		}
+	}
+
+	return false
+}
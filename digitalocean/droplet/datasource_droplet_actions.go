@@ -0,0 +1,122 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceDigitalOceanDropletActions lists the actions of a Droplet, e.g. to
+// check that none is in progress before attaching volumes or resizing it.
+func DataSourceDigitalOceanDropletActions() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletActionSchema(),
+		ResultAttributeName: "actions",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "the ID of the Droplet to list the actions of",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		GetRecords:    getDigitalOceanDropletActions,
+		FlattenRecord: flattenDigitalOceanDropletAction,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func dropletActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "the ID of the action",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of action, e.g. attach_volume or resize",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the action, one of in-progress, completed, or errored",
+		},
+		"started_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the action was started, (ISO8601)",
+		},
+		"completed_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the action was completed, (ISO8601), empty if it is in progress",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the slug of the region where the action occurred",
+		},
+	}
+}
+
+func getDigitalOceanDropletActions(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := extra["droplet_id"].(int)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allActions []interface{}
+
+	for {
+		actions, resp, err := client.Droplets.Actions(context.Background(), dropletID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving actions of droplet (%d): %s", dropletID, err)
+		}
+
+		for _, action := range actions {
+			allActions = append(allActions, action)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving actions of droplet (%d): %s", dropletID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allActions, nil
+}
+
+func flattenDigitalOceanDropletAction(rawAction, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	action := rawAction.(godo.Action)
+
+	flattenedAction := map[string]interface{}{
+		"id":           action.ID,
+		"type":         action.Type,
+		"status":       action.Status,
+		"started_at":   "",
+		"completed_at": "",
+		"region":       action.RegionSlug,
+	}
+
+	if action.StartedAt != nil {
+		flattenedAction["started_at"] = action.StartedAt.UTC().Format(time.RFC3339)
+	}
+	if action.CompletedAt != nil {
+		flattenedAction["completed_at"] = action.CompletedAt.UTC().Format(time.RFC3339)
+	}
+
+	return flattenedAction, nil
+}
@@ -0,0 +1,61 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletActions_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}`, name)
+	dataSourceConfig := `
+data "digitalocean_droplet_actions" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+}
+
+data "digitalocean_droplet_actions" "in_progress" {
+  droplet_id = digitalocean_droplet.foobar.id
+
+  filter {
+    key    = "status"
+    values = ["in-progress"]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_actions.foobar", "actions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_actions.foobar", "actions.0.type", "create"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_actions.foobar", "actions.0.status", "completed"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_actions.foobar", "actions.0.started_at"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_actions.foobar", "actions.0.completed_at"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_actions.in_progress", "actions.#", "0"),
+				),
+			},
+		},
+	})
+}
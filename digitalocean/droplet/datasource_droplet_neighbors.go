@@ -0,0 +1,122 @@
+package droplet
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const dropletNeighborsReportPath = "v2/reports/droplet_neighbors_ids"
+
+// dropletNeighborsReport represents the groups of Droplets of the account
+// running on the same physical hardware, as returned by the API.
+type dropletNeighborsReport struct {
+	NeighborIDs [][]int `json:"neighbor_ids"`
+}
+
+func DataSourceDigitalOceanDropletNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDropletNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			"droplet_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "only report the Droplets of this list sharing physical hardware with each other",
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"droplet_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"co_located": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether any of the Droplets share physical hardware",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDropletNeighborsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	req, err := client.NewRequest(ctx, http.MethodGet, dropletNeighborsReportPath, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	report := new(dropletNeighborsReport)
+	if _, err := client.Do(ctx, req, report); err != nil {
+		return diag.Errorf("Error retrieving Droplet neighbors: %s", err)
+	}
+
+	var dropletIDs []int
+	if v, ok := d.GetOk("droplet_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			dropletIDs = append(dropletIDs, id.(int))
+		}
+	}
+
+	groups := []map[string]interface{}{}
+	for _, group := range DropletNeighborGroups(report.NeighborIDs, dropletIDs) {
+		groups = append(groups, map[string]interface{}{
+			"droplet_ids": group,
+		})
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("groups", groups); err != nil {
+		return diag.Errorf("Error setting groups: %s", err)
+	}
+	d.Set("co_located", len(groups) > 0)
+
+	return nil
+}
+
+// DropletNeighborGroups returns the groups of at least two Droplets sharing
+// physical hardware, restricted to the given Droplets unless the list is
+// empty. The groups and the IDs in each group are sorted.
+func DropletNeighborGroups(neighborIDs [][]int, dropletIDs []int) [][]int {
+	include := map[int]bool{}
+	for _, id := range dropletIDs {
+		include[id] = true
+	}
+
+	groups := [][]int{}
+	for _, neighbors := range neighborIDs {
+		group := []int{}
+		for _, id := range neighbors {
+			if len(include) == 0 || include[id] {
+				group = append(group, id)
+			}
+		}
+
+		if len(group) < 2 {
+			continue
+		}
+
+		sort.Ints(group)
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+
+	return groups
+}
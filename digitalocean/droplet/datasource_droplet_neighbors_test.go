@@ -0,0 +1,78 @@
+package droplet_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDropletNeighborGroups(t *testing.T) {
+	neighborIDs := [][]int{{5, 3}, {1, 4, 2}, {6}}
+
+	cases := []struct {
+		name       string
+		dropletIDs []int
+		expected   [][]int
+	}{
+		{
+			name:     "all Droplets",
+			expected: [][]int{{1, 2, 4}, {3, 5}},
+		},
+		{
+			name:       "some Droplets",
+			dropletIDs: []int{2, 4, 5, 6},
+			expected:   [][]int{{2, 4}},
+		},
+		{
+			name:       "no neighbors",
+			dropletIDs: []int{1, 3, 6},
+			expected:   [][]int{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			groups := droplet.DropletNeighborGroups(neighborIDs, c.dropletIDs)
+			if !reflect.DeepEqual(groups, c.expected) {
+				t.Fatalf("Expected %v, got %v", c.expected, groups)
+			}
+		})
+	}
+}
+
+func TestAccDataSourceDigitalOceanDropletNeighbors_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanDropletNeighborsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_neighbors.foobar", "co_located"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_neighbors.foobar", "groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDigitalOceanDropletNeighborsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+data "digitalocean_droplet_neighbors" "foobar" {
+  droplet_ids = digitalocean_droplet.foobar[*].id
+}`, name)
+}
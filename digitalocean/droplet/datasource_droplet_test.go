@@ -46,6 +46,8 @@ data "digitalocean_droplet" "foobar" {
 					resource.TestCheckResourceAttrSet("data.digitalocean_droplet.foobar", "urn"),
 					resource.TestCheckResourceAttrSet("data.digitalocean_droplet.foobar", "created_at"),
 					resource.TestCheckResourceAttrSet("data.digitalocean_droplet.foobar", "vpc_uuid"),
+					resource.TestCheckTypeSetElemAttr("data.digitalocean_droplet.foobar", "features.*", "private_networking"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet.foobar", "region_features.#"),
 				),
 			},
 		},
@@ -121,6 +123,30 @@ func TestAccDataSourceDigitalOceanDroplet_BasicByTag(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceDigitalOceanDroplet_BasicByIPAddress(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanDropletConfig_basicByIPAddress(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceDigitalOceanDropletExists("data.digitalocean_droplet.private", &droplet),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet.private", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_droplet.private", "id", "digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_droplet.public", "id", "digitalocean_droplet.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceDigitalOceanDropletExists(n string, droplet *godo.Droplet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -189,6 +215,25 @@ data "digitalocean_droplet" "foobar" {
 `, name, defaultSize, defaultImage)
 }
 
+func testAccCheckDataSourceDigitalOceanDropletConfig_basicByIPAddress(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+}
+
+data "digitalocean_droplet" "private" {
+  ip_address = digitalocean_droplet.foo.ipv4_address_private
+}
+
+data "digitalocean_droplet" "public" {
+  ip_address = digitalocean_droplet.foo.ipv4_address
+}
+`, name, defaultSize, defaultImage)
+}
+
 func testAccCheckDataSourceDigitalOceanDropletConfig_basicWithTag(tagName string, name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_tag" "foo" {
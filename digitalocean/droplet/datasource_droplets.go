@@ -11,6 +11,7 @@ func DataSourceDigitalOceanDroplets() *schema.Resource {
 		ResultAttributeName: "droplets",
 		GetRecords:          getDigitalOceanDroplets,
 		FlattenRecord:       flattenDigitalOceanDroplet,
+		Paginated:           true,
 	}
 
 	return datalist.NewResource(dataListConfig)
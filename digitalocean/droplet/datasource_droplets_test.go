@@ -57,3 +57,130 @@ data "digitalocean_droplets" "result" {
 		},
 	})
 }
+
+func TestAccDataSourceDigitalOceanDroplets_TagExpressions(t *testing.T) {
+	prefix := acceptance.RandomTestName()
+	name1 := prefix + "-01"
+	name2 := prefix + "-02"
+	name3 := prefix + "-03"
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "web" {
+  name = "%[1]s-web"
+}
+
+resource "digitalocean_tag" "prod" {
+  name = "%[1]s-prod"
+}
+
+resource "digitalocean_tag" "canary" {
+  name = "%[1]s-canary"
+}
+
+resource "digitalocean_droplet" "foo" {
+  name   = "%[2]s"
+  size   = "%[5]s"
+  image  = "%[6]s"
+  region = "nyc3"
+  tags   = [digitalocean_tag.web.id, digitalocean_tag.prod.id]
+}
+
+resource "digitalocean_droplet" "bar" {
+  name   = "%[3]s"
+  size   = "%[5]s"
+  image  = "%[6]s"
+  region = "nyc3"
+  tags   = [digitalocean_tag.web.id, digitalocean_tag.prod.id, digitalocean_tag.canary.id]
+}
+
+resource "digitalocean_droplet" "baz" {
+  name   = "%[4]s"
+  size   = "%[5]s"
+  image  = "%[6]s"
+  region = "nyc3"
+  tags   = [digitalocean_tag.web.id]
+}
+`, prefix, name1, name2, name3, defaultSize, defaultImage)
+
+	datasourceConfig := `
+data "digitalocean_droplets" "result" {
+  filter {
+    key    = "tags"
+    all_of = [digitalocean_tag.web.id, digitalocean_tag.prod.id]
+  }
+  filter {
+    key     = "tags"
+    none_of = [digitalocean_tag.canary.id]
+  }
+}
+`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplets.result", "droplets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplets.result", "droplets.0.id", "digitalocean_droplet.foo", "id"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDroplets_Pagination(t *testing.T) {
+	prefix := acceptance.RandomTestName("paged")
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  count  = 3
+  name   = "%s-${count.index}"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+}
+`, prefix, defaultSize, defaultImage)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_droplets" "result" {
+  filter {
+    key      = "name"
+    values   = ["%s"]
+    match_by = "substring"
+  }
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+  limit  = 1
+  offset = 1
+}
+`, prefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplets.result", "total_count", "3"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplets.result", "droplets.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplets.result", "droplets.0.name", prefix+"-1"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
@@ -102,6 +102,16 @@ func dropletSchema() map[string]*schema.Schema {
 			Type:        schema.TypeBool,
 			Description: "whether the Droplet has monitoring enabled",
 		},
+		"features": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the features enabled on the Droplet, e.g. backups, ipv6 or monitoring",
+		},
+		"region_features": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the features available in the region of the Droplet",
+		},
 		"volume_ids": {
 			Type:        schema.TypeSet,
 			Elem:        &schema.Schema{Type: schema.TypeString},
@@ -199,6 +209,10 @@ func flattenDigitalOceanDroplet(rawDroplet, meta interface{}, extra map[string]i
 		flattenedDroplet["private_networking"] = containsDigitalOceanDropletFeature(features, "private_networking")
 		flattenedDroplet["monitoring"] = containsDigitalOceanDropletFeature(features, "monitoring")
 	}
+	flattenedDroplet["features"] = flattenDigitalOceanDropletFeatures(droplet.Features)
+	if droplet.Region != nil {
+		flattenedDroplet["region_features"] = flattenDigitalOceanDropletFeatures(droplet.Region.Features)
+	}
 
 	flattenedDroplet["volume_ids"] = flattenDigitalOceanDropletVolumeIds(droplet.VolumeIDs)
 
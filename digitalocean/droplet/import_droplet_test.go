@@ -108,7 +108,7 @@ func takeDropletSnapshot(t *testing.T, name string, droplet *godo.Droplet, snaps
 		if err != nil {
 			return err
 		}
-		util.WaitForAction(client, action)
+		util.WaitForAction(context.Background(), client, action)
 
 		retrieveDroplet, _, err := client.Droplets.Get(context.Background(), (*droplet).ID)
 		if err != nil {
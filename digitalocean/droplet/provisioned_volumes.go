@@ -0,0 +1,274 @@
+package droplet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/volume"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const provisionedVolumesBoundary = "digitalocean-provisioned-volumes"
+
+func provisionedVolumesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Volumes created, formatted and mounted along with the Droplet and destroyed with it",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: util.ValidateVolumeName,
+				},
+				"size": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"filesystem_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      "ext4",
+					ValidateFunc: validation.StringInSlice([]string{"ext4", "xfs"}, false),
+				},
+				"filesystem_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"mount_point": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^/.+"), "must be an absolute path"),
+					Description:  "the path the volume is mounted at, defaults to /mnt/<name>",
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"urn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// validateProvisionedVolumesDiff checks the provisioned volumes can be created
+// along with the Droplet.
+func validateProvisionedVolumesDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	volumes := d.Get("provisioned_volumes").([]interface{})
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	if d.NewValueKnown("region") && d.Get("region").(string) == "" {
+		return fmt.Errorf("`region` must be set to create `provisioned_volumes`")
+	}
+
+	names := map[string]bool{}
+	for _, raw := range volumes {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		if names[name] {
+			return fmt.Errorf("the names of `provisioned_volumes` must be unique, found %q more than once", name)
+		}
+		names[name] = true
+
+		if label := v["filesystem_label"].(string); label != "" {
+			if err := volume.ValidateFilesystemLabel(v["filesystem_type"].(string), label); err != nil {
+				return fmt.Errorf("invalid `filesystem_label` of provisioned volume %s: %s", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// createProvisionedVolumes creates the volumes with their filesystem, returning
+// the configuration of the volumes with their IDs. Volumes created before an
+// error are returned as well so that they can be cleaned up.
+func createProvisionedVolumes(ctx context.Context, client *godo.Client, region string, rawVolumes []interface{}) ([]map[string]interface{}, error) {
+	volumes := make([]map[string]interface{}, 0, len(rawVolumes))
+
+	for _, raw := range rawVolumes {
+		v := raw.(map[string]interface{})
+
+		req := &godo.VolumeCreateRequest{
+			Region:          strings.ToLower(region),
+			Name:            v["name"].(string),
+			SizeGigaBytes:   int64(v["size"].(int)),
+			FilesystemType:  v["filesystem_type"].(string),
+			FilesystemLabel: v["filesystem_label"].(string),
+		}
+
+		log.Printf("[DEBUG] Provisioned volume create configuration: %#v", req)
+		vol, _, err := client.Storage.CreateVolume(ctx, req)
+		if err != nil {
+			return volumes, fmt.Errorf("Error creating provisioned volume %s: %s", req.Name, err)
+		}
+
+		mountPoint := v["mount_point"].(string)
+		if mountPoint == "" {
+			mountPoint = path.Join("/mnt", vol.Name)
+		}
+
+		volumes = append(volumes, map[string]interface{}{
+			"name":             vol.Name,
+			"size":             v["size"],
+			"filesystem_type":  req.FilesystemType,
+			"filesystem_label": req.FilesystemLabel,
+			"mount_point":      mountPoint,
+			"id":               vol.ID,
+			"urn":              vol.URN(),
+		})
+	}
+
+	return volumes, nil
+}
+
+// deleteProvisionedVolumes deletes the volumes, retrying while they are still
+// being detached from the destroyed Droplet.
+func deleteProvisionedVolumes(ctx context.Context, client *godo.Client, volumes []interface{}, timeout time.Duration) error {
+	for _, raw := range volumes {
+		v := raw.(map[string]interface{})
+		id, _ := v["id"].(string)
+		if id == "" {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting provisioned volume: %s", id)
+		err := util.RetryContext(ctx, timeout, func() *resource.RetryError {
+			resp, err := client.Storage.DeleteVolume(ctx, id)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return nil
+				}
+
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					log.Printf("[DEBUG] Provisioned volume %s is still attached, retrying: %s", id, err)
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting provisioned volume %s: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+// cleanupProvisionedVolumes deletes the volumes created for a Droplet which
+// failed to be created, returning the original error along with any error
+// deleting them.
+func cleanupProvisionedVolumes(ctx context.Context, client *godo.Client, volumes []map[string]interface{}, timeout time.Duration, err error) error {
+	raw := make([]interface{}, 0, len(volumes))
+	for _, v := range volumes {
+		raw = append(raw, v)
+	}
+
+	if cleanupErr := deleteProvisionedVolumes(ctx, client, raw, timeout); cleanupErr != nil {
+		return fmt.Errorf("%s\n\nAdditionally, the provisioned volumes could not be cleaned up: %s", err, cleanupErr)
+	}
+
+	return err
+}
+
+// provisionedVolumeIDs returns the IDs of the provisioned volumes in the state.
+func provisionedVolumeIDs(d *schema.ResourceData) map[string]bool {
+	ids := map[string]bool{}
+	for _, raw := range d.Get("provisioned_volumes").([]interface{}) {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if id, _ := v["id"].(string); id != "" {
+				ids[id] = true
+			}
+		}
+	}
+
+	return ids
+}
+
+// ProvisionedVolumesUserData returns the user data mounting the provisioned
+// volumes using cloud-init. If the Droplet has user data, both are combined
+// into a multi-part archive, leaving cloud-init to detect the type of the
+// original user data.
+func ProvisionedVolumesUserData(volumes []map[string]interface{}, userData string) (string, error) {
+	if len(volumes) == 0 {
+		return userData, nil
+	}
+
+	var config strings.Builder
+	config.WriteString("#cloud-config\nmounts:\n")
+	for _, v := range volumes {
+		fmt.Fprintf(&config, "  - %s\n",
+			volume.MountEntry(v["name"].(string), v["mount_point"].(string), v["filesystem_type"].(string), volume.DefaultMountOptions))
+	}
+
+	if userData == "" {
+		return config.String(), nil
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(provisionedVolumesBoundary); err != nil {
+		return "", err
+	}
+
+	parts := []struct {
+		contentType string
+		filename    string
+		content     string
+	}{
+		{"text/cloud-config", "provisioned-volumes.cfg", config.String()},
+		{"text/plain", "user-data", userData},
+	}
+
+	for _, p := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", p.contentType+`; charset="utf-8"`)
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.filename))
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(p.content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q\nMIME-Version: 1.0\n\n%s", provisionedVolumesBoundary, buf.String()), nil
+}
@@ -11,8 +11,12 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/oneclick"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/volume"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -46,14 +50,20 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 			"image": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"rebuild_on_image_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to rebuild the Droplet from the new image, keeping its ID, IP addresses, and volumes, instead of replacing it when the image changes",
+			},
+
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: util.ValidateDropletName,
 			},
 
 			"region": {
@@ -84,6 +94,13 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Default:  false,
 			},
 
+			"destroy_associated_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to destroy the Droplet's associated snapshots, volumes, volume snapshots, and reserved IPs along with it",
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +111,8 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed: true,
 			},
 
+			"project_id": project.ProjectIDSchema(),
+
 			"disk": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -199,6 +218,8 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Computed: true,
 			},
 
+			"provisioned_volumes": provisionedVolumesSchema(),
+
 			"monitoring": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -214,13 +235,28 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 
 			"tags": tag.TagsSchema(),
 
+			"tags_authoritative": tag.TagsAuthoritativeSchema(),
+
 			"vpc_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			"features": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the features enabled on the Droplet, e.g. backups, ipv6 or monitoring",
+			},
+
+			"region_features": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the features available in the region of the Droplet",
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -244,10 +280,69 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 					return old.(bool) && !new.(bool)
 				},
 			),
+			// Resolve the price of new or resized Droplets so that it is known
+			// in the plan, e.g. for policies enforcing a budget.
+			customdiff.IfValueChange("size",
+				func(ctx context.Context, old, new, meta interface{}) bool {
+					return old.(string) != new.(string)
+				},
+				setDropletPriceDiff,
+			),
+			validateProvisionedVolumesDiff,
+			forceNewIfDropletVPCMoved,
+			// Changing the image replaces the Droplet unless it is opted in
+			// to be rebuilt in place.
+			customdiff.ForceNewIf("image", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("image") && !d.Get("rebuild_on_image_change").(bool)
+			}),
 		),
 	}
 }
 
+// forceNewIfDropletVPCMoved replaces the Droplet when it is moved to another
+// VPC. A Droplet which is not in a VPC yet can join the default VPC of its
+// region in place by enabling private networking.
+func forceNewIfDropletVPCMoved(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("vpc_uuid") {
+		return nil
+	}
+
+	old, new := d.GetChange("vpc_uuid")
+	if old.(string) == "" && d.NewValueKnown("vpc_uuid") {
+		client := meta.(*config.CombinedConfig).GodoClient()
+		vpc, _, err := client.VPCs.Get(ctx, new.(string))
+		if err != nil {
+			return fmt.Errorf("Error retrieving VPC %s: %s", new, err)
+		}
+
+		if vpc.Default && vpc.RegionSlug == d.Get("region").(string) {
+			return nil
+		}
+	}
+
+	return d.ForceNew("vpc_uuid")
+}
+
+func setDropletPriceDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("size") {
+		return nil
+	}
+
+	s, err := size.FindSize(ctx, meta.(*config.CombinedConfig), d.Get("size").(string))
+	if err != nil {
+		log.Printf("[WARN] Unable to resolve the price of the Droplet size: %s", err)
+		if err := d.SetNewComputed("price_hourly"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("price_monthly")
+	}
+
+	if err := d.SetNew("price_hourly", s.PriceHourly); err != nil {
+		return err
+	}
+	return d.SetNew("price_monthly", s.PriceMonthly)
+}
+
 func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -255,20 +350,21 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 
 	// Build up our creation options
 	opts := &godo.DropletCreateRequest{
-		Image:  godo.DropletCreateImage{},
-		Name:   d.Get("name").(string),
+		Name:   meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 		Region: d.Get("region").(string),
 		Size:   d.Get("size").(string),
 		Tags:   tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
 	}
 
-	imageId, err := strconv.Atoi(image)
-	if err == nil {
-		// The image field is provided as an ID (number).
-		opts.Image.ID = imageId
-	} else {
-		opts.Image.Slug = image
+	if err := tag.CreateMissingTags(ctx, meta, opts.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	createImage, err := expandDropletImage(ctx, client, image)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	opts.Image = createImage
 
 	if attr, ok := d.GetOk("backups"); ok {
 		opts.Backups = attr.(bool)
@@ -314,6 +410,29 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		opts.VPCUUID = attr.(string)
 	}
 
+	var provisionedVolumes []map[string]interface{}
+	if attr, ok := d.GetOk("provisioned_volumes"); ok {
+		if opts.Region == "" {
+			return diag.Errorf("`region` must be set to create `provisioned_volumes`")
+		}
+
+		provisionedVolumes, err = createProvisionedVolumes(ctx, client, opts.Region, attr.([]interface{}))
+		if err != nil {
+			return diag.FromErr(cleanupProvisionedVolumes(ctx, client, provisionedVolumes, d.Timeout(schema.TimeoutCreate), err))
+		}
+
+		for _, v := range provisionedVolumes {
+			opts.Volumes = append(opts.Volumes, godo.DropletCreateVolume{
+				ID: v["id"].(string),
+			})
+		}
+
+		opts.UserData, err = ProvisionedVolumesUserData(provisionedVolumes, opts.UserData)
+		if err != nil {
+			return diag.FromErr(cleanupProvisionedVolumes(ctx, client, provisionedVolumes, d.Timeout(schema.TimeoutCreate), err))
+		}
+	}
+
 	// Get configured ssh_keys
 	if v, ok := d.GetOk("ssh_keys"); ok {
 		expandedSshKeys, err := expandSshKeys(v.(*schema.Set).List())
@@ -325,21 +444,34 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Droplet create configuration: %#v", opts)
 
-	droplet, _, err := client.Droplets.Create(context.Background(), opts)
+	droplet, _, err := client.Droplets.Create(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Error creating droplet: %s", err)
+		err = fmt.Errorf("Error creating droplet: %s", err)
+		return diag.FromErr(cleanupProvisionedVolumes(ctx, client, provisionedVolumes, d.Timeout(schema.TimeoutCreate), err))
 	}
 
 	// Assign the droplets id
 	d.SetId(strconv.Itoa(droplet.ID))
 	log.Printf("[INFO] Droplet ID: %s", d.Id())
 
+	if len(provisionedVolumes) > 0 {
+		if err := d.Set("provisioned_volumes", provisionedVolumes); err != nil {
+			return diag.Errorf("Error setting `provisioned_volumes`: %s", err)
+		}
+	}
+
 	// Ensure Droplet status has moved to "active."
 	_, err = waitForDropletAttribute(ctx, d, "active", []string{"new"}, "status", schema.TimeoutCreate, meta)
 	if err != nil {
 		return diag.Errorf("Error waiting for droplet (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("project_id"); ok {
+		if err := project.AssignResource(ctx, client, v.(string), droplet.URN()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// waitForDropletAttribute updates the Droplet's state and calls setDropletAttributes.
 	// So there is no need to call resourceDigitalOceanDropletRead and add additional API calls.
 	return nil
@@ -354,7 +486,7 @@ func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Retrieve the droplet properties for updating the state
-	droplet, resp, err := client.Droplets.Get(context.Background(), id)
+	droplet, resp, err := client.Droplets.Get(ctx, id)
 	if err != nil {
 		// check if the droplet no longer exists.
 		if resp != nil && resp.StatusCode == 404 {
@@ -366,21 +498,34 @@ func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Error retrieving droplet: %s", err)
 	}
 
-	err = setDropletAttributes(d, droplet)
+	err = setDropletAttributes(d, droplet, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := project.ReadProjectID(ctx, client, d, droplet.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("destroy_associated_resources").(bool) {
+		resources, err := listDropletAssociatedResources(ctx, client, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving droplet associated resources: %s", err)
+		}
+
+		return dropletAssociatedResourcesWarning(id, resources)
+	}
+
 	return nil
 }
 
-func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
+func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet, meta interface{}) error {
 	// Note that the image attribute is not set here. It is intentionally allowed
 	// to drift once the Droplet has been created. This is to workaround the fact that
 	// image slugs can move to point to images with a different ID. Image slugs are helpers
 	// that always point to the most recent version of an image.
 	// See: https://github.com/digitalocean/terraform-provider-digitalocean/issues/152
-	d.Set("name", droplet.Name)
+	d.Set("name", meta.(*config.CombinedConfig).UnprefixName(droplet.Name))
 	d.Set("urn", droplet.URN())
 	d.Set("region", droplet.Region.Slug)
 	d.Set("size", droplet.Size.Slug)
@@ -405,11 +550,30 @@ func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
 		d.Set("monitoring", containsDigitalOceanDropletFeature(features, "monitoring"))
 	}
 
-	if err := d.Set("volume_ids", flattenDigitalOceanDropletVolumeIds(droplet.VolumeIDs)); err != nil {
+	if err := d.Set("features", flattenDigitalOceanDropletFeatures(droplet.Features)); err != nil {
+		return fmt.Errorf("Error setting `features`: %+v", err)
+	}
+	if droplet.Region != nil {
+		if err := d.Set("region_features", flattenDigitalOceanDropletFeatures(droplet.Region.Features)); err != nil {
+			return fmt.Errorf("Error setting `region_features`: %+v", err)
+		}
+	}
+
+	// Provisioned volumes are managed by the provisioned_volumes block, so
+	// they are excluded to not be detached when volume_ids changes.
+	provisioned := provisionedVolumeIDs(d)
+	volumeIDs := make([]string, 0, len(droplet.VolumeIDs))
+	for _, id := range droplet.VolumeIDs {
+		if !provisioned[id] {
+			volumeIDs = append(volumeIDs, id)
+		}
+	}
+
+	if err := d.Set("volume_ids", flattenDigitalOceanDropletVolumeIds(volumeIDs)); err != nil {
 		return fmt.Errorf("Error setting `volume_ids`: %+v", err)
 	}
 
-	if err := d.Set("tags", tag.FlattenTags(droplet.Tags)); err != nil {
+	if err := tag.SetManagedTags(d, droplet.Tags); err != nil {
 		return fmt.Errorf("Error setting `tags`: %+v", err)
 	}
 
@@ -441,8 +605,10 @@ func resourceDigitalOceanDropletImport(d *schema.ResourceData, meta interface{})
 		d.Set("image", godo.Stringify(droplet.Image.ID))
 	}
 
-	// This is a non API attribute. So set to the default setting in the schema.
+	// These are non API attributes. So set to the default setting in the schema.
 	d.Set("resize_disk", true)
+	d.Set("destroy_associated_resources", false)
+	d.Set("rebuild_on_image_change", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -478,159 +644,228 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("invalid droplet id: %v", err)
 	}
 
+	if d.HasChange("image") {
+		if err := rebuildDroplet(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("size") {
-		newSize := d.Get("size")
-		resizeDisk := d.Get("resize_disk").(bool)
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			newSize := d.Get("size")
+			resizeDisk := d.Get("resize_disk").(bool)
 
-		_, _, err = client.DropletActions.PowerOff(context.Background(), id)
-		if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
-			return diag.Errorf(
-				"Error powering off droplet (%s): %s", d.Id(), err)
-		}
+			_, _, err = client.DropletActions.PowerOff(ctx, id)
+			if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
+				return diag.Errorf(
+					"Error powering off droplet (%s): %s", d.Id(), err)
+			}
 
-		// Wait for power off
-		_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutUpdate, meta)
-		if err != nil {
-			return diag.Errorf(
-				"Error waiting for droplet (%s) to become powered off: %s", d.Id(), err)
-		}
+			// Wait for power off
+			_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutUpdate, meta)
+			if err != nil {
+				return diag.Errorf(
+					"Error waiting for droplet (%s) to become powered off: %s", d.Id(), err)
+			}
 
-		// Resize the droplet
-		var action *godo.Action
-		action, _, err = client.DropletActions.Resize(context.Background(), id, newSize.(string), resizeDisk)
-		if err != nil {
-			newErr := powerOnAndWait(ctx, d, meta)
-			if newErr != nil {
+			// Resize the droplet
+			var action *godo.Action
+			action, _, err = client.DropletActions.Resize(ctx, id, newSize.(string), resizeDisk)
+			if err != nil {
+				newErr := powerOnAndWait(ctx, d, meta)
+				if newErr != nil {
+					return diag.Errorf(
+						"Error powering on droplet (%s) after failed resize: %s", d.Id(), err)
+				}
 				return diag.Errorf(
-					"Error powering on droplet (%s) after failed resize: %s", d.Id(), err)
+					"Error resizing droplet (%s): %s", d.Id(), err)
 			}
-			return diag.Errorf(
-				"Error resizing droplet (%s): %s", d.Id(), err)
-		}
 
-		// Wait for the resize action to complete.
-		if err = util.WaitForAction(client, action); err != nil {
-			newErr := powerOnAndWait(ctx, d, meta)
-			if newErr != nil {
+			// Wait for the resize action to complete.
+			if err = util.WaitForAction(ctx, client, action); err != nil {
+				newErr := powerOnAndWait(ctx, d, meta)
+				if newErr != nil {
+					return diag.Errorf(
+						"Error powering on droplet (%s) after waiting for resize to finish: %s", d.Id(), err)
+				}
 				return diag.Errorf(
-					"Error powering on droplet (%s) after waiting for resize to finish: %s", d.Id(), err)
+					"Error waiting for resize droplet (%s) to finish: %s", d.Id(), err)
 			}
-			return diag.Errorf(
-				"Error waiting for resize droplet (%s) to finish: %s", d.Id(), err)
-		}
 
-		_, _, err = client.DropletActions.PowerOn(context.Background(), id)
+			_, _, err = client.DropletActions.PowerOn(ctx, id)
 
-		if err != nil {
-			return diag.Errorf(
-				"Error powering on droplet (%s) after resize: %s", d.Id(), err)
-		}
+			if err != nil {
+				return diag.Errorf(
+					"Error powering on droplet (%s) after resize: %s", d.Id(), err)
+			}
 
-		// Wait for power on
-		_, err = waitForDropletAttribute(ctx, d, "active", []string{"off"}, "status", schema.TimeoutUpdate, meta)
-		if err != nil {
-			return diag.FromErr(err)
+			// Wait for power on
+			_, err = waitForDropletAttribute(ctx, d, "active", []string{"off"}, "status", schema.TimeoutUpdate, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		})
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	if d.HasChange("name") {
-		oldName, newName := d.GetChange("name")
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			oldName, newName := d.GetChange("name")
 
-		// Rename the droplet
-		_, _, err = client.DropletActions.Rename(context.Background(), id, newName.(string))
+			// Rename the droplet
+			_, _, err = client.DropletActions.Rename(ctx, id, meta.(*config.CombinedConfig).PrefixName(newName.(string)))
 
-		if err != nil {
-			return diag.Errorf(
-				"Error renaming droplet (%s): %s", d.Id(), err)
-		}
+			if err != nil {
+				return diag.Errorf(
+					"Error renaming droplet (%s): %s", d.Id(), err)
+			}
 
-		// Wait for the name to change
-		_, err = waitForDropletAttribute(
-			ctx, d, newName.(string), []string{"", oldName.(string)}, "name", schema.TimeoutUpdate, meta)
+			// Wait for the name to change
+			_, err = waitForDropletAttribute(
+				ctx, d, newName.(string), []string{"", oldName.(string)}, "name", schema.TimeoutUpdate, meta)
 
-		if err != nil {
-			return diag.Errorf(
-				"Error waiting for rename droplet (%s) to finish: %s", d.Id(), err)
+			if err != nil {
+				return diag.Errorf(
+					"Error waiting for rename droplet (%s) to finish: %s", d.Id(), err)
+			}
+
+			return nil
+		})
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	if d.HasChange("backups") {
-		if d.Get("backups").(bool) {
-			// Enable backups on droplet
-			action, _, err := client.DropletActions.EnableBackups(context.Background(), id)
-			if err != nil {
-				return diag.Errorf(
-					"Error enabling backups on droplet (%s): %s", d.Id(), err)
-			}
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			if d.Get("backups").(bool) {
+				// Enable backups on droplet
+				action, _, err := client.DropletActions.EnableBackups(ctx, id)
+				if err != nil {
+					return diag.Errorf(
+						"Error enabling backups on droplet (%s): %s", d.Id(), err)
+				}
+
+				if err := util.WaitForAction(ctx, client, action); err != nil {
+					return diag.Errorf("Error waiting for backups to be enabled for droplet (%s): %s", d.Id(), err)
+				}
+			} else {
+				// Disable backups on droplet
+				action, _, err := client.DropletActions.DisableBackups(ctx, id)
+				if err != nil {
+					return diag.Errorf(
+						"Error disabling backups on droplet (%s): %s", d.Id(), err)
+				}
 
-			if err := util.WaitForAction(client, action); err != nil {
-				return diag.Errorf("Error waiting for backups to be enabled for droplet (%s): %s", d.Id(), err)
+				if err := util.WaitForAction(ctx, client, action); err != nil {
+					return diag.Errorf("Error waiting for backups to be disabled for droplet (%s): %s", d.Id(), err)
+				}
 			}
-		} else {
-			// Disable backups on droplet
-			action, _, err := client.DropletActions.DisableBackups(context.Background(), id)
+
+			return nil
+		})
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// As there is no way to disable private networking,
+	// we only check if it needs to be enabled. A change of the VPC is only
+	// planned in place when a Droplet which is not in a VPC joins the default
+	// one, which is done by enabling private networking.
+	enablePrivateNetworking := d.HasChange("private_networking") && d.Get("private_networking").(bool)
+	joinVPC := d.HasChange("vpc_uuid")
+	if enablePrivateNetworking || joinVPC {
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			vpcUUID := d.Get("vpc_uuid").(string)
+
+			_, _, err = client.DropletActions.EnablePrivateNetworking(ctx, id)
+
 			if err != nil {
 				return diag.Errorf(
-					"Error disabling backups on droplet (%s): %s", d.Id(), err)
+					"Error enabling private networking for droplet (%s): %s", d.Id(), err)
 			}
 
-			if err := util.WaitForAction(client, action); err != nil {
-				return diag.Errorf("Error waiting for backups to be disabled for droplet (%s): %s", d.Id(), err)
+			// Wait for the private_networking to turn on
+			if enablePrivateNetworking {
+				_, err = waitForDropletAttribute(
+					ctx, d, "true", []string{"", "false"}, "private_networking", schema.TimeoutUpdate, meta)
+
+				if err != nil {
+					return diag.Errorf(
+						"Error waiting for private networking to be enabled on for droplet (%s): %s", d.Id(), err)
+				}
 			}
-		}
-	}
 
-	// As there is no way to disable private networking,
-	// we only check if it needs to be enabled
-	if d.HasChange("private_networking") && d.Get("private_networking").(bool) {
-		_, _, err = client.DropletActions.EnablePrivateNetworking(context.Background(), id)
+			if joinVPC {
+				_, err = waitForDropletAttribute(
+					ctx, d, vpcUUID, []string{""}, "vpc_uuid", schema.TimeoutUpdate, meta)
 
-		if err != nil {
-			return diag.Errorf(
-				"Error enabling private networking for droplet (%s): %s", d.Id(), err)
-		}
+				if err != nil {
+					return diag.Errorf(
+						"Error waiting for droplet (%s) to join VPC %s: %s", d.Id(), vpcUUID, err)
+				}
+			}
 
-		// Wait for the private_networking to turn on
-		_, err = waitForDropletAttribute(
-			ctx, d, "true", []string{"", "false"}, "private_networking", schema.TimeoutUpdate, meta)
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Enabling private networking requires additional OS-level configuration",
+				Detail:   "When enabling private networking on an existing Droplet, its private network interface must be configured inside the Droplet before it can be used.",
+			})
 
-		if err != nil {
-			return diag.Errorf(
-				"Error waiting for private networking to be enabled on for droplet (%s): %s", d.Id(), err)
+			return nil
+		})
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	// As there is no way to disable IPv6, we only check if it needs to be enabled
 	if d.HasChange("ipv6") && d.Get("ipv6").(bool) {
-		_, _, err = client.DropletActions.EnableIPv6(context.Background(), id)
-		if err != nil {
-			return diag.Errorf(
-				"Error turning on ipv6 for droplet (%s): %s", d.Id(), err)
-		}
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			_, _, err = client.DropletActions.EnableIPv6(ctx, id)
+			if err != nil {
+				return diag.Errorf(
+					"Error turning on ipv6 for droplet (%s): %s", d.Id(), err)
+			}
 
-		// Wait for ipv6 to turn on
-		_, err = waitForDropletAttribute(
-			ctx, d, "true", []string{"", "false"}, "ipv6", schema.TimeoutUpdate, meta)
+			// Wait for ipv6 to turn on
+			_, err = waitForDropletAttribute(
+				ctx, d, "true", []string{"", "false"}, "ipv6", schema.TimeoutUpdate, meta)
 
-		if err != nil {
-			return diag.Errorf(
-				"Error waiting for ipv6 to be turned on for droplet (%s): %s", d.Id(), err)
-		}
+			if err != nil {
+				return diag.Errorf(
+					"Error waiting for ipv6 to be turned on for droplet (%s): %s", d.Id(), err)
+			}
 
-		warnings = append(warnings, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Enabling IPv6 requires additional OS-level configuration",
-			Detail:   "When enabling IPv6 on an existing Droplet, additional OS-level configuration is required. For more info, see: \nhttps://docs.digitalocean.com/products/networking/ipv6/how-to/enable/#on-existing-droplets",
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Enabling IPv6 requires additional OS-level configuration",
+				Detail:   "When enabling IPv6 on an existing Droplet, additional OS-level configuration is required. For more info, see: \nhttps://docs.digitalocean.com/products/networking/ipv6/how-to/enable/#on-existing-droplets",
+			})
+
+			return nil
 		})
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	if d.HasChange("tags") {
-		err = tag.SetTags(client, d, godo.DropletResourceType)
+		err = tag.SetTags(ctx, client, d, godo.DropletResourceType)
 		if err != nil {
 			return diag.Errorf("Error updating tags: %s", err)
 		}
 	}
 
+	if err := project.UpdateProjectID(ctx, client, d, godo.Droplet{ID: id}.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("volume_ids") {
 		oldIDs, newIDs := d.GetChange("volume_ids")
 		newSet := func(ids []interface{}) map[string]struct{} {
@@ -653,17 +888,24 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		oldIDSet := newSet(oldIDs.(*schema.Set).List())
 		newIDSet := newSet(newIDs.(*schema.Set).List())
 		for volumeID := range leftDiff(newIDSet, oldIDSet) {
-			action, _, err := client.StorageActions.Attach(context.Background(), volumeID, id)
-			if err != nil {
-				return diag.Errorf("Error attaching volume %q to droplet (%s): %s", volumeID, d.Id(), err)
-			}
-			// can't fire >1 action at a time, so waiting for each is OK
-			if err := util.WaitForAction(client, action); err != nil {
-				return diag.Errorf("Error waiting for volume %q to attach to droplet (%s): %s", volumeID, d.Id(), err)
+			diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+				action, _, err := client.StorageActions.Attach(ctx, volumeID, id)
+				if err != nil {
+					return diag.Errorf("Error attaching volume %q to droplet (%s): %s", volumeID, d.Id(), err)
+				}
+				// can't fire >1 action at a time, so waiting for each is OK
+				if err := util.WaitForAction(ctx, client, action); err != nil {
+					return diag.Errorf("Error waiting for volume %q to attach to droplet (%s): %s", volumeID, d.Id(), err)
+				}
+
+				return nil
+			})
+			if diags.HasError() {
+				return diags
 			}
 		}
 		for volumeID := range leftDiff(oldIDSet, newIDSet) {
-			detachVolumeIDOnDroplet(d, volumeID, meta)
+			detachVolumeIDOnDroplet(ctx, d, volumeID, meta)
 		}
 	}
 
@@ -695,45 +937,77 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	if shutdown {
 		log.Printf("[INFO] Shutting down droplet: %s", d.Id())
 
-		// Shutdown the droplet
-		// DO API doesn't return an error if we try to shutdown an already shutdown droplet
-		_, _, err = client.DropletActions.Shutdown(context.Background(), id)
-		if err != nil {
-			return diag.Errorf(
-				"Error shutting down the the droplet (%s): %s", d.Id(), err)
-		}
+		diags := withDropletActionLock(ctx, meta, id, func() diag.Diagnostics {
+			// Shutdown the droplet
+			// DO API doesn't return an error if we try to shutdown an already shutdown droplet
+			_, _, err = client.DropletActions.Shutdown(ctx, id)
+			if err != nil {
+				return diag.Errorf(
+					"Error shutting down the the droplet (%s): %s", d.Id(), err)
+			}
 
-		// Wait for shutdown
-		_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutDelete, meta)
-		if err != nil {
-			return diag.Errorf("Error waiting for droplet (%s) to become off: %s", d.Id(), err)
+			// Wait for shutdown
+			_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutDelete, meta)
+			if err != nil {
+				return diag.Errorf("Error waiting for droplet (%s) to become off: %s", d.Id(), err)
+			}
+
+			return nil
+		})
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
-	err = detachVolumesFromDroplet(d, meta)
-	if err != nil {
-		return diag.Errorf(
-			"Error detaching the volumes from the droplet (%s): %s", d.Id(), err)
-	}
+	var resp *godo.Response
+	if d.Get("destroy_associated_resources").(bool) {
+		log.Printf("[INFO] Deleting droplet with associated resources: %s", d.Id())
+
+		// Attached volumes are destroyed along with the droplet, so they are not detached first.
+		resp, err = destroyDropletWithAssociatedResources(ctx, client, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return diag.Errorf("Error deleting droplet with associated resources: %s", err)
+		}
+	} else {
+		log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
+		err = detachVolumesFromDroplet(ctx, d, meta)
+		if err != nil {
+			return diag.Errorf(
+				"Error detaching the volumes from the droplet (%s): %s", d.Id(), err)
+		}
 
-	log.Printf("[INFO] Deleting droplet: %s", d.Id())
+		log.Printf("[INFO] Deleting droplet: %s", d.Id())
 
-	// Destroy the droplet
-	resp, err := client.Droplets.Delete(context.Background(), id)
+		// Destroy the droplet
+		resp, err = client.Droplets.Delete(ctx, id)
+	}
 
 	// Handle already destroyed droplets
 	if err != nil && resp.StatusCode == 404 {
-		return nil
+		return deleteDropletProvisionedVolumes(ctx, d, meta)
 	}
 
 	_, err = waitForDropletDestroy(ctx, d, meta)
-	if err != nil && strings.Contains(err.Error(), "404") {
-		return nil
-	} else if err != nil {
+	if err != nil && !strings.Contains(err.Error(), "404") {
 		return diag.Errorf("Error deleting droplet: %s", err)
 	}
 
+	return deleteDropletProvisionedVolumes(ctx, d, meta)
+}
+
+// deleteDropletProvisionedVolumes deletes the volumes created by the
+// provisioned_volumes block once the Droplet has been destroyed.
+func deleteDropletProvisionedVolumes(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	volumes := d.Get("provisioned_volumes").([]interface{})
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	if err := deleteProvisionedVolumes(ctx, client, volumes, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -749,7 +1023,7 @@ func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta int
 		MinTimeout: 3 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func waitForDropletAttribute(
@@ -774,7 +1048,7 @@ func waitForDropletAttribute(
 		NotFoundChecks: 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // TODO This function still needs a little more refactoring to make it
@@ -789,12 +1063,12 @@ func dropletStateRefreshFunc(
 		}
 
 		// Retrieve the droplet properties
-		droplet, _, err := client.Droplets.Get(context.Background(), id)
+		droplet, _, err := client.Droplets.Get(ctx, id)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error retrieving droplet: %s", err)
 		}
 
-		err = setDropletAttributes(d, droplet)
+		err = setDropletAttributes(d, droplet, meta)
 		if err != nil {
 			return nil, "", err
 		}
@@ -821,7 +1095,87 @@ func dropletStateRefreshFunc(
 	}
 }
 
-// Powers on the droplet and waits for it to be active
+// expandDropletImage returns the image to create or rebuild a Droplet from.
+// The image is either an ID, an image slug, or the slug of a 1-Click App,
+// which is resolved to the ID of the current image of the App.
+func expandDropletImage(ctx context.Context, client *godo.Client, image string) (godo.DropletCreateImage, error) {
+	if imageID, err := strconv.Atoi(image); err == nil {
+		return godo.DropletCreateImage{ID: imageID}, nil
+	}
+
+	imageID, err := oneclick.ResolveDropletImage(ctx, client, image)
+	if err != nil {
+		return godo.DropletCreateImage{}, err
+	}
+	if imageID != 0 {
+		return godo.DropletCreateImage{ID: imageID}, nil
+	}
+
+	return godo.DropletCreateImage{Slug: image}, nil
+}
+
+// rebuildDroplet rebuilds the Droplet from its new image, which keeps its ID,
+// IP addresses, and attached volumes.
+func rebuildDroplet(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid droplet id: %v", err)
+	}
+
+	unlock, err := combined.LockDropletActions(ctx, id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the lock of droplet (%d) actions: %s", id, err)
+	}
+	defer unlock()
+
+	image := d.Get("image").(string)
+
+	createImage, err := expandDropletImage(ctx, client, image)
+	if err != nil {
+		return err
+	}
+
+	var action *godo.Action
+	if createImage.ID != 0 {
+		action, _, err = client.DropletActions.RebuildByImageID(ctx, id, createImage.ID)
+	} else {
+		action, _, err = client.DropletActions.RebuildByImageSlug(ctx, id, createImage.Slug)
+	}
+	if err != nil {
+		return fmt.Errorf("Error rebuilding droplet (%s) from image %s: %s", d.Id(), image, err)
+	}
+
+	if err := util.WaitForAction(ctx, client, action); err != nil {
+		return fmt.Errorf("Error waiting for rebuild of droplet (%s) to finish: %s", d.Id(), err)
+	}
+
+	// Wait for the Droplet to be running again
+	_, err = waitForDropletAttribute(ctx, d, "active", []string{"new", "off"}, "status", schema.TimeoutUpdate, meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for droplet (%s) to become active after rebuild: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// withDropletActionLock runs f, which makes actions against the Droplet, while
+// holding the lock of the Droplet's actions so that they do not collide with
+// the actions of volume attachments and reserved IP assignments.
+func withDropletActionLock(ctx context.Context, meta interface{}, id int, f func() diag.Diagnostics) diag.Diagnostics {
+	unlock, err := meta.(*config.CombinedConfig).LockDropletActions(ctx, id)
+	if err != nil {
+		return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", id, err)
+	}
+	defer unlock()
+
+	return f()
+}
+
+// Powers on the droplet and waits for it to be active. The caller must hold
+// the lock of the Droplet's actions.
 func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -829,7 +1183,7 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	client := meta.(*config.CombinedConfig).GodoClient()
-	_, _, err = client.DropletActions.PowerOn(context.Background(), id)
+	_, _, err = client.DropletActions.PowerOn(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -844,12 +1198,14 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 // Detach volumes from droplet
-func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
+func detachVolumesFromDroplet(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var errors []error
 	if attr, ok := d.GetOk("volume_ids"); ok {
 		errors = make([]error, 0, attr.(*schema.Set).Len())
 		for _, volumeID := range attr.(*schema.Set).List() {
-			detachVolumeIDOnDroplet(d, volumeID.(string), meta)
+			if err := detachVolumeIDOnDroplet(ctx, d, volumeID.(string), meta); err != nil {
+				errors = append(errors, err)
+			}
 		}
 	}
 
@@ -860,22 +1216,51 @@ func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func detachVolumeIDOnDroplet(d *schema.ResourceData, volumeID string, meta interface{}) error {
+func detachVolumeIDOnDroplet(ctx context.Context, d *schema.ResourceData, volumeID string, meta interface{}) error {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("invalid droplet id: %v", err)
 	}
-	client := meta.(*config.CombinedConfig).GodoClient()
-	action, _, err := client.StorageActions.DetachByDropletID(context.Background(), volumeID, id)
-	if err != nil {
-		return fmt.Errorf("Error detaching volume %q from droplet (%s): %s", volumeID, d.Id(), err)
-	}
-	// can't fire >1 action at a time, so waiting for each is OK
-	if err := util.WaitForAction(client, action); err != nil {
-		return fmt.Errorf("Error waiting for volume %q to detach from droplet (%s): %s", volumeID, d.Id(), err)
-	}
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
+
+	// A digitalocean_volume_attachment may be detaching the same volume
+	// concurrently, so retry while the Droplet has a pending event and treat
+	// a volume which is no longer attached as successfully detached.
+	return util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		unlock, err := combined.LockDropletActions(ctx, id)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error waiting for the lock of droplet (%s) actions: %s", d.Id(), err))
+		}
+		defer unlock()
 
-	return nil
+		action, resp, err := client.StorageActions.DetachByDropletID(ctx, volumeID, id)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("[DEBUG] Volume %q or droplet (%s) not found, considering volume detached", volumeID, d.Id())
+				return nil
+			}
+
+			if util.IsRetryableConflict(err) {
+				log.Printf("[DEBUG] Received %s, retrying detaching volume %q from droplet (%s)", err, volumeID, d.Id())
+				return resource.RetryableError(err)
+			}
+
+			v, _, getErr := client.Storage.GetVolume(ctx, volumeID)
+			if getErr == nil && !volume.AttachedToDroplet(v, id) {
+				log.Printf("[DEBUG] Volume %q is no longer attached to droplet (%s)", volumeID, d.Id())
+				return nil
+			}
+
+			return resource.NonRetryableError(fmt.Errorf("Error detaching volume %q from droplet (%s): %s", volumeID, d.Id(), err))
+		}
+		// can't fire >1 action at a time, so waiting for each is OK
+		if err := util.WaitForAction(ctx, client, action); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error waiting for volume %q to detach from droplet (%s): %s", volumeID, d.Id(), err))
+		}
+
+		return nil
+	})
 }
 
 func containsDigitalOceanDropletFeature(features []string, name string) bool {
@@ -913,3 +1298,12 @@ func flattenDigitalOceanDropletVolumeIds(volumeids []string) *schema.Set {
 
 	return flattenedVolumes
 }
+
+func flattenDigitalOceanDropletFeatures(features []string) *schema.Set {
+	flattenedFeatures := schema.NewSet(schema.HashString, []interface{}{})
+	for _, v := range features {
+		flattenedFeatures.Add(v)
+	}
+
+	return flattenedFeatures
+}
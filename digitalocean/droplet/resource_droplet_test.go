@@ -1,13 +1,19 @@
 package droplet_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -62,6 +68,254 @@ func TestAccDigitalOceanDroplet_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_ProvisionedVolumes(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+	volumeName := acceptance.RandomTestName("volume")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acceptance.TestAccCheckDigitalOceanDropletDestroy,
+			testAccCheckDigitalOceanDropletProvisionedVolumesDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_ProvisionedVolumes(name, volumeName),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "provisioned_volumes.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "provisioned_volumes.0.name", volumeName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "provisioned_volumes.0.filesystem_type", "xfs"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "provisioned_volumes.0.mount_point", "/mnt/"+volumeName),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet.foobar", "provisioned_volumes.0.id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "volume_ids.#", "0"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data", util.HashString("foobar")),
+					func(s *terraform.State) error {
+						if len(droplet.VolumeIDs) != 1 {
+							return fmt.Errorf("expected 1 attached volume, got %d", len(droplet.VolumeIDs))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestProvisionedVolumesUserData(t *testing.T) {
+	volumes := []map[string]interface{}{
+		{
+			"name":            "data",
+			"mount_point":     "/mnt/data",
+			"filesystem_type": "ext4",
+		},
+	}
+	mount := `["/dev/disk/by-id/scsi-0DO_Volume_data", "/mnt/data", "ext4", "defaults,nofail,discard,noatime", "0", "2"]`
+
+	userData, err := droplet.ProvisionedVolumesUserData(volumes, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.HasPrefix(userData, "#cloud-config\n") || !strings.Contains(userData, mount) {
+		t.Fatalf("unexpected user data without existing user data: %s", userData)
+	}
+
+	userData, err = droplet.ProvisionedVolumesUserData(volumes, "#!/bin/bash\necho hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, expected := range []string{"Content-Type: multipart/mixed", "text/cloud-config", mount, "#!/bin/bash\necho hello"} {
+		if !strings.Contains(userData, expected) {
+			t.Fatalf("expected user data to contain %q, got: %s", expected, userData)
+		}
+	}
+
+	userData, err = droplet.ProvisionedVolumesUserData(nil, "foobar")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if userData != "foobar" {
+		t.Fatalf("expected user data to be unchanged, got: %s", userData)
+	}
+}
+
+func TestDropletUpdateLocksDropletActions(t *testing.T) {
+	var actions int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/droplets/123/actions":
+			atomic.AddInt32(&actions, 1)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"action": {"id": 1, "status": "completed", "type": "rename"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/droplets/123":
+			w.Write([]byte(`{"droplet": {"id": 123, "name": "bar", "status": "active", "size": {"slug": "s-1vcpu-1gb"}, "image": {"slug": "ubuntu-22-04-x64"}, "region": {"slug": "nyc3"}, "networks": {}}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:             "12345",
+		APIEndpoint:       server.URL,
+		ActionConcurrency: 1,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := droplet.ResourceDigitalOceanDroplet()
+	s := &terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"name":       "foo",
+			"image":      "ubuntu-22-04-x64",
+			"region":     "nyc3",
+			"size":       "s-1vcpu-1gb",
+			"ipv6":       "false",
+			"monitoring": "false",
+		},
+	}
+	c := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "bar",
+		"image":  "ubuntu-22-04-x64",
+		"region": "nyc3",
+		"size":   "s-1vcpu-1gb",
+	})
+	diff, err := r.Diff(context.Background(), s, c, meta)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Another resource, e.g. a volume attachment, runs an action against the
+	// Droplet.
+	unlock, err := meta.LockDropletActions(context.Background(), 123)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, diags := r.Apply(context.Background(), s, diff, meta); diags.HasError() {
+			t.Errorf("Unexpected error: %v", diags)
+		}
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if n := atomic.LoadInt32(&actions); n != 0 {
+		t.Fatalf("Expected the rename to wait for the lock, got %d actions", n)
+	}
+
+	unlock()
+	<-done
+
+	if n := atomic.LoadInt32(&actions); n != 1 {
+		t.Fatalf("Expected the Droplet to be renamed once the lock is released, got %d actions", n)
+	}
+}
+
+func TestDropletVPCChangeDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/vpcs/default-vpc":
+			w.Write([]byte(`{"vpc": {"id": "default-vpc", "region": "nyc3", "default": true}}`))
+		case "/v2/vpcs/other-vpc":
+			w.Write([]byte(`{"vpc": {"id": "other-vpc", "region": "nyc3", "default": false}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		old, new    string
+		requiresNew bool
+	}{
+		// Moving the Droplet to another VPC replaces it.
+		{"default-vpc", "other-vpc", true},
+		// A Droplet which is not in a VPC can join the default one in place.
+		{"", "default-vpc", false},
+		{"", "other-vpc", true},
+	}
+
+	for _, tc := range cases {
+		s := &terraform.InstanceState{
+			ID: "123",
+			Attributes: map[string]string{
+				"name":       "foo",
+				"image":      "ubuntu-22-04-x64",
+				"region":     "nyc3",
+				"size":       "s-1vcpu-1gb",
+				"ipv6":       "false",
+				"monitoring": "false",
+				"vpc_uuid":   tc.old,
+			},
+		}
+		c := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":     "foo",
+			"image":    "ubuntu-22-04-x64",
+			"region":   "nyc3",
+			"size":     "s-1vcpu-1gb",
+			"vpc_uuid": tc.new,
+		})
+
+		diff, err := droplet.ResourceDigitalOceanDroplet().Diff(context.Background(), s, c, meta)
+		if err != nil {
+			t.Fatalf("%q => %q: unexpected error: %s", tc.old, tc.new, err)
+		}
+		if diff == nil || diff.RequiresNew() != tc.requiresNew {
+			t.Fatalf("%q => %q: expected requires new to be %t, got %#v", tc.old, tc.new, tc.requiresNew, diff)
+		}
+	}
+}
+
+func testAccCheckDigitalOceanDropletProvisionedVolumesDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_droplet" {
+			continue
+		}
+
+		id := rs.Primary.Attributes["provisioned_volumes.0.id"]
+		if id == "" {
+			continue
+		}
+
+		_, resp, err := client.Storage.GetVolume(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("Provisioned volume %s still exists", id)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanDroplet_WithID(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
@@ -289,6 +543,51 @@ func TestAccDigitalOceanDroplet_UpdateUserData(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_RebuildOnImageChange(t *testing.T) {
+	var afterCreate, afterRebuild, afterReplace godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_rebuildOnImageChange(name, "ubuntu-22-04-x64", true),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "rebuild_on_image_change", "true"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_rebuildOnImageChange(name, "ubuntu-24-04-x64", true),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterRebuild),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "image", "ubuntu-24-04-x64"),
+					func(s *terraform.State) error {
+						if afterCreate.ID != afterRebuild.ID {
+							return fmt.Errorf("Expected droplet %d to be rebuilt, but it was replaced by %d", afterCreate.ID, afterRebuild.ID)
+						}
+						if afterRebuild.Image.Slug != "ubuntu-24-04-x64" {
+							return fmt.Errorf("Expected droplet to be rebuilt from ubuntu-24-04-x64, got %s", afterRebuild.Image.Slug)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_rebuildOnImageChange(name, "ubuntu-22-04-x64", false),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterReplace),
+					testAccCheckDigitalOceanDropletRecreated(t, &afterRebuild, &afterReplace),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_UpdateTags(t *testing.T) {
 	var afterCreate, afterUpdate godo.Droplet
 	name := acceptance.RandomTestName()
@@ -431,6 +730,52 @@ func TestAccDigitalOceanDroplet_conditionalVolumes(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_DestroyAssociatedResources(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acceptance.TestAccCheckDigitalOceanDropletDestroy,
+			testAccCheckDigitalOceanVolumeDestroyedWithDroplet,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_DestroyAssociatedResources(name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "destroy_associated_resources", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "volume_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanVolumeDestroyedWithDroplet(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_volume" {
+			continue
+		}
+
+		_, resp, err := client.Storage.GetVolume(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Volume %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
@@ -458,6 +803,10 @@ func TestAccDigitalOceanDroplet_EnableAndDisableBackups(t *testing.T) {
 					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet.foobar", "backups", "true"),
+					resource.TestCheckTypeSetElemAttr(
+						"digitalocean_droplet.foobar", "features.*", "backups"),
+					resource.TestCheckTypeSetElemAttr(
+						"digitalocean_droplet.foobar", "region_features.*", "backups"),
 				),
 			},
 
@@ -832,6 +1181,17 @@ func testAccCheckDigitalOceanDropletRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckDigitalOceanDropletConfig_rebuildOnImageChange(name string, image string, rebuild bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name                    = "%s"
+  size                    = "%s"
+  image                   = "%s"
+  region                  = "nyc3"
+  rebuild_on_image_change = %t
+}`, name, defaultSize, image, rebuild)
+}
+
 func testAccCheckDigitalOceanDropletConfig_withID(name string, slug string) string {
 	return fmt.Sprintf(`
 data "digitalocean_image" "foobar" {
@@ -1000,6 +1360,25 @@ resource "digitalocean_droplet" "foobar" {
 `, name, name, name, defaultImage, defaultSize)
 }
 
+func testAccCheckDigitalOceanDropletConfig_DestroyAssociatedResources(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_volume" "foobar" {
+  region = "sfo3"
+  name   = "%s"
+  size   = 1
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name                         = "%s"
+  region                       = "sfo3"
+  image                        = "%s"
+  size                         = "%s"
+  volume_ids                   = [digitalocean_volume.foobar.id]
+  destroy_associated_resources = true
+}
+`, name, name, defaultImage, defaultSize)
+}
+
 func testAccCheckDigitalOceanDropletConfig_EnableBackups(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -1064,3 +1443,20 @@ resource "digitalocean_droplet" "foobar" {
   graceful_shutdown = false
 }`, name, defaultSize, defaultImage)
 }
+
+func testAccCheckDigitalOceanDropletConfig_ProvisionedVolumes(name, volumeName string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name      = "%s"
+  size      = "%s"
+  image     = "%s"
+  region    = "nyc3"
+  user_data = "foobar"
+
+  provisioned_volumes {
+    name            = "%s"
+    size            = 10
+    filesystem_type = "xfs"
+  }
+}`, name, defaultSize, defaultImage, volumeName)
+}
@@ -35,7 +35,7 @@ func sweepDroplets(region string) error {
 
 	var swept int
 	for _, d := range droplets {
-		if strings.HasPrefix(d.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(d.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying Droplet %s", d.Name)
 
 			if _, err := client.Droplets.Delete(context.Background(), d.ID); err != nil {
@@ -9,6 +9,7 @@ import (
 
 func DataSourceDigitalOceanFirewall() *schema.Resource {
 	fwSchema := firewallSchema()
+	delete(fwSchema, "rule_sets")
 
 	for _, f := range fwSchema {
 		f.Computed = true
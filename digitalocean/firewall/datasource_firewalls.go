@@ -0,0 +1,275 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanFirewalls() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"id": {
+				Type: schema.TypeString,
+			},
+			"name": {
+				Type: schema.TypeString,
+			},
+			"status": {
+				Type: schema.TypeString,
+			},
+			"created_at": {
+				Type: schema.TypeString,
+			},
+			"droplet_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{Type: schema.TypeInt},
+			},
+			"tags": tag.TagsDataSourceSchema(),
+			"rules": {
+				Type:        schema.TypeList,
+				Description: "the inbound and outbound rules of the firewall, sorted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "inbound or outbound",
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"droplet_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"load_balancer_uids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"kubernetes_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rules_json": {
+				Type:        schema.TypeString,
+				Description: "the rules of the firewall encoded as JSON; only set when include_rules_json is true",
+			},
+		},
+		ResultAttributeName: "firewalls",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"include_rules_json": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether to render the rules of each firewall as JSON in rules_json",
+			},
+		},
+		GetRecords:    getDigitalOceanFirewalls,
+		FlattenRecord: flattenDigitalOceanFirewall,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+// firewallRule is a firewall rule in a form independent of its direction,
+// in which the port range and the lists are normalized, so that the rules of
+// firewalls can be compared.
+type firewallRule struct {
+	Direction        string   `json:"direction"`
+	Protocol         string   `json:"protocol"`
+	PortRange        string   `json:"port_range"`
+	Addresses        []string `json:"addresses"`
+	DropletIDs       []int    `json:"droplet_ids"`
+	LoadBalancerUIDs []string `json:"load_balancer_uids"`
+	KubernetesIDs    []string `json:"kubernetes_ids"`
+	Tags             []string `json:"tags"`
+}
+
+func getDigitalOceanFirewalls(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var firewalls []interface{}
+
+	for {
+		fws, resp, err := client.Firewalls.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		for _, fw := range fws {
+			firewalls = append(firewalls, fw)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return firewalls, nil
+}
+
+func flattenDigitalOceanFirewall(rawFirewall, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	fw := rawFirewall.(godo.Firewall)
+
+	rules := normalizeFirewallRules(fw.InboundRules, fw.OutboundRules)
+	flattenedRules := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flattenedRules = append(flattenedRules, map[string]interface{}{
+			"direction":          rule.Direction,
+			"protocol":           rule.Protocol,
+			"port_range":         rule.PortRange,
+			"addresses":          rule.Addresses,
+			"droplet_ids":        rule.DropletIDs,
+			"load_balancer_uids": rule.LoadBalancerUIDs,
+			"kubernetes_ids":     rule.KubernetesIDs,
+			"tags":               rule.Tags,
+		})
+	}
+
+	flattenedFirewall := map[string]interface{}{
+		"id":          fw.ID,
+		"name":        fw.Name,
+		"status":      fw.Status,
+		"created_at":  fw.Created,
+		"droplet_ids": flattenFirewallDropletIds(fw.DropletIDs),
+		"tags":        tag.FlattenTags(fw.Tags),
+		"rules":       flattenedRules,
+		"rules_json":  "",
+	}
+
+	if includeJSON, ok := extra["include_rules_json"].(bool); ok && includeJSON {
+		rulesJSON, err := FirewallRulesJSON(fw.InboundRules, fw.OutboundRules)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding rules of firewall (%s): %s", fw.ID, err)
+		}
+		flattenedFirewall["rules_json"] = rulesJSON
+	}
+
+	return flattenedFirewall, nil
+}
+
+// FirewallRulesJSON returns the normalized rules of a firewall encoded as
+// JSON. Equivalent rules always produce the same JSON, regardless of the
+// order in which the API returns them.
+func FirewallRulesJSON(inbound []godo.InboundRule, outbound []godo.OutboundRule) (string, error) {
+	b, err := json.Marshal(normalizeFirewallRules(inbound, outbound))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func normalizeFirewallRules(inbound []godo.InboundRule, outbound []godo.OutboundRule) []firewallRule {
+	rules := make([]firewallRule, 0, len(inbound)+len(outbound))
+
+	for _, r := range inbound {
+		rules = append(rules, newFirewallRule("inbound", r.Protocol, r.PortRange, r.Sources))
+	}
+	for _, r := range outbound {
+		rules = append(rules, newFirewallRule("outbound", r.Protocol, r.PortRange, (*godo.Sources)(r.Destinations)))
+	}
+
+	keys := make([]string, len(rules))
+	for i, rule := range rules {
+		b, _ := json.Marshal(rule)
+		keys[i] = string(b)
+	}
+	sort.Sort(firewallRulesByKey{rules: rules, keys: keys})
+
+	return rules
+}
+
+// Sources and destinations share the same structure.
+func newFirewallRule(direction, protocol, portRange string, endpoints *godo.Sources) firewallRule {
+	// The API returns 0 when the port range was specified as all, or when
+	// it was not specified for icmp.
+	if portRange == "0" {
+		if protocol == "icmp" {
+			portRange = ""
+		} else {
+			portRange = "all"
+		}
+	}
+
+	rule := firewallRule{
+		Direction:        direction,
+		Protocol:         protocol,
+		PortRange:        portRange,
+		Addresses:        []string{},
+		DropletIDs:       []int{},
+		LoadBalancerUIDs: []string{},
+		KubernetesIDs:    []string{},
+		Tags:             []string{},
+	}
+
+	if endpoints != nil {
+		rule.Addresses = sortedStrings(endpoints.Addresses)
+		rule.LoadBalancerUIDs = sortedStrings(endpoints.LoadBalancerUIDs)
+		rule.KubernetesIDs = sortedStrings(endpoints.KubernetesIDs)
+		rule.Tags = sortedStrings(endpoints.Tags)
+
+		rule.DropletIDs = append(rule.DropletIDs, endpoints.DropletIDs...)
+		sort.Ints(rule.DropletIDs)
+	}
+
+	return rule
+}
+
+func sortedStrings(s []string) []string {
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+type firewallRulesByKey struct {
+	rules []firewallRule
+	keys  []string
+}
+
+func (s firewallRulesByKey) Len() int           { return len(s.rules) }
+func (s firewallRulesByKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s firewallRulesByKey) Swap(i, j int) {
+	s.rules[i], s.rules[j] = s.rules[j], s.rules[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
@@ -0,0 +1,105 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFirewallRulesJSON(t *testing.T) {
+	inbound := []godo.InboundRule{
+		{
+			Protocol:  "tcp",
+			PortRange: "22",
+			Sources:   &godo.Sources{Addresses: []string{"::/0", "0.0.0.0/0"}},
+		},
+		{
+			Protocol:  "icmp",
+			PortRange: "0",
+			Sources:   &godo.Sources{Tags: []string{"web"}, DropletIDs: []int{2, 1}},
+		},
+	}
+	outbound := []godo.OutboundRule{
+		{
+			Protocol:     "udp",
+			PortRange:    "0",
+			Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}},
+		},
+	}
+
+	expected := `[` +
+		`{"direction":"inbound","protocol":"icmp","port_range":"","addresses":[],"droplet_ids":[1,2],"load_balancer_uids":[],"kubernetes_ids":[],"tags":["web"]},` +
+		`{"direction":"inbound","protocol":"tcp","port_range":"22","addresses":["0.0.0.0/0","::/0"],"droplet_ids":[],"load_balancer_uids":[],"kubernetes_ids":[],"tags":[]},` +
+		`{"direction":"outbound","protocol":"udp","port_range":"all","addresses":["0.0.0.0/0"],"droplet_ids":[],"load_balancer_uids":[],"kubernetes_ids":[],"tags":[]}` +
+		`]`
+
+	actual, err := firewall.FirewallRulesJSON(inbound, outbound)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+
+	// The order in which the API returns the rules does not matter.
+	reversed, err := firewall.FirewallRulesJSON([]godo.InboundRule{inbound[1], inbound[0]}, outbound)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reversed != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, reversed)
+	}
+
+	empty, err := firewall.FirewallRulesJSON(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty != "[]" {
+		t.Fatalf("expected [], got %s", empty)
+	}
+}
+
+func TestAccDataSourceDigitalOceanFirewalls_Basic(t *testing.T) {
+	fwName := acceptance.RandomTestName()
+	fwConfig := testAccDigitalOceanFirewallConfig_OnlyInbound(fwName)
+	fwsDataConfig := `
+data "digitalocean_firewalls" "foobar" {
+  include_rules_json = true
+
+  filter {
+    key    = "name"
+    values = [digitalocean_firewall.foobar.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fwConfig,
+			},
+			{
+				Config: fwConfig + fwsDataConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_firewalls.foobar", "firewalls.0.id",
+						"digitalocean_firewall.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.name", fwName),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.0.direction", "inbound"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.0.port_range", "22"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.0.addresses.0", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules.0.addresses.1", "::/0"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.foobar", "firewalls.0.rules_json",
+						`[{"direction":"inbound","protocol":"tcp","port_range":"22","addresses":["0.0.0.0/0","::/0"],"droplet_ids":[],"load_balancer_uids":[],"kubernetes_ids":[],"tags":[]}]`),
+				),
+			},
+		},
+	})
+}
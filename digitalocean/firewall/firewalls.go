@@ -1,6 +1,10 @@
 package firewall
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -64,6 +68,16 @@ func firewallSchema() map[string]*schema.Schema {
 			},
 		},
 
+		"rule_sets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			Description: "The rules attributes of digitalocean_firewall_rule_set resources whose rules are added to the firewall",
+		},
+
 		"tags": tag.TagsSchema(),
 	}
 }
@@ -335,3 +349,172 @@ func flattenFirewallOutboundRules(rules []godo.OutboundRule) []interface{} {
 
 	return flattenedRules
 }
+
+// firewallReferencedTags returns the tags the firewall is applied to and the
+// tags used as the sources and destinations of its rules.
+func firewallReferencedTags(opts *godo.FirewallRequest) [][]string {
+	tags := [][]string{opts.Tags}
+
+	for _, rule := range opts.InboundRules {
+		if rule.Sources != nil {
+			tags = append(tags, rule.Sources.Tags)
+		}
+	}
+
+	for _, rule := range opts.OutboundRules {
+		if rule.Destinations != nil {
+			tags = append(tags, rule.Destinations.Tags)
+		}
+	}
+
+	return tags
+}
+
+// validateFirewallRulePorts checks that the rules of a firewall or rule set
+// which are not ICMP rules have a port range.
+func validateFirewallRulePorts(inboundRules, outboundRules []interface{}) error {
+	for _, v := range inboundRules {
+		inbound := v.(map[string]interface{})
+		protocol := inbound["protocol"]
+
+		port := inbound["port_range"]
+		if protocol != "icmp" && port == "" {
+			return fmt.Errorf("`port_range` of inbound rules is required if protocol is `tcp` or `udp`")
+		}
+	}
+
+	for _, v := range outboundRules {
+		outbound := v.(map[string]interface{})
+		protocol := outbound["protocol"]
+
+		port := outbound["port_range"]
+		if protocol != "icmp" && port == "" {
+			return fmt.Errorf("`port_range` of outbound rules is required if protocol is `tcp` or `udp`")
+		}
+	}
+
+	return nil
+}
+
+// firewallRuleKey identifies a rule independently of the ordering of its
+// targets and of the way the API returns port ranges, so the rules of the
+// requests and of the API responses can be compared.
+func firewallRuleKey(protocol, portRange string, targets *godo.Sources) string {
+	if portRange == "0" || portRange == "all" {
+		portRange = "all"
+		if protocol == "icmp" {
+			portRange = ""
+		}
+	}
+
+	sorted := func(values []string) string {
+		s := append([]string{}, values...)
+		sort.Strings(s)
+		return strings.Join(s, ",")
+	}
+
+	var t godo.Sources
+	if targets != nil {
+		t = *targets
+	}
+
+	dropletIDs := append([]int{}, t.DropletIDs...)
+	sort.Ints(dropletIDs)
+
+	return fmt.Sprintf("%s/%s/%s/%v/%s/%s/%s", protocol, portRange, sorted(t.Addresses), dropletIDs,
+		sorted(t.LoadBalancerUIDs), sorted(t.KubernetesIDs), sorted(t.Tags))
+}
+
+func firewallInboundRuleKey(rule godo.InboundRule) string {
+	return firewallRuleKey(rule.Protocol, rule.PortRange, rule.Sources)
+}
+
+func firewallOutboundRuleKey(rule godo.OutboundRule) string {
+	return firewallRuleKey(rule.Protocol, rule.PortRange, (*godo.Sources)(rule.Destinations))
+}
+
+// mergeFirewallInboundRules appends the rules which are not already part of
+// the given rules.
+func mergeFirewallInboundRules(rules, extra []godo.InboundRule) []godo.InboundRule {
+	keys := map[string]bool{}
+	for _, rule := range rules {
+		keys[firewallInboundRuleKey(rule)] = true
+	}
+
+	for _, rule := range extra {
+		if key := firewallInboundRuleKey(rule); !keys[key] {
+			keys[key] = true
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// mergeFirewallOutboundRules appends the rules which are not already part of
+// the given rules.
+func mergeFirewallOutboundRules(rules, extra []godo.OutboundRule) []godo.OutboundRule {
+	keys := map[string]bool{}
+	for _, rule := range rules {
+		keys[firewallOutboundRuleKey(rule)] = true
+	}
+
+	for _, rule := range extra {
+		if key := firewallOutboundRuleKey(rule); !keys[key] {
+			keys[key] = true
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// removeFirewallInboundRules removes the rules which come from rule sets,
+// unless they are also rules of the firewall itself.
+func removeFirewallInboundRules(rules, ruleSetRules, ownRules []godo.InboundRule) []godo.InboundRule {
+	remove := map[string]bool{}
+	for _, rule := range ruleSetRules {
+		remove[firewallInboundRuleKey(rule)] = true
+	}
+	for _, rule := range ownRules {
+		delete(remove, firewallInboundRuleKey(rule))
+	}
+
+	if len(remove) == 0 {
+		return rules
+	}
+
+	remaining := []godo.InboundRule{}
+	for _, rule := range rules {
+		if !remove[firewallInboundRuleKey(rule)] {
+			remaining = append(remaining, rule)
+		}
+	}
+
+	return remaining
+}
+
+// removeFirewallOutboundRules removes the rules which come from rule sets,
+// unless they are also rules of the firewall itself.
+func removeFirewallOutboundRules(rules, ruleSetRules, ownRules []godo.OutboundRule) []godo.OutboundRule {
+	remove := map[string]bool{}
+	for _, rule := range ruleSetRules {
+		remove[firewallOutboundRuleKey(rule)] = true
+	}
+	for _, rule := range ownRules {
+		delete(remove, firewallOutboundRuleKey(rule))
+	}
+
+	if len(remove) == 0 {
+		return rules
+	}
+
+	remaining := []godo.OutboundRule{}
+	for _, rule := range rules {
+		if !remove[firewallOutboundRuleKey(rule)] {
+			remaining = append(remaining, rule)
+		}
+	}
+
+	return remaining
+}
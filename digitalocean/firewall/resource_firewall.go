@@ -29,32 +29,13 @@ func ResourceDigitalOceanFirewall() *schema.Resource {
 
 			inboundRules, hasInbound := diff.GetOk("inbound_rule")
 			outboundRules, hasOutbound := diff.GetOk("outbound_rule")
+			_, hasRuleSets := diff.GetOk("rule_sets")
 
-			if !hasInbound && !hasOutbound {
+			if !hasInbound && !hasOutbound && !hasRuleSets {
 				return fmt.Errorf("At least one rule must be specified")
 			}
 
-			for _, v := range inboundRules.(*schema.Set).List() {
-				inbound := v.(map[string]interface{})
-				protocol := inbound["protocol"]
-
-				port := inbound["port_range"]
-				if protocol != "icmp" && port == "" {
-					return fmt.Errorf("`port_range` of inbound rules is required if protocol is `tcp` or `udp`")
-				}
-			}
-
-			for _, v := range outboundRules.(*schema.Set).List() {
-				inbound := v.(map[string]interface{})
-				protocol := inbound["protocol"]
-
-				port := inbound["port_range"]
-				if protocol != "icmp" && port == "" {
-					return fmt.Errorf("`port_range` of outbound rules is required if protocol is `tcp` or `udp`")
-				}
-			}
-
-			return nil
+			return validateFirewallRulePorts(inboundRules.(*schema.Set).List(), outboundRules.(*schema.Set).List())
 		},
 	}
 }
@@ -62,14 +43,18 @@ func ResourceDigitalOceanFirewall() *schema.Resource {
 func resourceDigitalOceanFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	opts, err := firewallRequest(d, client)
+	opts, err := firewallRequest(d, meta)
 	if err != nil {
 		return diag.Errorf("Error in firewall request: %s", err)
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, firewallReferencedTags(opts)...); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Firewall create configuration: %#v", opts)
 
-	firewall, _, err := client.Firewalls.Create(context.Background(), opts)
+	firewall, _, err := client.Firewalls.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating firewall: %s", err)
 	}
@@ -86,7 +71,7 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	// Retrieve the firewall properties for updating the state
-	firewall, resp, err := client.Firewalls.Get(context.Background(), d.Id())
+	firewall, resp, err := client.Firewalls.Get(ctx, d.Id())
 	if err != nil {
 		// check if the firewall no longer exists.
 		if resp != nil && resp.StatusCode == 404 {
@@ -101,17 +86,40 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("status", firewall.Status)
 	d.Set("created_at", firewall.Created)
 	d.Set("pending_changes", firewallPendingChanges(d, firewall))
-	d.Set("name", firewall.Name)
+	d.Set("name", meta.(*config.CombinedConfig).UnprefixName(firewall.Name))
 
 	if err := d.Set("droplet_ids", flattenFirewallDropletIds(firewall.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting `droplet_ids`: %+v", err)
 	}
 
-	if err := d.Set("inbound_rule", flattenFirewallInboundRules(firewall.InboundRules)); err != nil {
+	// The rules coming from rule sets are only tracked by the rule_sets,
+	// which the data source does not have.
+	rawRuleSets, _ := d.Get("rule_sets").([]interface{})
+	ruleSets, err := expandFirewallRuleSets(rawRuleSets)
+	if err != nil {
+		return diag.Errorf("Error reading firewall rule sets: %s", err)
+	}
+
+	if len(rawRuleSets) > 0 {
+		appliedRuleSets, err := flattenFirewallRuleSets(rawRuleSets, firewall)
+		if err != nil {
+			return diag.Errorf("Error reading firewall rule sets: %s", err)
+		}
+		if err := d.Set("rule_sets", appliedRuleSets); err != nil {
+			return diag.Errorf("[DEBUG] Error setting `rule_sets`: %+v", err)
+		}
+	}
+
+	inboundRules := removeFirewallInboundRules(firewall.InboundRules, ruleSets.InboundRules,
+		expandFirewallInboundRules(d.Get("inbound_rule").(*schema.Set).List()))
+	outboundRules := removeFirewallOutboundRules(firewall.OutboundRules, ruleSets.OutboundRules,
+		expandFirewallOutboundRules(d.Get("outbound_rule").(*schema.Set).List()))
+
+	if err := d.Set("inbound_rule", flattenFirewallInboundRules(inboundRules)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Firewall inbound_rule error: %#v", err)
 	}
 
-	if err := d.Set("outbound_rule", flattenFirewallOutboundRules(firewall.OutboundRules)); err != nil {
+	if err := d.Set("outbound_rule", flattenFirewallOutboundRules(outboundRules)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Firewall outbound_rule error: %#v", err)
 	}
 
@@ -125,14 +133,18 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 func resourceDigitalOceanFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	opts, err := firewallRequest(d, client)
+	opts, err := firewallRequest(d, meta)
 	if err != nil {
 		return diag.Errorf("Error in firewall request: %s", err)
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, firewallReferencedTags(opts)...); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Firewall update configuration: %#v", opts)
 
-	_, _, err = client.Firewalls.Update(context.Background(), d.Id(), opts)
+	_, _, err = client.Firewalls.Update(ctx, d.Id(), opts)
 	if err != nil {
 		return diag.Errorf("Error updating firewall: %s", err)
 	}
@@ -146,7 +158,7 @@ func resourceDigitalOceanFirewallDelete(ctx context.Context, d *schema.ResourceD
 	log.Printf("[INFO] Deleting firewall: %s", d.Id())
 
 	// Destroy the droplet
-	_, err := client.Firewalls.Delete(context.Background(), d.Id())
+	_, err := client.Firewalls.Delete(ctx, d.Id())
 
 	// Handle remotely destroyed droplets
 	if err != nil && strings.Contains(err.Error(), "404 Not Found") {
@@ -160,10 +172,10 @@ func resourceDigitalOceanFirewallDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func firewallRequest(d *schema.ResourceData, client *godo.Client) (*godo.FirewallRequest, error) {
+func firewallRequest(d *schema.ResourceData, meta interface{}) (*godo.FirewallRequest, error) {
 	// Build up our firewall request
 	opts := &godo.FirewallRequest{
-		Name: d.Get("name").(string),
+		Name: meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 	}
 
 	if v, ok := d.GetOk("droplet_ids"); ok {
@@ -180,6 +192,14 @@ func firewallRequest(d *schema.ResourceData, client *godo.Client) (*godo.Firewal
 		opts.OutboundRules = expandFirewallOutboundRules(v.(*schema.Set).List())
 	}
 
+	// Add the rules of the rule sets which are not rules of the firewall itself
+	ruleSets, err := expandFirewallRuleSets(d.Get("rule_sets").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	opts.InboundRules = mergeFirewallInboundRules(opts.InboundRules, ruleSets.InboundRules)
+	opts.OutboundRules = mergeFirewallOutboundRules(opts.OutboundRules, ruleSets.OutboundRules)
+
 	// Get tags
 	opts.Tags = tag.ExpandTags(d.Get("tags").(*schema.Set).List())
 
@@ -0,0 +1,206 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// firewallRuleSet is the encoding of the rules attribute of a
+// digitalocean_firewall_rule_set, which is composed in firewalls by their
+// rule_sets argument.
+type firewallRuleSet struct {
+	InboundRules  []godo.InboundRule  `json:"inbound_rules,omitempty"`
+	OutboundRules []godo.OutboundRule `json:"outbound_rules,omitempty"`
+}
+
+// ResourceDigitalOceanFirewallRuleSet is a named set of firewall rules which
+// can be shared by many firewalls. The API has no such object, so the rule
+// set only lives in the Terraform state.
+func ResourceDigitalOceanFirewallRuleSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFirewallRuleSetCreate,
+		ReadContext:   resourceDigitalOceanFirewallRuleSetRead,
+		UpdateContext: resourceDigitalOceanFirewallRuleSetRead,
+		DeleteContext: resourceDigitalOceanFirewallRuleSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the rule set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description of the rule set",
+			},
+			"inbound_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     firewallRuleSchema("source"),
+			},
+			"outbound_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     firewallRuleSchema("destination"),
+			},
+			"rules": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The encoded rules of the rule set, to be added to the rule_sets of firewalls",
+			},
+		},
+
+		CustomizeDiff: resourceDigitalOceanFirewallRuleSetCustomizeDiff,
+	}
+}
+
+func resourceDigitalOceanFirewallRuleSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.PrefixedUniqueId("firewall-rule-set-"))
+
+	return resourceDigitalOceanFirewallRuleSetRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFirewallRuleSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rules, err := encodeFirewallRuleSet(d.Get("inbound_rule").(*schema.Set).List(), d.Get("outbound_rule").(*schema.Set).List())
+	if err != nil {
+		return diag.Errorf("Error encoding firewall rule set: %s", err)
+	}
+
+	d.Set("rules", rules)
+
+	return nil
+}
+
+func resourceDigitalOceanFirewallRuleSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Firewalls keep the rules of the rule set until their rule_sets are updated.
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanFirewallRuleSetCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	inboundRules := diff.Get("inbound_rule").(*schema.Set).List()
+	outboundRules := diff.Get("outbound_rule").(*schema.Set).List()
+
+	if len(inboundRules) == 0 && len(outboundRules) == 0 {
+		return fmt.Errorf("At least one rule must be specified")
+	}
+
+	if err := validateFirewallRulePorts(inboundRules, outboundRules); err != nil {
+		return err
+	}
+
+	if !diff.NewValueKnown("inbound_rule") || !diff.NewValueKnown("outbound_rule") {
+		return diff.SetNewComputed("rules")
+	}
+
+	rules, err := encodeFirewallRuleSet(inboundRules, outboundRules)
+	if err != nil {
+		return err
+	}
+
+	if rules != diff.Get("rules").(string) {
+		return diff.SetNew("rules", rules)
+	}
+
+	return nil
+}
+
+func encodeFirewallRuleSet(inboundRules, outboundRules []interface{}) (string, error) {
+	ruleSet := firewallRuleSet{
+		InboundRules:  expandFirewallInboundRules(inboundRules),
+		OutboundRules: expandFirewallOutboundRules(outboundRules),
+	}
+
+	rules, err := json.Marshal(ruleSet)
+	if err != nil {
+		return "", err
+	}
+
+	return string(rules), nil
+}
+
+// expandFirewallRuleSets decodes and merges the rules of the rule sets of a
+// firewall.
+func expandFirewallRuleSets(rawRuleSets []interface{}) (*firewallRuleSet, error) {
+	merged := &firewallRuleSet{}
+	for _, raw := range rawRuleSets {
+		rules, ok := raw.(string)
+		if !ok || rules == "" {
+			continue
+		}
+
+		var ruleSet firewallRuleSet
+		if err := json.Unmarshal([]byte(rules), &ruleSet); err != nil {
+			return nil, fmt.Errorf("invalid rule set: %s", err)
+		}
+
+		merged.InboundRules = mergeFirewallInboundRules(merged.InboundRules, ruleSet.InboundRules)
+		merged.OutboundRules = mergeFirewallOutboundRules(merged.OutboundRules, ruleSet.OutboundRules)
+	}
+
+	return merged, nil
+}
+
+// flattenFirewallRuleSets returns the rule sets as applied to the firewall.
+// The rules removed from the firewall outside of Terraform are dropped from
+// the encoded rules of their rule sets, so that the difference with the
+// configuration plans adding them back.
+func flattenFirewallRuleSets(rawRuleSets []interface{}, firewall *godo.Firewall) ([]interface{}, error) {
+	inboundKeys := map[string]bool{}
+	for _, rule := range firewall.InboundRules {
+		inboundKeys[firewallInboundRuleKey(rule)] = true
+	}
+	outboundKeys := map[string]bool{}
+	for _, rule := range firewall.OutboundRules {
+		outboundKeys[firewallOutboundRuleKey(rule)] = true
+	}
+
+	applied := make([]interface{}, 0, len(rawRuleSets))
+	for _, raw := range rawRuleSets {
+		rules, ok := raw.(string)
+		if !ok || rules == "" {
+			applied = append(applied, raw)
+			continue
+		}
+
+		var ruleSet firewallRuleSet
+		if err := json.Unmarshal([]byte(rules), &ruleSet); err != nil {
+			return nil, fmt.Errorf("invalid rule set: %s", err)
+		}
+
+		var appliedRuleSet firewallRuleSet
+		for _, rule := range ruleSet.InboundRules {
+			if inboundKeys[firewallInboundRuleKey(rule)] {
+				appliedRuleSet.InboundRules = append(appliedRuleSet.InboundRules, rule)
+			}
+		}
+		for _, rule := range ruleSet.OutboundRules {
+			if outboundKeys[firewallOutboundRuleKey(rule)] {
+				appliedRuleSet.OutboundRules = append(appliedRuleSet.OutboundRules, rule)
+			}
+		}
+
+		if len(appliedRuleSet.InboundRules) == len(ruleSet.InboundRules) &&
+			len(appliedRuleSet.OutboundRules) == len(ruleSet.OutboundRules) {
+			applied = append(applied, rules)
+			continue
+		}
+
+		appliedRules, err := json.Marshal(appliedRuleSet)
+		if err != nil {
+			return nil, err
+		}
+		applied = append(applied, string(appliedRules))
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,286 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDigitalOceanFirewallRuleSetsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/firewalls/fw-id" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(`{"firewall": {
+  "id": "fw-id",
+  "name": "web",
+  "status": "succeeded",
+  "inbound_rules": [
+    {"protocol": "tcp", "ports": "443", "sources": {"addresses": ["0.0.0.0/0", "::/0"]}},
+    {"protocol": "tcp", "ports": "22", "sources": {"addresses": ["10.0.0.5/32"]}},
+    {"protocol": "icmp", "ports": "0", "sources": {"addresses": ["10.0.0.0/8"]}}
+  ],
+  "outbound_rules": [
+    {"protocol": "tcp", "ports": "0", "destinations": {"addresses": ["0.0.0.0/0"]}}
+  ]
+}}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleSetResource := firewall.ResourceDigitalOceanFirewallRuleSet()
+	ruleSet := schema.TestResourceDataRaw(t, ruleSetResource.Schema, map[string]interface{}{
+		"name": "baseline",
+		"inbound_rule": []interface{}{
+			map[string]interface{}{
+				"protocol":         "tcp",
+				"port_range":       "22",
+				"source_addresses": []interface{}{"10.0.0.5/32"},
+			},
+			map[string]interface{}{
+				"protocol":         "icmp",
+				"source_addresses": []interface{}{"10.0.0.0/8"},
+			},
+		},
+		"outbound_rule": []interface{}{
+			map[string]interface{}{
+				"protocol":              "tcp",
+				"port_range":            "all",
+				"destination_addresses": []interface{}{"0.0.0.0/0"},
+			},
+		},
+	})
+	if diags := ruleSetResource.CreateContext(context.Background(), ruleSet, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	r := firewall.ResourceDigitalOceanFirewall()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "web",
+		"inbound_rule": []interface{}{
+			map[string]interface{}{
+				"protocol":         "tcp",
+				"port_range":       "443",
+				"source_addresses": []interface{}{"::/0", "0.0.0.0/0"},
+			},
+		},
+		"rule_sets": []interface{}{ruleSet.Get("rules").(string)},
+	})
+	d.SetId("fw-id")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	inbound := d.Get("inbound_rule").(*schema.Set).List()
+	if len(inbound) != 1 {
+		t.Fatalf("Expected only the own inbound rule of the firewall, got %v", inbound)
+	}
+	if port := inbound[0].(map[string]interface{})["port_range"]; port != "443" {
+		t.Errorf("Expected the inbound rule for port 443, got %v", port)
+	}
+
+	if outbound := d.Get("outbound_rule").(*schema.Set).List(); len(outbound) != 0 {
+		t.Errorf("Expected no outbound rules outside of the rule set, got %v", outbound)
+	}
+
+	if ruleSets := d.Get("rule_sets").([]interface{}); len(ruleSets) != 1 || ruleSets[0] != ruleSet.Get("rules") {
+		t.Errorf("Expected the rule set to be unchanged, got %v", ruleSets)
+	}
+}
+
+func TestDigitalOceanFirewallRuleSetsReadDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The icmp rule of the rule set was removed outside of Terraform.
+		w.Write([]byte(`{"firewall": {
+  "id": "fw-id",
+  "name": "web",
+  "status": "succeeded",
+  "inbound_rules": [
+    {"protocol": "tcp", "ports": "22", "sources": {"addresses": ["10.0.0.5/32"]}}
+  ]
+}}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleSetResource := firewall.ResourceDigitalOceanFirewallRuleSet()
+	ruleSet := schema.TestResourceDataRaw(t, ruleSetResource.Schema, map[string]interface{}{
+		"name": "baseline",
+		"inbound_rule": []interface{}{
+			map[string]interface{}{
+				"protocol":         "tcp",
+				"port_range":       "22",
+				"source_addresses": []interface{}{"10.0.0.5/32"},
+			},
+			map[string]interface{}{
+				"protocol":         "icmp",
+				"source_addresses": []interface{}{"10.0.0.0/8"},
+			},
+		},
+	})
+	if diags := ruleSetResource.CreateContext(context.Background(), ruleSet, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	applied := schema.TestResourceDataRaw(t, ruleSetResource.Schema, map[string]interface{}{
+		"name": "baseline",
+		"inbound_rule": []interface{}{
+			map[string]interface{}{
+				"protocol":         "tcp",
+				"port_range":       "22",
+				"source_addresses": []interface{}{"10.0.0.5/32"},
+			},
+		},
+	})
+	if diags := ruleSetResource.CreateContext(context.Background(), applied, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	r := firewall.ResourceDigitalOceanFirewall()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":      "web",
+		"rule_sets": []interface{}{ruleSet.Get("rules").(string)},
+	})
+	d.SetId("fw-id")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	ruleSets := d.Get("rule_sets").([]interface{})
+	if len(ruleSets) != 1 || ruleSets[0] != applied.Get("rules") {
+		t.Errorf("Expected the rule set to only have the rules of the firewall %s, got %v", applied.Get("rules"), ruleSets)
+	}
+}
+
+func TestAccDigitalOceanFirewallRuleSet_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var fw godo.Firewall
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanFirewallRuleSetConfig(rName, "22"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &fw),
+					resource.TestCheckResourceAttrSet("digitalocean_firewall_rule_set.ssh", "rules"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "rule_sets.#", "2"),
+					testAccCheckDigitalOceanFirewallRuleCount(&fw, 3, 1),
+				),
+			},
+			{
+				Config: testAccDigitalOceanFirewallRuleSetConfig(rName, "2222"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &fw),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "1"),
+					testAccCheckDigitalOceanFirewallRuleCount(&fw, 3, 1),
+					testAccCheckDigitalOceanFirewallInboundPort(&fw, "2222"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanFirewallRuleCount(fw *godo.Firewall, inbound, outbound int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(fw.InboundRules) != inbound {
+			return fmt.Errorf("Expected %d inbound rules, got %d", inbound, len(fw.InboundRules))
+		}
+		if len(fw.OutboundRules) != outbound {
+			return fmt.Errorf("Expected %d outbound rules, got %d", outbound, len(fw.OutboundRules))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanFirewallInboundPort(fw *godo.Firewall, port string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rule := range fw.InboundRules {
+			if rule.PortRange == port {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected an inbound rule for port %s, got %v", port, fw.InboundRules)
+	}
+}
+
+func testAccDigitalOceanFirewallRuleSetConfig(rName, sshPort string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_firewall_rule_set" "ssh" {
+  name = "ssh-from-bastion"
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "%s"
+    source_addresses = ["192.168.1.1/32"]
+  }
+}
+
+resource "digitalocean_firewall_rule_set" "node_exporter" {
+  name = "node-exporter"
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "9100"
+    source_addresses = ["10.0.0.0/8"]
+  }
+
+  outbound_rule {
+    protocol              = "tcp"
+    port_range            = "all"
+    destination_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+
+resource "digitalocean_firewall" "foobar" {
+  name = "%s"
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "443"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+
+  rule_sets = [
+    digitalocean_firewall_rule_set.ssh.rules,
+    digitalocean_firewall_rule_set.node_exporter.rules,
+  ]
+}
+`, sshPort, rName)
+}
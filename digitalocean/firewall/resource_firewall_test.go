@@ -156,6 +156,31 @@ func TestAccDigitalOceanFirewall_icmp(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanFirewall_ManagedServices(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var firewall godo.Firewall
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanFirewallConfig_ManagedServices(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.0.source_load_balancer_uids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.0.source_kubernetes_ids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "outbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "outbound_rule.0.destination_load_balancer_uids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "outbound_rule.0.destination_kubernetes_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanFirewall_ImportMultipleRules(t *testing.T) {
 	resourceName := "digitalocean_firewall.foobar"
 	rName := acceptance.RandomTestName()
@@ -279,6 +304,52 @@ resource "digitalocean_firewall" "foobar" {
 	`, tagName, rName, tagName, tagName)
 }
 
+func testAccDigitalOceanFirewallConfig_ManagedServices(rName string) string {
+	return fmt.Sprintf(`
+data "digitalocean_kubernetes_versions" "latest" {}
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc3"
+  version = data.digitalocean_kubernetes_versions.latest.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}
+
+resource "digitalocean_firewall" "foobar" {
+  name = "%s"
+  inbound_rule {
+    protocol                  = "tcp"
+    port_range                = "443"
+    source_load_balancer_uids = [digitalocean_loadbalancer.foobar.id]
+    source_kubernetes_ids     = [digitalocean_kubernetes_cluster.foobar.id]
+  }
+  outbound_rule {
+    protocol                       = "tcp"
+    port_range                     = "5432"
+    destination_load_balancer_uids = [digitalocean_loadbalancer.foobar.id]
+    destination_kubernetes_ids     = [digitalocean_kubernetes_cluster.foobar.id]
+  }
+}
+	`, rName, rName, rName)
+}
+
 func testAccDigitalOceanFirewallConfig_fullPortRange(rName string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_firewall" "foobar" {
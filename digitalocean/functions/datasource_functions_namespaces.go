@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanFunctionsNamespaces() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the namespace, used to deploy and invoke functions.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Description: "The UUID of the namespace.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label of the namespace.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The region the namespace is located in.",
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Description: "The API host used to deploy and invoke the functions of the namespace.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the namespace was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the namespace was last updated.",
+			},
+		},
+		ResultAttributeName: "namespaces",
+		FlattenRecord:       flattenDigitalOceanFunctionsNamespace,
+		GetRecords:          getDigitalOceanFunctionsNamespaces,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanFunctionsNamespaces(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaces, _, err := client.Functions.ListNamespaces(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving functions namespaces: %s", err)
+	}
+
+	records := make([]interface{}, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		records = append(records, namespace)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanFunctionsNamespace(rawNamespace, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	namespace := rawNamespace.(godo.FunctionsNamespace)
+
+	flattenedNamespace := map[string]interface{}{}
+	flattenedNamespace["id"] = namespace.Namespace
+	flattenedNamespace["uuid"] = namespace.UUID
+	flattenedNamespace["label"] = namespace.Label
+	flattenedNamespace["region"] = namespace.Region
+	flattenedNamespace["api_host"] = namespace.ApiHost
+	flattenedNamespace["created_at"] = namespace.CreatedAt.UTC().Format(time.RFC3339)
+	flattenedNamespace["updated_at"] = namespace.UpdatedAt.UTC().Format(time.RFC3339)
+
+	return flattenedNamespace, nil
+}
@@ -0,0 +1,88 @@
+package functions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanFunctionsNamespaces_Basic(t *testing.T) {
+	label := acceptance.RandomTestName()
+	var namespace godo.FunctionsNamespace
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// There is no resource managing namespaces, so the namespace
+				// is created with the API and deleted once the test finishes.
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+					created, _, err := client.Functions.CreateNamespace(context.Background(), &godo.FunctionsNamespaceCreateRequest{
+						Label:  label,
+						Region: "nyc1",
+					})
+					if err != nil {
+						t.Fatalf("Error creating functions namespace: %s", err)
+					}
+					namespace = *created
+
+					t.Cleanup(func() {
+						if _, err := client.Functions.DeleteNamespace(context.Background(), namespace.Namespace); err != nil {
+							t.Errorf("Error deleting functions namespace %s: %s", namespace.Namespace, err)
+						}
+					})
+				},
+				Config: fmt.Sprintf(`
+data "digitalocean_functions_namespaces" "foobar" {
+  filter {
+    key    = "label"
+    values = ["%s"]
+  }
+}
+`, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_functions_namespaces.foobar", "namespaces.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_functions_namespaces.foobar", "namespaces.0.label", label),
+					resource.TestCheckResourceAttr("data.digitalocean_functions_namespaces.foobar", "namespaces.0.region", "nyc1"),
+					resource.TestCheckResourceAttrPtr("data.digitalocean_functions_namespaces.foobar", "namespaces.0.id", &namespace.Namespace),
+					resource.TestCheckResourceAttrPtr("data.digitalocean_functions_namespaces.foobar", "namespaces.0.uuid", &namespace.UUID),
+					resource.TestCheckResourceAttrPtr("data.digitalocean_functions_namespaces.foobar", "namespaces.0.api_host", &namespace.ApiHost),
+					resource.TestCheckResourceAttrSet("data.digitalocean_functions_namespaces.foobar", "namespaces.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_functions_namespaces.foobar", "namespaces.0.updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanFunctionsNamespaces_FilterByLabel(t *testing.T) {
+	label := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "digitalocean_functions_namespaces" "foobar" {
+  filter {
+    key    = "label"
+    values = ["%s"]
+  }
+}
+`, label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_functions_namespaces.foobar", "namespaces.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanGenAIModels() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "the UUID of the model",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "the name of the model",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "the version of the model, e.g. 3.1.0",
+			},
+			"is_foundational": {
+				Type:        schema.TypeBool,
+				Description: "whether the model is a foundational model provided by DigitalOcean",
+			},
+			"upload_complete": {
+				Type:        schema.TypeBool,
+				Description: "whether the model is fully uploaded and can be used",
+			},
+			"parent_uuid": {
+				Type:        schema.TypeString,
+				Description: "the UUID of the model the model was derived from",
+			},
+			"agreement_name": {
+				Type:        schema.TypeString,
+				Description: "the name of the license agreement of the model",
+			},
+			"agreement_url": {
+				Type:        schema.TypeString,
+				Description: "the URL of the license agreement of the model",
+			},
+			"created_at": {
+				Type: schema.TypeString,
+			},
+			"updated_at": {
+				Type: schema.TypeString,
+			},
+		},
+		ResultAttributeName: "models",
+		GetRecords:          getDigitalOceanGenAIModels,
+		FlattenRecord:       flattenDigitalOceanGenAIModel,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanGenAIModels(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	models, err := listGenAIModels(context.Background(), client)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving GenAI models: %s", err)
+	}
+
+	var records []interface{}
+	for _, model := range models {
+		records = append(records, model)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanGenAIModel(rawModel, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	model := rawModel.(genAIModel)
+
+	flattenedModel := map[string]interface{}{
+		"id":              model.UUID,
+		"name":            model.Name,
+		"version":         "",
+		"is_foundational": model.IsFoundational,
+		"upload_complete": model.UploadComplete,
+		"parent_uuid":     model.ParentUUID,
+		"agreement_name":  "",
+		"agreement_url":   "",
+		"created_at":      model.CreatedAt,
+		"updated_at":      model.UpdatedAt,
+	}
+
+	if model.Version != nil {
+		flattenedModel["version"] = fmt.Sprintf("%d.%d.%d", model.Version.Major, model.Version.Minor, model.Version.Patch)
+	}
+
+	if model.Agreement != nil {
+		flattenedModel["agreement_name"] = model.Agreement.Name
+		flattenedModel["agreement_url"] = model.Agreement.URL
+	}
+
+	return flattenedModel, nil
+}
@@ -0,0 +1,36 @@
+package genai_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanGenAIModels_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_genai_models" "foundational" {
+  filter {
+    key    = "is_foundational"
+    values = ["true"]
+  }
+
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_genai_models.foundational", "models.0.id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_genai_models.foundational", "models.0.name"),
+					resource.TestCheckResourceAttr("data.digitalocean_genai_models.foundational", "models.0.is_foundational", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,346 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+// The GenAI platform is not supported by godo yet, so its API is used
+// directly through the godo client.
+const (
+	genAIAgentsPath         = "v2/gen-ai/agents"
+	genAIKnowledgeBasesPath = "v2/gen-ai/knowledge_bases"
+	genAIModelsPath         = "v2/gen-ai/models"
+)
+
+// genAIModel represents a model available to GenAI agents and knowledge bases.
+type genAIModel struct {
+	UUID           string               `json:"uuid"`
+	Name           string               `json:"name"`
+	Version        *genAIModelVersion   `json:"version,omitempty"`
+	IsFoundational bool                 `json:"is_foundational"`
+	UploadComplete bool                 `json:"upload_complete"`
+	ParentUUID     string               `json:"parent_uuid,omitempty"`
+	Agreement      *genAIModelAgreement `json:"agreement,omitempty"`
+	CreatedAt      string               `json:"created_at,omitempty"`
+	UpdatedAt      string               `json:"updated_at,omitempty"`
+}
+
+type genAIModelVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch"`
+}
+
+type genAIModelAgreement struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+}
+
+type genAIModelsRoot struct {
+	Models []genAIModel `json:"models"`
+	Links  *godo.Links  `json:"links"`
+}
+
+// genAIAgent represents a GenAI agent.
+type genAIAgent struct {
+	UUID           string                `json:"uuid"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description,omitempty"`
+	Instruction    string                `json:"instruction"`
+	Model          *genAIModel           `json:"model,omitempty"`
+	ProjectID      string                `json:"project_id"`
+	Region         string                `json:"region"`
+	Tags           []string              `json:"tags,omitempty"`
+	Temperature    float64               `json:"temperature"`
+	TopP           float64               `json:"top_p"`
+	MaxTokens      int                   `json:"max_tokens"`
+	K              int                   `json:"k"`
+	KnowledgeBases []genAIKnowledgeBase  `json:"knowledge_bases,omitempty"`
+	Deployment     *genAIAgentDeployment `json:"deployment,omitempty"`
+	CreatedAt      string                `json:"created_at,omitempty"`
+	UpdatedAt      string                `json:"updated_at,omitempty"`
+}
+
+type genAIAgentDeployment struct {
+	UUID       string `json:"uuid"`
+	URL        string `json:"url"`
+	Status     string `json:"status"`
+	Visibility string `json:"visibility"`
+}
+
+type genAIAgentCreateRequest struct {
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	Instruction        string   `json:"instruction"`
+	ModelUUID          string   `json:"model_uuid"`
+	ProjectID          string   `json:"project_id"`
+	Region             string   `json:"region"`
+	Tags               []string `json:"tags,omitempty"`
+	KnowledgeBaseUUIDs []string `json:"knowledge_base_uuid,omitempty"`
+}
+
+type genAIAgentUpdateRequest struct {
+	UUID        string   `json:"uuid"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Instruction string   `json:"instruction"`
+	ModelUUID   string   `json:"model_uuid"`
+	ProjectID   string   `json:"project_id"`
+	Tags        []string `json:"tags"`
+	Temperature float64  `json:"temperature"`
+	TopP        float64  `json:"top_p"`
+	MaxTokens   int      `json:"max_tokens"`
+	K           int      `json:"k"`
+}
+
+type genAIAgentVisibilityRequest struct {
+	UUID       string `json:"uuid"`
+	Visibility string `json:"visibility"`
+}
+
+type genAIAgentRoot struct {
+	Agent *genAIAgent `json:"agent"`
+}
+
+type genAIAgentsRoot struct {
+	Agents []genAIAgent `json:"agents"`
+	Links  *godo.Links  `json:"links"`
+}
+
+// genAIKnowledgeBase represents a GenAI knowledge base.
+type genAIKnowledgeBase struct {
+	UUID               string   `json:"uuid"`
+	Name               string   `json:"name"`
+	Region             string   `json:"region"`
+	ProjectID          string   `json:"project_id"`
+	Tags               []string `json:"tags,omitempty"`
+	DatabaseID         string   `json:"database_id,omitempty"`
+	EmbeddingModelUUID string   `json:"embedding_model_uuid"`
+	CreatedAt          string   `json:"created_at,omitempty"`
+	UpdatedAt          string   `json:"updated_at,omitempty"`
+}
+
+// genAIKnowledgeBaseDataSource is a source of the documents indexed by a
+// knowledge base. Exactly one of its fields is set.
+type genAIKnowledgeBaseDataSource struct {
+	UUID                 string                     `json:"uuid,omitempty"`
+	SpacesDataSource     *genAISpacesDataSource     `json:"spaces_data_source,omitempty"`
+	WebCrawlerDataSource *genAIWebCrawlerDataSource `json:"web_crawler_data_source,omitempty"`
+}
+
+type genAISpacesDataSource struct {
+	BucketName string `json:"bucket_name"`
+	ItemPath   string `json:"item_path,omitempty"`
+	Region     string `json:"region"`
+}
+
+type genAIWebCrawlerDataSource struct {
+	BaseURL        string `json:"base_url"`
+	CrawlingOption string `json:"crawling_option,omitempty"`
+	EmbedMedia     bool   `json:"embed_media"`
+}
+
+type genAIKnowledgeBaseCreateRequest struct {
+	Name               string                         `json:"name"`
+	EmbeddingModelUUID string                         `json:"embedding_model_uuid"`
+	ProjectID          string                         `json:"project_id"`
+	Region             string                         `json:"region"`
+	Tags               []string                       `json:"tags,omitempty"`
+	VPCUUID            string                         `json:"vpc_uuid,omitempty"`
+	DatabaseID         string                         `json:"database_id,omitempty"`
+	DataSources        []genAIKnowledgeBaseDataSource `json:"datasources"`
+}
+
+type genAIKnowledgeBaseUpdateRequest struct {
+	UUID      string   `json:"uuid"`
+	Name      string   `json:"name"`
+	ProjectID string   `json:"project_id"`
+	Tags      []string `json:"tags"`
+}
+
+type genAIKnowledgeBaseRoot struct {
+	KnowledgeBase  *genAIKnowledgeBase `json:"knowledge_base"`
+	DatabaseStatus string              `json:"database_status,omitempty"`
+}
+
+type genAIKnowledgeBasesRoot struct {
+	KnowledgeBases []genAIKnowledgeBase `json:"knowledge_bases"`
+	Links          *godo.Links          `json:"links"`
+}
+
+type genAIKnowledgeBaseDataSourcesRoot struct {
+	DataSources []genAIKnowledgeBaseDataSource `json:"knowledge_base_data_sources"`
+	Links       *godo.Links                    `json:"links"`
+}
+
+func genAIDo(ctx context.Context, client *godo.Client, method, path string, body, v interface{}) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, v)
+}
+
+// listGenAIPages calls list for every page of a list, until it returns nil
+// links or the last page.
+func listGenAIPages(path string, list func(path string) (*godo.Links, error)) error {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		links, err := list(fmt.Sprintf("%s?page=%d&per_page=%d", path, opts.Page, opts.PerPage))
+		if err != nil {
+			return err
+		}
+
+		if links == nil || links.IsLastPage() {
+			return nil
+		}
+
+		page, err := links.CurrentPage()
+		if err != nil {
+			return err
+		}
+
+		opts.Page = page + 1
+	}
+}
+
+func listGenAIModels(ctx context.Context, client *godo.Client) ([]genAIModel, error) {
+	var models []genAIModel
+	err := listGenAIPages(genAIModelsPath, func(path string) (*godo.Links, error) {
+		root := new(genAIModelsRoot)
+		if _, err := genAIDo(ctx, client, http.MethodGet, path, nil, root); err != nil {
+			return nil, err
+		}
+		models = append(models, root.Models...)
+		return root.Links, nil
+	})
+
+	return models, err
+}
+
+func getGenAIAgent(ctx context.Context, client *godo.Client, id string) (*genAIAgent, *godo.Response, error) {
+	root := new(genAIAgentRoot)
+	resp, err := genAIDo(ctx, client, http.MethodGet, fmt.Sprintf("%s/%s", genAIAgentsPath, id), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Agent, resp, nil
+}
+
+func listGenAIAgents(ctx context.Context, client *godo.Client) ([]genAIAgent, error) {
+	var agents []genAIAgent
+	err := listGenAIPages(genAIAgentsPath, func(path string) (*godo.Links, error) {
+		root := new(genAIAgentsRoot)
+		if _, err := genAIDo(ctx, client, http.MethodGet, path, nil, root); err != nil {
+			return nil, err
+		}
+		agents = append(agents, root.Agents...)
+		return root.Links, nil
+	})
+
+	return agents, err
+}
+
+func createGenAIAgent(ctx context.Context, client *godo.Client, create *genAIAgentCreateRequest) (*genAIAgent, error) {
+	root := new(genAIAgentRoot)
+	if _, err := genAIDo(ctx, client, http.MethodPost, genAIAgentsPath, create, root); err != nil {
+		return nil, err
+	}
+
+	return root.Agent, nil
+}
+
+func updateGenAIAgent(ctx context.Context, client *godo.Client, update *genAIAgentUpdateRequest) error {
+	_, err := genAIDo(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", genAIAgentsPath, update.UUID), update, nil)
+	return err
+}
+
+func updateGenAIAgentVisibility(ctx context.Context, client *godo.Client, id, visibility string) error {
+	update := &genAIAgentVisibilityRequest{
+		UUID:       id,
+		Visibility: visibility,
+	}
+	_, err := genAIDo(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s/deployment_visibility", genAIAgentsPath, id), update, nil)
+	return err
+}
+
+func deleteGenAIAgent(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+	return genAIDo(ctx, client, http.MethodDelete, fmt.Sprintf("%s/%s", genAIAgentsPath, id), nil, nil)
+}
+
+func attachGenAIKnowledgeBase(ctx context.Context, client *godo.Client, agentID, knowledgeBaseID string) error {
+	_, err := genAIDo(ctx, client, http.MethodPost, fmt.Sprintf("%s/%s/knowledge_bases/%s", genAIAgentsPath, agentID, knowledgeBaseID), nil, nil)
+	return err
+}
+
+func detachGenAIKnowledgeBase(ctx context.Context, client *godo.Client, agentID, knowledgeBaseID string) error {
+	_, err := genAIDo(ctx, client, http.MethodDelete, fmt.Sprintf("%s/%s/knowledge_bases/%s", genAIAgentsPath, agentID, knowledgeBaseID), nil, nil)
+	return err
+}
+
+func getGenAIKnowledgeBase(ctx context.Context, client *godo.Client, id string) (*genAIKnowledgeBaseRoot, *godo.Response, error) {
+	root := new(genAIKnowledgeBaseRoot)
+	resp, err := genAIDo(ctx, client, http.MethodGet, fmt.Sprintf("%s/%s", genAIKnowledgeBasesPath, id), nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+func listGenAIKnowledgeBases(ctx context.Context, client *godo.Client) ([]genAIKnowledgeBase, error) {
+	var knowledgeBases []genAIKnowledgeBase
+	err := listGenAIPages(genAIKnowledgeBasesPath, func(path string) (*godo.Links, error) {
+		root := new(genAIKnowledgeBasesRoot)
+		if _, err := genAIDo(ctx, client, http.MethodGet, path, nil, root); err != nil {
+			return nil, err
+		}
+		knowledgeBases = append(knowledgeBases, root.KnowledgeBases...)
+		return root.Links, nil
+	})
+
+	return knowledgeBases, err
+}
+
+func listGenAIKnowledgeBaseDataSources(ctx context.Context, client *godo.Client, id string) ([]genAIKnowledgeBaseDataSource, error) {
+	var dataSources []genAIKnowledgeBaseDataSource
+	err := listGenAIPages(fmt.Sprintf("%s/%s/data_sources", genAIKnowledgeBasesPath, id), func(path string) (*godo.Links, error) {
+		root := new(genAIKnowledgeBaseDataSourcesRoot)
+		if _, err := genAIDo(ctx, client, http.MethodGet, path, nil, root); err != nil {
+			return nil, err
+		}
+		dataSources = append(dataSources, root.DataSources...)
+		return root.Links, nil
+	})
+
+	return dataSources, err
+}
+
+func createGenAIKnowledgeBase(ctx context.Context, client *godo.Client, create *genAIKnowledgeBaseCreateRequest) (*genAIKnowledgeBase, error) {
+	root := new(genAIKnowledgeBaseRoot)
+	if _, err := genAIDo(ctx, client, http.MethodPost, genAIKnowledgeBasesPath, create, root); err != nil {
+		return nil, err
+	}
+
+	return root.KnowledgeBase, nil
+}
+
+func updateGenAIKnowledgeBase(ctx context.Context, client *godo.Client, update *genAIKnowledgeBaseUpdateRequest) error {
+	_, err := genAIDo(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", genAIKnowledgeBasesPath, update.UUID), update, nil)
+	return err
+}
+
+func deleteGenAIKnowledgeBase(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+	return genAIDo(ctx, client, http.MethodDelete, fmt.Sprintf("%s/%s", genAIKnowledgeBasesPath, id), nil, nil)
+}
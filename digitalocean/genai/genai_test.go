@@ -0,0 +1,63 @@
+package genai_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAccGenAIModelsConfig looks up the models used by the tests.
+const testAccGenAIModelsConfig = `
+data "digitalocean_project" "default" {
+}
+
+data "digitalocean_genai_models" "agent" {
+  filter {
+    key      = "name"
+    values   = ["Llama 3.3 Instruct"]
+    match_by = "substring"
+  }
+}
+
+data "digitalocean_genai_models" "embedding" {
+  filter {
+    key      = "name"
+    values   = ["GTE Large"]
+    match_by = "substring"
+  }
+}
+`
+
+// testAccCheckDigitalOceanGenAIDestroy returns a check that the resources of
+// the given type, found at the given path of the GenAI API, were destroyed.
+func testAccCheckDigitalOceanGenAIDestroy(resourceType, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			req, err := client.NewRequest(context.Background(), http.MethodGet, fmt.Sprintf("%s/%s", path, rs.Primary.ID), nil)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Do(context.Background(), req, nil)
+			if err == nil {
+				return fmt.Errorf("%s resource still exists", resourceType)
+			}
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
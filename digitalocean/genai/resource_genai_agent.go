@@ -0,0 +1,367 @@
+package genai
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const genAIAgentVisibilityPrefix = "VISIBILITY_"
+
+func ResourceDigitalOceanGenAIAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanGenAIAgentCreate,
+		ReadContext:   resourceDigitalOceanGenAIAgentRead,
+		UpdateContext: resourceDigitalOceanGenAIAgentUpdate,
+		DeleteContext: resourceDigitalOceanGenAIAgentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "the ID of the project the agent belongs to",
+				ValidateFunc: validation.IsUUID,
+			},
+			"model_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "the UUID of the model used by the agent",
+				ValidateFunc: validation.IsUUID,
+			},
+			"instruction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "the instructions given to the model, i.e. the system prompt of the agent",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"knowledge_base_uuids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the UUIDs of the knowledge bases the agent retrieves information from",
+			},
+			"temperature": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+				Description:  "the sampling temperature of the model",
+			},
+			"top_p": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+				Description:  "the cumulative probability of the tokens the model samples from",
+			},
+			"max_tokens": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "the maximum number of tokens in the responses of the agent",
+			},
+			"k": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+				Description:  "the number of results retrieved from the knowledge bases for each request",
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Description:  "whether the endpoint of the agent can be used without an access key",
+			},
+			"tags": tag.TagsSchema(),
+			"deployment_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the URL of the endpoint of the agent",
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanGenAIAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &genAIAgentCreateRequest{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Instruction:        d.Get("instruction").(string),
+		ModelUUID:          d.Get("model_uuid").(string),
+		ProjectID:          d.Get("project_id").(string),
+		Region:             d.Get("region").(string),
+		Tags:               tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
+		KnowledgeBaseUUIDs: expandGenAIKnowledgeBaseUUIDs(d.Get("knowledge_base_uuids").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] GenAI agent create configuration: %#v", opts)
+	agent, err := createGenAIAgent(ctx, client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating GenAI agent: %s", err)
+	}
+
+	d.SetId(agent.UUID)
+	log.Printf("[INFO] GenAI agent created: %s", agent.UUID)
+
+	agent, err = waitForGenAIAgentDeployment(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error waiting for GenAI agent (%s) to be deployed: %s", d.Id(), err)
+	}
+
+	// The model settings can only be set once the agent is created.
+	_, temperature := d.GetOk("temperature")
+	_, topP := d.GetOk("top_p")
+	_, maxTokens := d.GetOk("max_tokens")
+	_, k := d.GetOk("k")
+	if temperature || topP || maxTokens || k {
+		if err := updateGenAIAgent(ctx, client, expandGenAIAgentUpdateRequest(d, agent)); err != nil {
+			return diag.Errorf("Error updating GenAI agent (%s): %s", d.Id(), err)
+		}
+	}
+
+	if visibility := d.Get("visibility").(string); visibility != flattenGenAIAgentVisibility(agent) {
+		if err := updateGenAIAgentVisibility(ctx, client, d.Id(), expandGenAIAgentVisibility(visibility)); err != nil {
+			return diag.Errorf("Error updating visibility of GenAI agent (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanGenAIAgentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGenAIAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	agent, resp, err := getGenAIAgent(ctx, client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] GenAI agent (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading GenAI agent: %s", err)
+	}
+
+	d.Set("name", agent.Name)
+	d.Set("region", agent.Region)
+	d.Set("project_id", agent.ProjectID)
+	d.Set("instruction", agent.Instruction)
+	d.Set("description", agent.Description)
+	d.Set("temperature", agent.Temperature)
+	d.Set("top_p", agent.TopP)
+	d.Set("max_tokens", agent.MaxTokens)
+	d.Set("k", agent.K)
+	d.Set("tags", tag.FlattenTags(agent.Tags))
+	d.Set("knowledge_base_uuids", genAIKnowledgeBaseIDs(agent.KnowledgeBases))
+	d.Set("created_at", agent.CreatedAt)
+	d.Set("updated_at", agent.UpdatedAt)
+
+	if agent.Model != nil {
+		d.Set("model_uuid", agent.Model.UUID)
+	}
+
+	d.Set("visibility", flattenGenAIAgentVisibility(agent))
+	if agent.Deployment != nil {
+		d.Set("deployment_url", agent.Deployment.URL)
+		d.Set("deployment_status", agent.Deployment.Status)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanGenAIAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChange("knowledge_base_uuids") {
+		old, new := d.GetChange("knowledge_base_uuids")
+		detach := old.(*schema.Set).Difference(new.(*schema.Set))
+		attach := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		for _, id := range detach.List() {
+			if err := detachGenAIKnowledgeBase(ctx, client, d.Id(), id.(string)); err != nil {
+				return diag.Errorf("Error detaching knowledge base (%s) from GenAI agent (%s): %s", id, d.Id(), err)
+			}
+		}
+
+		for _, id := range attach.List() {
+			if err := attachGenAIKnowledgeBase(ctx, client, d.Id(), id.(string)); err != nil {
+				return diag.Errorf("Error attaching knowledge base (%s) to GenAI agent (%s): %s", id, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("name", "description", "instruction", "model_uuid", "project_id", "tags", "temperature", "top_p", "max_tokens", "k") {
+		if err := updateGenAIAgent(ctx, client, expandGenAIAgentUpdateRequest(d, nil)); err != nil {
+			return diag.Errorf("Error updating GenAI agent (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitForGenAIAgentDeployment(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error waiting for GenAI agent (%s) to be deployed: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("visibility") {
+		if err := updateGenAIAgentVisibility(ctx, client, d.Id(), expandGenAIAgentVisibility(d.Get("visibility").(string))); err != nil {
+			return diag.Errorf("Error updating visibility of GenAI agent (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanGenAIAgentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGenAIAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting GenAI agent: %s", d.Id())
+	resp, err := deleteGenAIAgent(ctx, client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting GenAI agent: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForGenAIAgentDeployment(ctx context.Context, client *godo.Client, id string, timeout time.Duration) (*genAIAgent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"", "STATUS_UNKNOWN", "STATUS_WAITING_FOR_DEPLOYMENT", "STATUS_DEPLOYING"},
+		Target:  []string{"STATUS_RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			agent, _, err := getGenAIAgent(ctx, client, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if agent.Deployment == nil {
+				return agent, "", nil
+			}
+
+			return agent, agent.Deployment.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	agent, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return agent.(*genAIAgent), nil
+}
+
+// expandGenAIAgentUpdateRequest returns the update of the agent to its
+// configuration. The model settings not set in the configuration are kept
+// from the given agent, if any.
+func expandGenAIAgentUpdateRequest(d *schema.ResourceData, agent *genAIAgent) *genAIAgentUpdateRequest {
+	update := &genAIAgentUpdateRequest{
+		UUID:        d.Id(),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Instruction: d.Get("instruction").(string),
+		ModelUUID:   d.Get("model_uuid").(string),
+		ProjectID:   d.Get("project_id").(string),
+		Tags:        tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
+		Temperature: d.Get("temperature").(float64),
+		TopP:        d.Get("top_p").(float64),
+		MaxTokens:   d.Get("max_tokens").(int),
+		K:           d.Get("k").(int),
+	}
+
+	if agent != nil {
+		if _, ok := d.GetOk("temperature"); !ok {
+			update.Temperature = agent.Temperature
+		}
+		if _, ok := d.GetOk("top_p"); !ok {
+			update.TopP = agent.TopP
+		}
+		if _, ok := d.GetOk("max_tokens"); !ok {
+			update.MaxTokens = agent.MaxTokens
+		}
+		if _, ok := d.GetOk("k"); !ok {
+			update.K = agent.K
+		}
+	}
+
+	return update
+}
+
+func expandGenAIKnowledgeBaseUUIDs(raw []interface{}) []string {
+	ids := make([]string, 0, len(raw))
+	for _, id := range raw {
+		ids = append(ids, id.(string))
+	}
+	return ids
+}
+
+func genAIKnowledgeBaseIDs(knowledgeBases []genAIKnowledgeBase) []string {
+	ids := make([]string, 0, len(knowledgeBases))
+	for _, knowledgeBase := range knowledgeBases {
+		ids = append(ids, knowledgeBase.UUID)
+	}
+	return ids
+}
+
+func expandGenAIAgentVisibility(visibility string) string {
+	return genAIAgentVisibilityPrefix + strings.ToUpper(visibility)
+}
+
+func flattenGenAIAgentVisibility(agent *genAIAgent) string {
+	if agent.Deployment == nil || agent.Deployment.Visibility == "" {
+		return "private"
+	}
+	return strings.ToLower(strings.TrimPrefix(agent.Deployment.Visibility, genAIAgentVisibilityPrefix))
+}
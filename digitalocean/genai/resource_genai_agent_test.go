@@ -0,0 +1,85 @@
+package genai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanGenAIAgent_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	updatedName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanGenAIDestroy("digitalocean_genai_agent", "v2/gen-ai/agents"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGenAIModelsConfig + fmt.Sprintf(testAccCheckDigitalOceanGenAIAgentConfig_Basic, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "name", name),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "region", "tor1"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_genai_agent.foobar", "model_uuid", "data.digitalocean_genai_models.agent", "models.0.id"),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "instruction", "You answer questions about DigitalOcean."),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "visibility", "private"),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "deployment_status", "STATUS_RUNNING"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_agent.foobar", "deployment_url"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_agent.foobar", "temperature"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_agent.foobar", "created_at"),
+				),
+			},
+			{
+				Config: testAccGenAIModelsConfig + fmt.Sprintf(testAccCheckDigitalOceanGenAIAgentConfig_KnowledgeBase, updatedName, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "name", updatedName),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "temperature", "0.2"),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "k", "5"),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "visibility", "public"),
+					resource.TestCheckResourceAttr("digitalocean_genai_agent.foobar", "knowledge_base_uuids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"digitalocean_genai_agent.foobar", "knowledge_base_uuids.*", "digitalocean_genai_knowledge_base.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanGenAIAgentConfig_Basic = `
+resource "digitalocean_genai_agent" "foobar" {
+  name        = "%s"
+  region      = "tor1"
+  project_id  = data.digitalocean_project.default.id
+  model_uuid  = data.digitalocean_genai_models.agent.models.0.id
+  instruction = "You answer questions about DigitalOcean."
+}
+`
+
+const testAccCheckDigitalOceanGenAIAgentConfig_KnowledgeBase = `
+resource "digitalocean_genai_knowledge_base" "foobar" {
+  name                 = "%s"
+  region               = "tor1"
+  project_id           = data.digitalocean_project.default.id
+  embedding_model_uuid = data.digitalocean_genai_models.embedding.models.0.id
+
+  web_crawler_data_source {
+    base_url        = "https://docs.digitalocean.com/products/gen-ai/"
+    crawling_option = "PATH"
+  }
+}
+
+resource "digitalocean_genai_agent" "foobar" {
+  name                 = "%s"
+  region               = "tor1"
+  project_id           = data.digitalocean_project.default.id
+  model_uuid           = data.digitalocean_genai_models.agent.models.0.id
+  instruction          = "You answer questions about DigitalOcean."
+  knowledge_base_uuids = [digitalocean_genai_knowledge_base.foobar.id]
+  temperature          = 0.2
+  k                    = 5
+  visibility           = "public"
+}
+`
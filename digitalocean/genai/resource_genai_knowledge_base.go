@@ -0,0 +1,333 @@
+package genai
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanGenAIKnowledgeBase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanGenAIKnowledgeBaseCreate,
+		ReadContext:   resourceDigitalOceanGenAIKnowledgeBaseRead,
+		UpdateContext: resourceDigitalOceanGenAIKnowledgeBaseUpdate,
+		DeleteContext: resourceDigitalOceanGenAIKnowledgeBaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "the ID of the project the knowledge base belongs to",
+				ValidateFunc: validation.IsUUID,
+			},
+			"embedding_model_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "the UUID of the model used to embed the documents of the knowledge base",
+				ValidateFunc: validation.IsUUID,
+			},
+			"vpc_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "the ID of the VPC the database of the knowledge base is created in",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"database_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "the ID of the OpenSearch database cluster storing the knowledge base; a new one is created if not set",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"spaces_data_source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "a Spaces bucket, or a path within it, to index",
+				AtLeastOneOf: []string{"spaces_data_source", "web_crawler_data_source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"item_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "the path of the files to index within the bucket; the whole bucket is indexed if not set",
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"web_crawler_data_source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "a website to crawl and index",
+				AtLeastOneOf: []string{"spaces_data_source", "web_crawler_data_source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"crawling_option": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "SCOPED",
+							ValidateFunc: validation.StringInSlice([]string{
+								"SCOPED",
+								"PATH",
+								"DOMAIN",
+								"SUBDOMAINS",
+							}, false),
+							Description: "which pages linked from the base URL are crawled",
+						},
+						"embed_media": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "whether images and other media are indexed",
+						},
+					},
+				},
+			},
+			"tags": tag.TagsSchema(),
+			"database_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the status of the database of the knowledge base",
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanGenAIKnowledgeBaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &genAIKnowledgeBaseCreateRequest{
+		Name:               d.Get("name").(string),
+		EmbeddingModelUUID: d.Get("embedding_model_uuid").(string),
+		ProjectID:          d.Get("project_id").(string),
+		Region:             d.Get("region").(string),
+		Tags:               tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
+		VPCUUID:            d.Get("vpc_uuid").(string),
+		DatabaseID:         d.Get("database_id").(string),
+		DataSources:        expandGenAIKnowledgeBaseDataSources(d),
+	}
+
+	log.Printf("[DEBUG] GenAI knowledge base create configuration: %#v", opts)
+	knowledgeBase, err := createGenAIKnowledgeBase(ctx, client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating GenAI knowledge base: %s", err)
+	}
+
+	d.SetId(knowledgeBase.UUID)
+	log.Printf("[INFO] GenAI knowledge base created: %s", knowledgeBase.UUID)
+
+	if err := waitForGenAIKnowledgeBaseDatabase(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for the database of GenAI knowledge base (%s) to become online: %s", d.Id(), err)
+	}
+
+	return resourceDigitalOceanGenAIKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGenAIKnowledgeBaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	root, resp, err := getGenAIKnowledgeBase(ctx, client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] GenAI knowledge base (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading GenAI knowledge base: %s", err)
+	}
+
+	knowledgeBase := root.KnowledgeBase
+	d.Set("name", knowledgeBase.Name)
+	d.Set("region", knowledgeBase.Region)
+	d.Set("project_id", knowledgeBase.ProjectID)
+	d.Set("embedding_model_uuid", knowledgeBase.EmbeddingModelUUID)
+	d.Set("database_id", knowledgeBase.DatabaseID)
+	d.Set("database_status", root.DatabaseStatus)
+	d.Set("tags", tag.FlattenTags(knowledgeBase.Tags))
+	d.Set("created_at", knowledgeBase.CreatedAt)
+	d.Set("updated_at", knowledgeBase.UpdatedAt)
+
+	dataSources, err := listGenAIKnowledgeBaseDataSources(ctx, client, d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading GenAI knowledge base data sources: %s", err)
+	}
+
+	spaces, webCrawlers := flattenGenAIKnowledgeBaseDataSources(dataSources)
+	if err := d.Set("spaces_data_source", spaces); err != nil {
+		return diag.Errorf("Error setting spaces_data_source: %s", err)
+	}
+	if err := d.Set("web_crawler_data_source", webCrawlers); err != nil {
+		return diag.Errorf("Error setting web_crawler_data_source: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanGenAIKnowledgeBaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChanges("name", "project_id", "tags") {
+		opts := &genAIKnowledgeBaseUpdateRequest{
+			UUID:      d.Id(),
+			Name:      d.Get("name").(string),
+			ProjectID: d.Get("project_id").(string),
+			Tags:      tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
+		}
+
+		if err := updateGenAIKnowledgeBase(ctx, client, opts); err != nil {
+			return diag.Errorf("Error updating GenAI knowledge base (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanGenAIKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGenAIKnowledgeBaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting GenAI knowledge base: %s", d.Id())
+	resp, err := deleteGenAIKnowledgeBase(ctx, client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting GenAI knowledge base: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForGenAIKnowledgeBaseDatabase(ctx context.Context, client *godo.Client, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"", "CREATING", "POWERING_ON", "REBUILDING"},
+		Target:  []string{"ONLINE"},
+		Refresh: func() (interface{}, string, error) {
+			root, _, err := getGenAIKnowledgeBase(ctx, client, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return root, root.DatabaseStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func expandGenAIKnowledgeBaseDataSources(d *schema.ResourceData) []genAIKnowledgeBaseDataSource {
+	var dataSources []genAIKnowledgeBaseDataSource
+
+	for _, raw := range d.Get("spaces_data_source").([]interface{}) {
+		s := raw.(map[string]interface{})
+		dataSources = append(dataSources, genAIKnowledgeBaseDataSource{
+			SpacesDataSource: &genAISpacesDataSource{
+				BucketName: s["bucket_name"].(string),
+				ItemPath:   s["item_path"].(string),
+				Region:     s["region"].(string),
+			},
+		})
+	}
+
+	for _, raw := range d.Get("web_crawler_data_source").([]interface{}) {
+		w := raw.(map[string]interface{})
+		dataSources = append(dataSources, genAIKnowledgeBaseDataSource{
+			WebCrawlerDataSource: &genAIWebCrawlerDataSource{
+				BaseURL:        w["base_url"].(string),
+				CrawlingOption: w["crawling_option"].(string),
+				EmbedMedia:     w["embed_media"].(bool),
+			},
+		})
+	}
+
+	return dataSources
+}
+
+func flattenGenAIKnowledgeBaseDataSources(dataSources []genAIKnowledgeBaseDataSource) ([]interface{}, []interface{}) {
+	spaces := make([]interface{}, 0)
+	webCrawlers := make([]interface{}, 0)
+
+	for _, dataSource := range dataSources {
+		if s := dataSource.SpacesDataSource; s != nil {
+			spaces = append(spaces, map[string]interface{}{
+				"bucket_name": s.BucketName,
+				"item_path":   s.ItemPath,
+				"region":      s.Region,
+			})
+		}
+
+		if w := dataSource.WebCrawlerDataSource; w != nil {
+			webCrawlers = append(webCrawlers, map[string]interface{}{
+				"base_url":        w.BaseURL,
+				"crawling_option": w.CrawlingOption,
+				"embed_media":     w.EmbedMedia,
+			})
+		}
+	}
+
+	return spaces, webCrawlers
+}
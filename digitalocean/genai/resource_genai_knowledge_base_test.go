@@ -0,0 +1,64 @@
+package genai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanGenAIKnowledgeBase_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	updatedName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanGenAIDestroy("digitalocean_genai_knowledge_base", "v2/gen-ai/knowledge_bases"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGenAIModelsConfig + fmt.Sprintf(testAccCheckDigitalOceanGenAIKnowledgeBaseConfig, name, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "name", name),
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "region", "tor1"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_genai_knowledge_base.foobar", "project_id", "data.digitalocean_project.default", "id"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_genai_knowledge_base.foobar", "embedding_model_uuid", "data.digitalocean_genai_models.embedding", "models.0.id"),
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "web_crawler_data_source.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_genai_knowledge_base.foobar", "web_crawler_data_source.0.base_url", "https://docs.digitalocean.com/products/gen-ai/"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_genai_knowledge_base.foobar", "web_crawler_data_source.0.crawling_option", "PATH"),
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "database_status", "ONLINE"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_knowledge_base.foobar", "database_id"),
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "tags.#", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_knowledge_base.foobar", "created_at"),
+				),
+			},
+			{
+				Config: testAccGenAIModelsConfig + fmt.Sprintf(testAccCheckDigitalOceanGenAIKnowledgeBaseConfig, updatedName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_knowledge_base.foobar", "name", updatedName),
+					resource.TestCheckTypeSetElemAttr("digitalocean_genai_knowledge_base.foobar", "tags.*", "bar"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanGenAIKnowledgeBaseConfig = `
+resource "digitalocean_genai_knowledge_base" "foobar" {
+  name                 = "%s"
+  region               = "tor1"
+  project_id           = data.digitalocean_project.default.id
+  embedding_model_uuid = data.digitalocean_genai_models.embedding.models.0.id
+  tags                 = ["%s"]
+
+  web_crawler_data_source {
+    base_url        = "https://docs.digitalocean.com/products/gen-ai/"
+    crawling_option = "PATH"
+  }
+}
+`
@@ -0,0 +1,76 @@
+package genai
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("digitalocean_genai_agent", &resource.Sweeper{
+		Name: "digitalocean_genai_agent",
+		F:    sweepGenAIAgents,
+	})
+
+	resource.AddTestSweepers("digitalocean_genai_knowledge_base", &resource.Sweeper{
+		Name:         "digitalocean_genai_knowledge_base",
+		F:            sweepGenAIKnowledgeBases,
+		Dependencies: []string{"digitalocean_genai_agent"},
+	})
+}
+
+func sweepGenAIAgents(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	ctx := context.Background()
+
+	agents, err := listGenAIAgents(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range agents {
+		if strings.HasPrefix(a.Name, sweep.NamePrefix()) {
+			log.Printf("[DEBUG] Destroying GenAI agent %s", a.Name)
+			if _, err := deleteGenAIAgent(ctx, client, a.UUID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func sweepGenAIKnowledgeBases(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	ctx := context.Background()
+
+	knowledgeBases, err := listGenAIKnowledgeBases(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for _, kb := range knowledgeBases {
+		if strings.HasPrefix(kb.Name, sweep.NamePrefix()) {
+			log.Printf("[DEBUG] Destroying GenAI knowledge base %s", kb.Name)
+			if _, err := deleteGenAIKnowledgeBase(ctx, client, kb.UUID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
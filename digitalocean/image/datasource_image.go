@@ -52,7 +52,7 @@ func dataSourceDigitalOceanImageRead(ctx context.Context, d *schema.ResourceData
 	var foundImage *godo.Image
 
 	if id, ok := d.GetOk("id"); ok {
-		image, resp, err := client.Images.GetByID(context.Background(), id.(int))
+		image, resp, err := client.Images.GetByID(ctx, id.(int))
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				return diag.Errorf("image ID %d not found: %s", id.(int), err)
@@ -61,7 +61,7 @@ func dataSourceDigitalOceanImageRead(ctx context.Context, d *schema.ResourceData
 		}
 		foundImage = image
 	} else if slug, ok := d.GetOk("slug"); ok {
-		image, resp, err := client.Images.GetBySlug(context.Background(), slug.(string))
+		image, resp, err := client.Images.GetBySlug(ctx, slug.(string))
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				return diag.Errorf("image not found: %s", err)
@@ -94,13 +94,26 @@ func ResourceDigitalOceanCustomImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"retry_on_failure": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 10),
+				Description:  "the number of times a failed import of the image is deleted and re-created",
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 		},
 
-		// Images can not currently be removed from a region.
+		// Additional regions are distributed to in place using image transfer
+		// actions, but the API does not provide a way to remove an image from
+		// a region. Removing a region requires the image to be re-created.
 		CustomizeDiff: customdiff.ForceNewIfChange("regions", func(ctx context.Context, old, new, meta interface{}) bool {
 			remove, _ := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
 			return len(remove.List()) > 0
@@ -133,17 +146,33 @@ func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.Resour
 		imageCreateRequest.Tags = tag.ExpandTags(tags.(*schema.Set).List())
 	}
 
-	imageResponse, _, err := client.Images.Create(ctx, &imageCreateRequest)
-	if err != nil {
-		return diag.Errorf("Error creating custom image: %s", err)
-	}
+	// Imports failing part way, e.g. because the URL was briefly unreachable,
+	// are re-created up to retry_on_failure times.
+	retries := d.Get("retry_on_failure").(int)
+	var imageResponse *godo.Image
+	var err error
+	for attempt := 0; ; attempt++ {
+		imageResponse, _, err = client.Images.Create(ctx, &imageCreateRequest)
+		if err != nil {
+			return diag.Errorf("Error creating custom image: %s", err)
+		}
 
-	id := strconv.Itoa(imageResponse.ID)
-	d.SetId(id)
+		id := strconv.Itoa(imageResponse.ID)
+		d.SetId(id)
 
-	_, err = waitForImage(ctx, d, ImageAvailableStatus, imagePendingStatuses(), "status", meta)
-	if err != nil {
-		return diag.Errorf("Error waiting for image (%s) to become ready: %s", d.Id(), err)
+		_, err = waitForImage(ctx, d, ImageAvailableStatus, imagePendingStatuses(), "status", meta)
+		if err == nil {
+			break
+		}
+
+		if _, ok := err.(*imageImportError); !ok || attempt >= retries {
+			return diag.Errorf("Error waiting for image (%s) to become ready: %s", d.Id(), err)
+		}
+
+		log.Printf("[WARN] Import of image (%s) failed, retrying (%d/%d): %s", d.Id(), attempt+1, retries, err)
+		if _, err := client.Images.Delete(ctx, imageResponse.ID); err != nil {
+			log.Printf("[DEBUG] Unable to delete failed image (%s): %s", d.Id(), err)
+		}
 	}
 
 	if len(regions) > 1 {
@@ -152,7 +181,7 @@ func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.Resour
 		regions[len(regions)-1] = ""
 		regions = regions[:len(regions)-1]
 		log.Printf("[INFO] Image available in: %s Distributing to: %v", region, regions)
-		err = distributeImageToRegions(client, imageResponse.ID, regions)
+		err = distributeImageToRegions(ctx, client, imageResponse.ID, regions)
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -178,6 +207,17 @@ func resourceDigitalOceanCustomImageRead(ctx context.Context, d *schema.Resource
 	// Set status as deleted if image is deleted
 	if imageResponse.Status == ImageDeletedStatus {
 		d.SetId("")
+
+		// A failed import leaves the image deleted with the reason why.
+		if imageResponse.ErrorMessage != "" {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Import of custom image (%s) failed, it will be re-created", imageID),
+					Detail:   imageResponse.ErrorMessage,
+				},
+			}
+		}
 		return nil
 	}
 	d.Set("image_id", imageResponse.ID)
@@ -195,6 +235,7 @@ func resourceDigitalOceanCustomImageRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("Error setting `tags`: %+v", err)
 	}
 	d.Set("status", imageResponse.Status)
+	d.Set("error_message", imageResponse.ErrorMessage)
 	return nil
 }
 
@@ -225,7 +266,7 @@ func resourceDigitalOceanCustomImageUpdate(ctx context.Context, d *schema.Resour
 	if d.HasChange("regions") {
 		old, new := d.GetChange("regions")
 		_, add := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
-		err = distributeImageToRegions(client, id, add.List())
+		err = distributeImageToRegions(ctx, client, id, add.List())
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -286,14 +327,28 @@ func imageStateRefreshFunc(ctx context.Context, d *schema.ResourceData, state st
 		}
 
 		if imageResponse.Status == ImageDeletedStatus {
-			return nil, "", fmt.Errorf(imageResponse.ErrorMessage)
+			return nil, "", &imageImportError{message: imageResponse.ErrorMessage}
 		}
 
 		return imageResponse, imageResponse.Status, nil
 	}
 }
 
-func distributeImageToRegions(client *godo.Client, imageId int, regions []interface{}) (err error) {
+// imageImportError is returned when the import of an image fails, leaving it
+// deleted.
+type imageImportError struct {
+	message string
+}
+
+func (e *imageImportError) Error() string {
+	return e.message
+}
+
+// distributeImageToRegions transfers an image to each of the provided regions.
+// All of the transfers are started before waiting on any of them so that the
+// image is copied to the regions in parallel.
+func distributeImageToRegions(ctx context.Context, client *godo.Client, imageId int, regions []interface{}) error {
+	actions := make([]*godo.Action, 0, len(regions))
 	for _, region := range regions {
 		transferRequest := &godo.ActionRequest{
 			"type":   "transfer",
@@ -301,14 +356,20 @@ func distributeImageToRegions(client *godo.Client, imageId int, regions []interf
 		}
 
 		log.Printf("[INFO] Transferring image (%d) to: %s", imageId, region)
-		action, _, err := client.ImageActions.Transfer(context.TODO(), imageId, transferRequest)
+		action, _, err := client.ImageActions.Transfer(ctx, imageId, transferRequest)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error transferring image to %s: %s", region, err)
 		}
 
-		err = util.WaitForAction(client, action)
+		actions = append(actions, action)
+	}
+
+	// The region of a transfer action may be the one the image is transferred
+	// from, so the error names the requested region instead.
+	for i, action := range actions {
+		err := util.WaitForAction(ctx, client, action)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error waiting for image transfer to %s: %s", regions[i], err)
 		}
 	}
 
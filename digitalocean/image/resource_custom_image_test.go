@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -95,6 +96,31 @@ func TestAccDigitalOceanCustomImageMultiRegion(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCustomImage_failedImport(t *testing.T) {
+	rString := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanCustomImageConfig_failedImport, rString, rString),
+				ExpectError: regexp.MustCompile("Error waiting for image .* to become ready"),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanCustomImageConfig_failedImport = `
+resource "digitalocean_custom_image" "%s" {
+  name             = "%s"
+  url              = "https://stable.release.flatcar-linux.net/amd64-usr/not-found/flatcar_production_digitalocean_image.bin.bz2"
+  regions          = ["nyc3"]
+  retry_on_failure = 1
+}
+`
+
 func testAccCheckDigitalOceanCustomImageConfig(rName string, name string, regions string, distro string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_custom_image" "%s" {
@@ -0,0 +1,264 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanImageRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceDigitalOceanImageRetentionPolicyRead,
+		CreateContext: resourceDigitalOceanImageRetentionPolicyCreate,
+		UpdateContext: resourceDigitalOceanImageRetentionPolicyUpdate,
+		DeleteContext: resourceDigitalOceanImageRetentionPolicyDelete,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression matched against the names of images the policy applies to",
+			},
+			"keep_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the most recently created matching images to keep",
+			},
+			"image_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"custom", "snapshot"}, false),
+				},
+				Description: "The types of images the policy applies to. Defaults to both custom images and snapshots",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, matching images are listed in pending_deletion but not deleted",
+			},
+			"pending_deletion": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The images that would be deleted by applying the policy",
+			},
+			"deleted_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the images deleted by the most recent apply",
+			},
+		},
+
+		CustomizeDiff: resourceDigitalOceanImageRetentionPolicyCustomizeDiff,
+	}
+}
+
+func resourceDigitalOceanImageRetentionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.PrefixedUniqueId("image-retention-"))
+
+	if err := applyImageRetentionPolicy(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanImageRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanImageRetentionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	candidates, err := imageRetentionCandidates(ctx, meta.(*config.CombinedConfig), d.Get("name_regex").(string), d.Get("keep_count").(int), d.Get("image_types").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("pending_deletion", flattenImageRetentionCandidates(candidates)); err != nil {
+		return diag.Errorf("Error setting pending_deletion: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanImageRetentionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyImageRetentionPolicy(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanImageRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanImageRetentionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing the policy does not delete or restore any images.
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanImageRetentionPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	pending := len(diff.Get("pending_deletion").([]interface{}))
+
+	// The images pending deletion are refreshed by Read, so they are only
+	// listed again when the policy is created or the images it matches change.
+	if diff.Id() == "" || diff.HasChanges("name_regex", "keep_count", "image_types") {
+		if !diff.NewValueKnown("name_regex") || !diff.NewValueKnown("keep_count") || !diff.NewValueKnown("image_types") {
+			return diff.SetNewComputed("pending_deletion")
+		}
+
+		candidates, err := imageRetentionCandidates(ctx, meta.(*config.CombinedConfig), diff.Get("name_regex").(string), diff.Get("keep_count").(int), diff.Get("image_types").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		if err := diff.SetNew("pending_deletion", flattenImageRetentionCandidates(candidates)); err != nil {
+			return err
+		}
+		pending = len(candidates)
+	}
+
+	// Ensure the policy is applied whenever there are images to prune, even
+	// if the set of images pending deletion is unchanged since the last read.
+	if !diff.Get("dry_run").(bool) && pending > 0 {
+		return diff.SetNewComputed("deleted_ids")
+	}
+
+	return nil
+}
+
+func applyImageRetentionPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
+
+	deleted := []int{}
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] Image retention policy (%s) is a dry run, no images will be deleted", d.Id())
+		return d.Set("deleted_ids", deleted)
+	}
+
+	// The images are listed again rather than from the cache filled when
+	// planning, so that the keep_count most recent images include those
+	// created since, e.g. by snapshots applied before the policy.
+	combined.InvalidateUserImages()
+	candidates, err := imageRetentionCandidates(ctx, combined, d.Get("name_regex").(string), d.Get("keep_count").(int), d.Get("image_types").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	// The deleted images must no longer be listed by the Read that follows.
+	if len(candidates) > 0 {
+		defer combined.InvalidateUserImages()
+	}
+
+	for _, image := range candidates {
+		log.Printf("[INFO] Image retention policy (%s) deleting image: %d (%s)", d.Id(), image.ID, image.Name)
+		resp, err := client.Images.Delete(ctx, image.ID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			d.Set("deleted_ids", deleted)
+			return fmt.Errorf("Error deleting image %d (%s): %s", image.ID, image.Name, err)
+		}
+		deleted = append(deleted, image.ID)
+	}
+
+	return d.Set("deleted_ids", deleted)
+}
+
+// imageRetentionCandidates returns the images matching the policy which are
+// older than the keepCount most recently created matching images.
+func imageRetentionCandidates(ctx context.Context, meta *config.CombinedConfig, nameRegex string, keepCount int, imageTypes []interface{}) ([]godo.Image, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("Error compiling name_regex: %s", err)
+	}
+
+	types := map[string]bool{}
+	for _, t := range imageTypes {
+		types[t.(string)] = true
+	}
+	if len(types) == 0 {
+		types["custom"] = true
+		types["snapshot"] = true
+	}
+
+	images, err := meta.UserImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []godo.Image
+	for _, image := range images {
+		if types[image.Type] && re.MatchString(image.Name) {
+			matched = append(matched, image)
+		}
+	}
+
+	return SelectImagesToPrune(matched, keepCount), nil
+}
+
+// SelectImagesToPrune sorts the images from newest to oldest and returns all
+// but the first keepCount of them.
+func SelectImagesToPrune(images []godo.Image, keepCount int) []godo.Image {
+	sort.SliceStable(images, func(i, j int) bool {
+		return imageCreatedAt(images[i]).After(imageCreatedAt(images[j]))
+	})
+
+	if len(images) <= keepCount {
+		return []godo.Image{}
+	}
+
+	return images[keepCount:]
+}
+
+func imageCreatedAt(image godo.Image) time.Time {
+	created, err := time.Parse(time.RFC3339, image.Created)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return created
+}
+
+func flattenImageRetentionCandidates(images []godo.Image) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(images))
+	for _, image := range images {
+		result = append(result, map[string]interface{}{
+			"id":         image.ID,
+			"name":       image.Name,
+			"type":       image.Type,
+			"created_at": image.Created,
+		})
+	}
+
+	return result
+}
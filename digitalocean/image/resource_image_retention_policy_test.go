@@ -0,0 +1,213 @@
+package image_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanImageRetentionPolicy_DryRun(t *testing.T) {
+	prefix := acceptance.RandomTestName()
+	config := fmt.Sprintf(`
+resource "digitalocean_custom_image" "foo" {
+  count   = 2
+  name    = "%s-${count.index}"
+  url     = "https://stable.release.flatcar-linux.net/amd64-usr/2605.7.0/flatcar_production_digitalocean_image.bin.bz2"
+  regions = ["nyc3"]
+}
+
+resource "digitalocean_image_retention_policy" "foobar" {
+  name_regex  = "^%s-"
+  keep_count  = 1
+  image_types = ["custom"]
+  dry_run     = true
+
+  depends_on = [digitalocean_custom_image.foo]
+}
+`, prefix, prefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_image_retention_policy.foobar", "pending_deletion.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_image_retention_policy.foobar", "deleted_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDigitalOceanImageRetentionPolicyDiff(t *testing.T) {
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/images" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		listed++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"images": [
+			{"id": 1, "name": "app-1", "type": "custom", "created_at": "2024-01-01T00:00:00Z"},
+			{"id": 2, "name": "app-2", "type": "custom", "created_at": "2024-02-01T00:00:00Z"}
+		], "meta": {"total": 2}}`)
+	}))
+	defer server.Close()
+
+	meta, err := (&config.Config{Token: "12345", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := image.ResourceDigitalOceanImageRetentionPolicy()
+	conf := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name_regex": "^app-",
+		"keep_count": 1,
+	})
+
+	// The images are listed once and cached for the plans that follow.
+	for i := 0; i < 2; i++ {
+		diff, err := r.Diff(context.Background(), nil, conf, meta)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if actual := diff.Attributes["pending_deletion.0.id"].New; actual != "1" {
+			t.Errorf("Expected image 1 to be pending deletion, got %q", actual)
+		}
+	}
+	if listed != 1 {
+		t.Errorf("Expected the images to be listed once, got %d", listed)
+	}
+
+	// The images pending deletion of an unchanged policy are the ones found
+	// by the last refresh.
+	state := &terraform.InstanceState{
+		ID: "image-retention-123",
+		Attributes: map[string]string{
+			"id":                 "image-retention-123",
+			"name_regex":         "^app-",
+			"keep_count":         "1",
+			"dry_run":            "true",
+			"pending_deletion.#": "0",
+			"deleted_ids.#":      "0",
+		},
+	}
+	conf = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name_regex": "^app-",
+		"keep_count": 1,
+		"dry_run":    true,
+	})
+	meta.InvalidateUserImages()
+
+	diff, err := r.Diff(context.Background(), state, conf, meta)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no diff, got %#v", diff.Attributes)
+	}
+	if listed != 1 {
+		t.Errorf("Expected the images to not be listed for an unchanged policy, got %d lists", listed)
+	}
+}
+
+func TestDigitalOceanImageRetentionPolicyApplyListsImagesAgain(t *testing.T) {
+	images := []string{
+		`{"id": 1, "name": "app-1", "type": "custom", "created_at": "2024-01-01T00:00:00Z"}`,
+		`{"id": 2, "name": "app-2", "type": "custom", "created_at": "2024-02-01T00:00:00Z"}`,
+	}
+	deleted := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/images":
+			w.Header().Set("Content-Type", "application/json")
+			listed := []string{}
+			for i, image := range images {
+				if !deleted[fmt.Sprint(i+1)] {
+					listed = append(listed, image)
+				}
+			}
+			fmt.Fprintf(w, `{"images": [%s], "meta": {"total": %d}}`, strings.Join(listed, ","), len(listed))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v2/images/"):
+			deleted[strings.TrimPrefix(r.URL.Path, "/v2/images/")] = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	meta, err := (&config.Config{Token: "12345", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The images are cached when planning.
+	if _, err := meta.UserImages(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Another resource creates an image before the policy is applied.
+	images = append(images, `{"id": 3, "name": "app-3", "type": "custom", "created_at": "2024-03-01T00:00:00Z"}`)
+
+	r := image.ResourceDigitalOceanImageRetentionPolicy()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name_regex": "^app-",
+		"keep_count": 1,
+	})
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]bool{"1": true, "2": true}
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("Expected all but the newest image to be deleted, got %v", deleted)
+	}
+}
+
+func TestSelectImagesToPrune(t *testing.T) {
+	images := []godo.Image{
+		{ID: 1, Created: "2024-01-01T00:00:00Z"},
+		{ID: 3, Created: "2024-03-01T00:00:00Z"},
+		{ID: 2, Created: "2024-02-01T00:00:00Z"},
+	}
+
+	tests := []struct {
+		keep int
+		want []int
+	}{
+		{keep: 1, want: []int{2, 1}},
+		{keep: 2, want: []int{1}},
+		{keep: 3, want: []int{}},
+		{keep: 5, want: []int{}},
+	}
+
+	for _, tt := range tests {
+		pruned := image.SelectImagesToPrune(append([]godo.Image{}, images...), tt.keep)
+		ids := []int{}
+		for _, i := range pruned {
+			ids = append(ids, i.ID)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("SelectImagesToPrune with keep %d returned %+v, expected %+v", tt.keep, ids, tt.want)
+		}
+	}
+}
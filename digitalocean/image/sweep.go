@@ -34,7 +34,7 @@ func sweepCustomImage(region string) error {
 	}
 
 	for _, i := range images {
-		if strings.HasPrefix(i.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(i.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying image %s", i.Name)
 
 			if _, err := client.Images.Delete(context.Background(), i.ID); err != nil {
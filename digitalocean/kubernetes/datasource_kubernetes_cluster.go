@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -26,8 +28,26 @@ func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"name", "name_prefix", "tag"},
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "prefix of the name of the only cluster to return",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"name", "name_prefix", "tag"},
+			},
+
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "unique tag of the cluster",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"name", "name_prefix", "tag"},
 			},
 
 			"region": {
@@ -123,6 +143,29 @@ func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"issue_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to retrieve a DigitalOcean API token for the cluster and store it in the kube_config and token attributes",
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -139,23 +182,81 @@ func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 func dataSourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	clusters, resp, err := client.Kubernetes.List(context.Background(), &godo.ListOptions{})
+	clusters, err := listKubernetesClusters(ctx, client)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			return diag.Errorf("No clusters found")
+		return diag.Errorf("Error listing Kubernetes clusters: %s", err)
+	}
+
+	var cluster *godo.KubernetesCluster
+	if v, ok := d.GetOk("name"); ok {
+		cluster, err = findKubernetesCluster(clusters, "name "+v.(string), func(c *godo.KubernetesCluster) bool {
+			return c.Name == v.(string)
+		})
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		cluster, err = findKubernetesCluster(clusters, "name prefix "+v.(string), func(c *godo.KubernetesCluster) bool {
+			return strings.HasPrefix(c.Name, v.(string))
+		})
+	} else if v, ok := d.GetOk("tag"); ok {
+		cluster, err = findKubernetesCluster(clusters, "tag "+v.(string), func(c *godo.KubernetesCluster) bool {
+			for _, t := range c.Tags {
+				if t == v.(string) {
+					return true
+				}
+			}
+			return false
+		})
+	} else {
+		return diag.Errorf("Error: specify either a name, name_prefix, or tag to use to look up the cluster")
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("name", cluster.Name)
+
+	return digitaloceanKubernetesClusterRead(ctx, meta.(*config.CombinedConfig), cluster, d)
+}
+
+func listKubernetesClusters(ctx context.Context, client *godo.Client) ([]*godo.KubernetesCluster, error) {
+	var clusters []*godo.KubernetesCluster
+
+	opts := &godo.ListOptions{PerPage: 200}
+	for {
+		page, resp, err := client.Kubernetes.List(ctx, opts)
+		if err != nil {
+			return nil, err
 		}
 
-		return diag.Errorf("Error listing Kubernetes clusters: %s", err)
+		clusters = append(clusters, page...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		current, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opts.Page = current + 1
 	}
 
-	// select the correct cluster
-	for _, c := range clusters {
-		if c.Name == d.Get("name").(string) {
-			d.SetId(c.ID)
+	return clusters, nil
+}
 
-			return digitaloceanKubernetesClusterRead(client, c, d)
+func findKubernetesCluster(clusters []*godo.KubernetesCluster, description string, match func(*godo.KubernetesCluster) bool) (*godo.KubernetesCluster, error) {
+	var results []*godo.KubernetesCluster
+	for _, c := range clusters {
+		if match(c) {
+			results = append(results, c)
 		}
 	}
 
-	return diag.Errorf("Unable to find cluster with name: %s", d.Get("name").(string))
+	if len(results) == 1 {
+		return results[0], nil
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("Unable to find cluster with %s", description)
+	}
+	return nil, fmt.Errorf("too many clusters found with %s (found %d, expected 1)", description, len(results))
 }
@@ -57,6 +57,63 @@ data "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDataSourceDigitalOceanKubernetesCluster_ByNamePrefixAndTag(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foo" {
+  name    = "%s-ephemeral"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+  tags    = ["%s"]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}`, testClusterVersionLatest, rName, rName)
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_kubernetes_cluster" "by_prefix" {
+  name_prefix = "%s"
+
+  depends_on = [digitalocean_kubernetes_cluster.foo]
+}
+
+data "digitalocean_kubernetes_cluster" "by_tag" {
+  tag = "%s"
+
+  depends_on = [digitalocean_kubernetes_cluster.foo]
+}`, rName, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_cluster.by_prefix", "id", "digitalocean_kubernetes_cluster.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.by_prefix", "name", rName+"-ephemeral"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_kubernetes_cluster.by_tag", "id", "digitalocean_kubernetes_cluster.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_cluster.by_tag", "name", rName+"-ephemeral"),
+				),
+			},
+			{
+				Config: resourceConfig + fmt.Sprintf(`
+data "digitalocean_kubernetes_cluster" "by_prefix" {
+  name_prefix = "%s-missing"
+}`, rName),
+				ExpectError: regexp.MustCompile("Unable to find cluster with name prefix"),
+			},
+		},
+	})
+}
+
 func testAccDigitalOceanKubernetesConfigForDataSource(version string, rName string) string {
 	return fmt.Sprintf(`%s
 
@@ -34,7 +34,7 @@ func DataSourceDigitalOceanKubernetesVersions() *schema.Resource {
 func dataSourceDigitalOceanKubernetesVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	k8sOptions, _, err := client.Kubernetes.GetOptions(context.Background())
+	k8sOptions, _, err := client.Kubernetes.GetOptions(ctx)
 	if err != nil {
 		return diag.Errorf("Error retrieving Kubernetes options: %s", err)
 	}
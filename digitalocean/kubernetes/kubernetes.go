@@ -1,9 +1,11 @@
 package kubernetes
 
 import (
+	"context"
 	"strings"
 
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,6 +42,7 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			DiffSuppressFunc: func(key, old, new string, d *schema.ResourceData) bool {
 				nodeCountKey := "node_count"
 				actualNodeCountKey := "actual_node_count"
+				autoScaleKey := "auto_scale"
 
 				// Since this schema is shared between the node pool resource
 				// and as the node pool sub-element of the cluster resource,
@@ -51,6 +54,13 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 					nodeCountKey = strings.Join(nodeCountKeyParts, ".")
 					actualNodeCountKeyParts := append(npKeyParts, "actual_node_count")
 					actualNodeCountKey = strings.Join(actualNodeCountKeyParts, ".")
+					autoScaleKey = strings.Join(append(npKeyParts, "auto_scale"), ".")
+				}
+
+				// The node count of existing autoscaled pools is managed by
+				// the autoscaler, which may scale them down to zero nodes.
+				if d.Id() != "" && d.Get(autoScaleKey).(bool) {
+					return true
 				}
 
 				// If node_count equals actual_node_count already, then
@@ -73,7 +83,7 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 		"min_nodes": {
 			Type:         schema.TypeInt,
 			Optional:     true,
-			ValidateFunc: validation.IntAtLeast(1),
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 
 		"max_nodes": {
@@ -98,6 +108,18 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Optional: true,
 			Elem:     nodePoolTaintSchema(),
 		},
+
+		"price_hourly": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "the estimated hourly price of the node pool, based on max_nodes if auto-scaling is enabled",
+		},
+
+		"price_monthly": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "the estimated monthly price of the node pool, based on max_nodes if auto-scaling is enabled",
+		},
 	}
 
 	if isResource {
@@ -252,7 +274,7 @@ func flattenMaintPolicyOpts(opts *godo.KubernetesMaintenancePolicy) []map[string
 	return result
 }
 
-func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.KubernetesNodePool, parentTags ...string) []interface{} {
+func flattenNodePool(ctx context.Context, meta *config.CombinedConfig, d *schema.ResourceData, keyPrefix string, pool *godo.KubernetesNodePool, parentTags ...string) []interface{} {
 	rawPool := map[string]interface{}{
 		"id":                pool.ID,
 		"name":              pool.Name,
@@ -280,6 +302,11 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 		rawPool["taint"] = flattenNodePoolTaints(pool.Taints)
 	}
 
+	if priceHourly, priceMonthly, ok := nodePoolPrice(ctx, meta, pool); ok {
+		rawPool["price_hourly"] = priceHourly
+		rawPool["price_monthly"] = priceMonthly
+	}
+
 	// Assign a node_count only if it's been set explicitly, since it's
 	// optional and we don't want to update with a 0 if it's not set.
 	if _, ok := d.GetOk(keyPrefix + "node_count"); ok {
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanKubernetesAddon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesAddonCreate,
+		ReadContext:   resourceDigitalOceanKubernetesAddonRead,
+		DeleteContext: resourceDigitalOceanKubernetesAddonDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanKubernetesAddonImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The slug of the Kubernetes 1-Click App, e.g. metrics-server",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	slug := d.Get("slug").(string)
+
+	oneClicks, _, err := client.OneClick.List(ctx, "kubernetes")
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes 1-Click Apps: %s", err)
+	}
+
+	var available []string
+	for _, oc := range oneClicks {
+		available = append(available, oc.Slug)
+	}
+	sort.Strings(available)
+
+	if !containsString(available, slug) {
+		return diag.Errorf("Kubernetes 1-Click App %q not found, available apps are: %s", slug, strings.Join(available, ", "))
+	}
+
+	log.Printf("[INFO] Installing Kubernetes 1-Click App %s on cluster %s", slug, clusterID)
+	resp, _, err := client.OneClick.InstallKubernetes(ctx, &godo.InstallKubernetesAppsRequest{
+		Slugs:       []string{slug},
+		ClusterUUID: clusterID,
+	})
+	if err != nil {
+		return diag.Errorf("Error installing Kubernetes 1-Click App %s: %s", slug, err)
+	}
+	log.Printf("[DEBUG] Kubernetes 1-Click App install response: %s", resp.Message)
+
+	d.SetId(makeKubernetesAddonID(clusterID, slug))
+
+	return resourceDigitalOceanKubernetesAddonRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanKubernetesAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	// The API does not report which 1-Click Apps are installed, so only check
+	// that the cluster still exists.
+	_, resp, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanKubernetesAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	slug := d.Get("slug").(string)
+
+	log.Printf("[INFO] Removing Kubernetes 1-Click App %s from state", d.Id())
+	d.SetId("")
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Kubernetes 1-Click App was not uninstalled",
+			Detail: fmt.Sprintf("1-Click Apps can not be uninstalled using the DigitalOcean API. %s has been removed from the "+
+				"Terraform state but remains installed on the cluster until it is removed, e.g. using helm or kubectl.", slug),
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesAddonImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeKubernetesAddonID(s[0], s[1]))
+		d.Set("cluster_id", s[0])
+		d.Set("slug", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the Kubernetes cluster and the slug of the 1-Click App joined with a comma (e.g. `id,slug`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeKubernetesAddonID(clusterID string, slug string) string {
+	return fmt.Sprintf("%s/addon/%s", clusterID, slug)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
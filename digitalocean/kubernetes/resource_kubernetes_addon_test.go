@@ -0,0 +1,72 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesAddon_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesAddonConfig(rName, "metrics-server"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_addon.foobar", "slug", "metrics-server"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_kubernetes_addon.foobar", "cluster_id",
+						"digitalocean_kubernetes_cluster.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesAddon_InvalidSlug(t *testing.T) {
+	rName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDigitalOceanKubernetesAddonConfig(rName, "not-a-real-app"),
+				ExpectError: regexp.MustCompile(`Kubernetes 1-Click App "not-a-real-app" not found`),
+			},
+		},
+	})
+}
+
+func testAccDigitalOceanKubernetesAddonConfig(rName string, slug string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource "digitalocean_kubernetes_addon" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  slug       = "%s"
+}
+`, testClusterVersionLatest, rName, slug)
+}
@@ -19,6 +19,11 @@ import (
 	yaml "gopkg.in/yaml.v2"
 )
 
+// tokenlessCredentialsExpirySeconds is the lifetime of the token issued when
+// retrieving the CA certificate of clusters with issue_token = false. The token
+// is discarded without being stored.
+const tokenlessCredentialsExpirySeconds = 60
+
 var (
 	MultipleNodePoolImportError = fmt.Errorf("Cluster contains multiple node pools. Manually add the `%s` tag to the pool that should be used as the default. Additional pools must be imported separately as 'digitalocean_kubernetes_node_pool' resources.", DigitaloceanKubernetesDefaultNodePoolTag)
 )
@@ -159,6 +164,29 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"issue_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to retrieve a DigitalOcean API token for the cluster and store it in the kube_config and token attributes",
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -199,6 +227,24 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				}
 				return false
 			}),
+			// The credentials are retrieved again when issue_token changes.
+			customdiff.IfValueChange("issue_token",
+				func(ctx context.Context, old, new, meta interface{}) bool {
+					return old.(bool) != new.(bool)
+				},
+				func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+					if d.Id() == "" {
+						return nil
+					}
+
+					for _, k := range []string{"kube_config", "token"} {
+						if err := d.SetNewComputed(k); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			),
 		),
 	}
 }
@@ -211,8 +257,9 @@ func kubernetesConfigSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"raw_config": {
-					Type:     schema.TypeString,
-					Computed: true,
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
 				},
 
 				"host": {
@@ -226,18 +273,21 @@ func kubernetesConfigSchema() *schema.Schema {
 				},
 
 				"client_key": {
-					Type:     schema.TypeString,
-					Computed: true,
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
 				},
 
 				"client_certificate": {
-					Type:     schema.TypeString,
-					Computed: true,
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
 				},
 
 				"token": {
-					Type:     schema.TypeString,
-					Computed: true,
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
 				},
 
 				"expires_at": {
@@ -295,7 +345,7 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 		opts.AutoUpgrade = autoUpgrade.(bool)
 	}
 
-	cluster, _, err := client.Kubernetes.Create(context.Background(), opts)
+	cluster, _, err := client.Kubernetes.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Kubernetes cluster: %s", err)
 	}
@@ -304,14 +354,26 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 	d.SetId(cluster.ID)
 
 	// wait for completion
-	_, err = waitForKubernetesClusterCreate(client, d)
+	cluster, err = waitForKubernetesClusterCreate(ctx, client, d)
 	if err != nil {
 		d.SetId("")
 		return diag.Errorf("Error creating Kubernetes cluster: %s", err)
 	}
 
+	for _, req := range poolCreateRequests {
+		for _, pool := range cluster.NodePools {
+			if pool.Name != req.Name {
+				continue
+			}
+
+			if _, err := setNodePoolMinNodesZero(ctx, client, cluster.ID, req, pool); err != nil {
+				return diag.Errorf("Error creating Kubernetes cluster: %s", err)
+			}
+		}
+	}
+
 	if d.Get("registry_integration") == true {
-		err = enableRegistryIntegration(client, cluster.ID)
+		err = enableRegistryIntegration(ctx, client, cluster.ID)
 		if err != nil {
 			return diag.Errorf("Error enabling registry integration: %s", err)
 		}
@@ -323,7 +385,7 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	cluster, resp, err := client.Kubernetes.Get(context.Background(), d.Id())
+	cluster, resp, err := client.Kubernetes.Get(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -333,14 +395,16 @@ func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
-	return digitaloceanKubernetesClusterRead(client, cluster, d)
+	return digitaloceanKubernetesClusterRead(ctx, meta.(*config.CombinedConfig), cluster, d)
 }
 
-func digitaloceanKubernetesClusterRead(
-	client *godo.Client,
+func digitaloceanKubernetesClusterRead(ctx context.Context,
+	meta *config.CombinedConfig,
 	cluster *godo.KubernetesCluster,
 	d *schema.ResourceData,
 ) diag.Diagnostics {
+	client := meta.GodoClient()
+
 	d.Set("name", cluster.Name)
 	d.Set("region", cluster.RegionSlug)
 	d.Set("version", cluster.VersionSlug)
@@ -373,7 +437,7 @@ func digitaloceanKubernetesClusterRead(
 				}
 
 				keyPrefix := fmt.Sprintf("node_pool.%d.", i)
-				if err := d.Set("node_pool", flattenNodePool(d, keyPrefix, p, cluster.Tags...)); err != nil {
+				if err := d.Set("node_pool", flattenNodePool(ctx, meta, d, keyPrefix, p, cluster.Tags...)); err != nil {
 					log.Printf("[DEBUG] Error setting node pool attributes: %s %#v", err, cluster.NodePools)
 				}
 
@@ -390,25 +454,56 @@ func digitaloceanKubernetesClusterRead(
 	if d.Get("kube_config") != nil && len(d.Get("kube_config").([]interface{})) > 0 {
 		creds = d.Get("kube_config").([]interface{})[0].(map[string]interface{})
 	}
-	var expiresAt time.Time
-	if creds["expires_at"] != nil && creds["expires_at"].(string) != "" {
-		var err error
-		expiresAt, err = time.Parse(time.RFC3339, creds["expires_at"].(string))
-		if err != nil {
-			return diag.Errorf("Unable to parse Kubernetes credentials expiry: %s", err)
+
+	if kubernetesIssueTokenDisabled(d) {
+		// Without tokens, the credentials are only needed for the CA
+		// certificate of the cluster, so they are retrieved once.
+		if creds["cluster_ca_certificate"] == nil || creds["cluster_ca_certificate"].(string) == "" ||
+			creds["token"] != nil && creds["token"].(string) != "" {
+			creds, _, err := client.Kubernetes.GetCredentials(ctx, cluster.ID, &godo.KubernetesClusterCredentialsGetRequest{
+				ExpirySeconds: godo.PtrTo(tokenlessCredentialsExpirySeconds),
+			})
+			if err != nil {
+				return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
+			}
+			d.Set("kube_config", flattenTokenlessCredentials(cluster.Name, cluster.RegionSlug, creds))
 		}
-	}
-	if expiresAt.IsZero() || expiresAt.Before(time.Now()) {
-		creds, _, err := client.Kubernetes.GetCredentials(context.Background(), cluster.ID, &godo.KubernetesClusterCredentialsGetRequest{})
-		if err != nil {
-			return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
+	} else {
+		var expiresAt time.Time
+		if creds["expires_at"] != nil && creds["expires_at"].(string) != "" {
+			var err error
+			expiresAt, err = time.Parse(time.RFC3339, creds["expires_at"].(string))
+			if err != nil {
+				return diag.Errorf("Unable to parse Kubernetes credentials expiry: %s", err)
+			}
 		}
-		d.Set("kube_config", flattenCredentials(cluster.Name, cluster.RegionSlug, creds))
+		if expiresAt.IsZero() || expiresAt.Before(time.Now()) {
+			creds, _, err := client.Kubernetes.GetCredentials(ctx, cluster.ID, &godo.KubernetesClusterCredentialsGetRequest{})
+			if err != nil {
+				return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
+			}
+			d.Set("kube_config", flattenCredentials(cluster.Name, cluster.RegionSlug, creds))
+		}
+	}
+
+	creds = nil
+	if kubeConfig, ok := d.Get("kube_config").([]interface{}); ok && len(kubeConfig) > 0 && kubeConfig[0] != nil {
+		creds = kubeConfig[0].(map[string]interface{})
 	}
+	d.Set("host", creds["host"])
+	d.Set("cluster_ca_certificate", creds["cluster_ca_certificate"])
+	d.Set("token", creds["token"])
 
 	return nil
 }
 
+// kubernetesIssueTokenDisabled reports whether issue_token is explicitly set
+// to false. It is treated as true in state written before it was added.
+func kubernetesIssueTokenDisabled(d *schema.ResourceData) bool {
+	v, ok := d.GetOkExists("issue_token")
+	return ok && !v.(bool)
+}
+
 func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -431,7 +526,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 			opts.MaintenancePolicy = maintPolicy
 		}
 
-		_, resp, err := client.Kubernetes.Update(context.Background(), d.Id(), opts)
+		_, resp, err := client.Kubernetes.Update(ctx, d.Id(), opts)
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				d.SetId("")
@@ -455,7 +550,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 
 		// update the existing default pool
 		timeout := d.Timeout(schema.TimeoutCreate)
-		_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
+		_, err := digitaloceanKubernetesNodePoolUpdate(ctx, client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -466,7 +561,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 			VersionSlug: d.Get("version").(string),
 		}
 
-		_, err := client.Kubernetes.Upgrade(context.Background(), d.Id(), opts)
+		_, err := client.Kubernetes.Upgrade(ctx, d.Id(), opts)
 		if err != nil {
 			return diag.Errorf("Unable to upgrade cluster version: %s", err)
 		}
@@ -474,12 +569,12 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 
 	if d.HasChanges("registry_integration") {
 		if d.Get("registry_integration") == true {
-			err := enableRegistryIntegration(client, d.Id())
+			err := enableRegistryIntegration(ctx, client, d.Id())
 			if err != nil {
 				return diag.Errorf("Error enabling registry integration: %s", err)
 			}
 		} else {
-			err := disableRegistryIntegration(client, d.Id())
+			err := disableRegistryIntegration(ctx, client, d.Id())
 			if err != nil {
 				return diag.Errorf("Error disabling registry integration: %s", err)
 			}
@@ -588,17 +683,17 @@ func resourceDigitalOceanKubernetesClusterImportState(ctx context.Context, d *sc
 	return []*schema.ResourceData{d}, nil
 }
 
-func enableRegistryIntegration(client *godo.Client, clusterUUID string) error {
-	_, err := client.Kubernetes.AddRegistry(context.Background(), &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
+func enableRegistryIntegration(ctx context.Context, client *godo.Client, clusterUUID string) error {
+	_, err := client.Kubernetes.AddRegistry(ctx, &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
 	return err
 }
 
-func disableRegistryIntegration(client *godo.Client, clusterUUID string) error {
-	_, err := client.Kubernetes.RemoveRegistry(context.Background(), &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
+func disableRegistryIntegration(ctx context.Context, client *godo.Client, clusterUUID string) error {
+	_, err := client.Kubernetes.RemoveRegistry(ctx, &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{clusterUUID}})
 	return err
 }
 
-func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
+func waitForKubernetesClusterCreate(ctx context.Context, client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
 	var (
 		tickerInterval = 10 * time.Second
 		timeoutSeconds = d.Timeout(schema.TimeoutCreate).Seconds()
@@ -608,7 +703,7 @@ func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData)
 	)
 
 	for range ticker.C {
-		cluster, _, err := client.Kubernetes.Get(context.Background(), d.Id())
+		cluster, _, err := client.Kubernetes.Get(ctx, d.Id())
 		if err != nil {
 			ticker.Stop()
 			return nil, fmt.Errorf("Error trying to read cluster state: %s", err)
@@ -670,7 +765,7 @@ type kubernetesConfigUser struct {
 type kubernetesConfigUserData struct {
 	ClientKeyData         string `yaml:"client-key-data,omitempty"`
 	ClientCertificateData string `yaml:"client-certificate-data,omitempty"`
-	Token                 string `yaml:"token"`
+	Token                 string `yaml:"token,omitempty"`
 }
 
 func flattenCredentials(name string, region string, creds *godo.KubernetesClusterCredentials) []interface{} {
@@ -682,7 +777,11 @@ func flattenCredentials(name string, region string, creds *godo.KubernetesCluste
 		"cluster_ca_certificate": base64.StdEncoding.EncodeToString(creds.CertificateAuthorityData),
 		"host":                   creds.Server,
 		"token":                  creds.Token,
-		"expires_at":             creds.ExpiresAt.Format(time.RFC3339),
+		"expires_at":             "",
+	}
+
+	if !creds.ExpiresAt.IsZero() {
+		raw["expires_at"] = creds.ExpiresAt.Format(time.RFC3339)
 	}
 
 	if creds.ClientKeyData != nil {
@@ -703,6 +802,20 @@ func flattenCredentials(name string, region string, creds *godo.KubernetesCluste
 	return []interface{}{raw}
 }
 
+// flattenTokenlessCredentials flattens the credentials of the cluster without
+// its token and client certificate, e.g. for clusters authenticating users
+// through OIDC. The rendered kubeconfig has no user credentials.
+func flattenTokenlessCredentials(name string, region string, creds *godo.KubernetesClusterCredentials) []interface{} {
+	if creds == nil {
+		return nil
+	}
+
+	return flattenCredentials(name, region, &godo.KubernetesClusterCredentials{
+		Server:                   creds.Server,
+		CertificateAuthorityData: creds.CertificateAuthorityData,
+	})
+}
+
 func RenderKubeconfig(name string, region string, creds *godo.KubernetesClusterCredentials) ([]byte, error) {
 	clusterName := fmt.Sprintf("do-%s-%s", region, name)
 	userName := fmt.Sprintf("do-%s-%s-admin", region, name)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -130,6 +131,47 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_IssueToken(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigIssueToken(testClusterVersionLatest, rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "issue_token", "false"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "host"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "cluster_ca_certificate"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "token", ""),
+					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "host", "digitalocean_kubernetes_cluster.foobar", "kube_config.0.host"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "kube_config.0.token", ""),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "kube_config.0.expires_at", ""),
+					resource.TestCheckResourceAttrWith("digitalocean_kubernetes_cluster.foobar", "kube_config.0.raw_config", func(value string) error {
+						if strings.Contains(value, "token:") {
+							return fmt.Errorf("expected kubeconfig without a token, got: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigIssueToken(testClusterVersionLatest, rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "token"),
+					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_cluster.foobar", "token", "digitalocean_kubernetes_cluster.foobar", "kube_config.0.token"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "kube_config.0.expires_at"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_CreateWithHAControlPlane(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -787,6 +829,24 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 `, testClusterVersion, rName)
 }
 
+func testAccDigitalOceanKubernetesConfigIssueToken(testClusterVersion string, rName string, issueToken bool) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name        = "%s"
+  region      = "nyc1"
+  version     = data.digitalocean_kubernetes_versions.test.latest_version
+  issue_token = %t
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName, issueToken)
+}
+
 func testAccDigitalOceanKubernetesConfigMaintenancePolicy(testClusterVersion string, rName string, policy string) string {
 	return fmt.Sprintf(`%s
 
@@ -1066,4 +1126,18 @@ users:
 	if !reflect.DeepEqual(got, expected) {
 		t.Errorf("renderKubeconfig returned %+v\n, expected %+v\n", got, expected)
 	}
+
+	// Without a token, the kubeconfig has no user credentials.
+	tokenless, err := kubernetes.RenderKubeconfig("test-cluster", "lon1", &godo.KubernetesClusterCredentials{
+		Server:                   creds.Server,
+		CertificateAuthorityData: certAuth,
+	})
+	if err != nil {
+		t.Errorf("error calling renderKubeconfig: %s", err)
+	}
+
+	expectedTokenless := strings.Replace(expected, "  user:\n    token: 97ae2bbcfd85c34155a56b822ffa73909d6770b28eb7e5dfa78fa83e02ffc60f\n", "  user: {}\n", 1)
+	if string(tokenless) != expectedTokenless {
+		t.Errorf("renderKubeconfig returned %+v\n, expected %+v\n", string(tokenless), expectedTokenless)
+	}
 }
@@ -3,11 +3,13 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +37,33 @@ func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			// Resolve the price of the node pool so that it is known in the
+			// plan, e.g. for policies enforcing a budget.
+			keys := []string{"size", "node_count", "auto_scale", "max_nodes"}
+			if diff.Id() != "" && !diff.HasChanges(keys...) {
+				return nil
+			}
+
+			for _, key := range keys {
+				if !diff.NewValueKnown(key) {
+					return nil
+				}
+			}
+
+			s, err := size.FindSize(ctx, meta.(*config.CombinedConfig), diff.Get("size").(string))
+			if err != nil {
+				log.Printf("[WARN] Unable to resolve the price of the node pool size: %s", err)
+				return nil
+			}
+
+			count := nodePoolPriceCount(diff.Get("auto_scale").(bool), diff.Get("node_count").(int), diff.Get("max_nodes").(int))
+			if err := diff.SetNew("price_hourly", s.PriceHourly*float64(count)); err != nil {
+				return err
+			}
+			return diff.SetNew("price_monthly", s.PriceMonthly*float64(count))
+		},
 	}
 }
 
@@ -54,7 +83,7 @@ func resourceDigitalOceanKubernetesNodePoolCreate(ctx context.Context, d *schema
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	pool, err := digitaloceanKubernetesNodePoolCreate(client, timeout, rawPool, d.Get("cluster_id").(string))
+	pool, err := digitaloceanKubernetesNodePoolCreate(ctx, client, timeout, rawPool, d.Get("cluster_id").(string))
 	if err != nil {
 		return diag.Errorf("Error creating Kubernetes node pool: %s", err)
 	}
@@ -67,7 +96,7 @@ func resourceDigitalOceanKubernetesNodePoolCreate(ctx context.Context, d *schema
 func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	pool, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+	pool, resp, err := client.Kubernetes.GetNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -89,10 +118,9 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 	d.Set("nodes", flattenNodes(pool.Nodes))
 	d.Set("taint", flattenNodePoolTaints(pool.Taints))
 
-	// Assign a node_count only if it's been set explicitly, since it's
-	// optional and we don't want to update with a 0 if it's not set.
-	if _, ok := d.GetOk("node_count"); ok {
-		d.Set("node_count", pool.Count)
+	if priceHourly, priceMonthly, ok := nodePoolPrice(ctx, meta.(*config.CombinedConfig), pool); ok {
+		d.Set("price_hourly", priceHourly)
+		d.Set("price_monthly", priceMonthly)
 	}
 
 	return nil
@@ -119,7 +147,7 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 	rawPool["taint"] = newTaint
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, rawPool, d.Get("cluster_id").(string), d.Id())
+	_, err := digitaloceanKubernetesNodePoolUpdate(ctx, client, timeout, rawPool, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("Error updating node pool: %s", err)
 	}
@@ -129,12 +157,12 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 
 func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
-	_, err := client.Kubernetes.DeleteNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+	_, err := client.Kubernetes.DeleteNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("Unable to delete node pool %s", err)
 	}
 
-	err = waitForKubernetesNodePoolDelete(client, d)
+	err = waitForKubernetesNodePoolDelete(ctx, client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -155,7 +183,10 @@ func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, m
 	// Scan all of the Kubernetes clusters to recover the node pool's cluster ID.
 	var clusterId string
 	var nodePool *godo.KubernetesNodePool
-	listOptions := godo.ListOptions{}
+	listOptions := godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
 	for {
 		clusters, response, err := client.Kubernetes.List(context.Background(), &listOptions)
 		if err != nil {
@@ -204,7 +235,30 @@ func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, m
 	return []*schema.ResourceData{d}, nil
 }
 
-func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID string, customTags ...string) (*godo.KubernetesNodePool, error) {
+// nodePoolPrice returns the estimated hourly and monthly prices of the node
+// pool, or false if the price of its size can not be resolved.
+func nodePoolPrice(ctx context.Context, meta *config.CombinedConfig, pool *godo.KubernetesNodePool) (float64, float64, bool) {
+	s, err := size.FindSize(ctx, meta, pool.Size)
+	if err != nil {
+		log.Printf("[WARN] Unable to resolve the price of the node pool size: %s", err)
+		return 0, 0, false
+	}
+
+	count := float64(nodePoolPriceCount(pool.AutoScale, pool.Count, pool.MaxNodes))
+	return s.PriceHourly * count, s.PriceMonthly * count, true
+}
+
+// nodePoolPriceCount returns the number of nodes used to estimate the price of
+// a node pool, which is the maximum number of nodes if auto-scaling is enabled.
+func nodePoolPriceCount(autoScale bool, nodeCount int, maxNodes int) int {
+	if autoScale {
+		return maxNodes
+	}
+
+	return nodeCount
+}
+
+func digitaloceanKubernetesNodePoolCreate(ctx context.Context, client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID string, customTags ...string) (*godo.KubernetesNodePool, error) {
 	// append any custom tags
 	tags := tag.ExpandTags(pool["tags"].(*schema.Set).List())
 	tags = append(tags, customTags...)
@@ -221,13 +275,18 @@ func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Dura
 		Taints:    expandNodePoolTaints(pool["taint"].(*schema.Set).List()),
 	}
 
-	p, _, err := client.Kubernetes.CreateNodePool(context.Background(), clusterID, req)
+	p, _, err := client.Kubernetes.CreateNodePool(ctx, clusterID, req)
 
 	if err != nil {
 		return nil, fmt.Errorf("Unable to create new default node pool %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
+	p, err = setNodePoolMinNodesZero(ctx, client, clusterID, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	err = waitForKubernetesNodePoolCreate(ctx, client, timeout, clusterID, p.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +294,31 @@ func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Dura
 	return p, nil
 }
 
-func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID, poolID string, customTags ...string) (*godo.KubernetesNodePool, error) {
+// setNodePoolMinNodesZero sets the min_nodes of the created node pool to zero
+// if it was requested. A min_nodes of zero is dropped from the create request
+// as it is the zero value, so it is explicitly set afterwards to allow the
+// pool to scale to zero.
+func setNodePoolMinNodesZero(ctx context.Context, client *godo.Client, clusterID string, req *godo.KubernetesNodePoolCreateRequest, pool *godo.KubernetesNodePool) (*godo.KubernetesNodePool, error) {
+	if !req.AutoScale || req.MinNodes != 0 || pool.MinNodes == 0 {
+		return pool, nil
+	}
+
+	pool, _, err := client.Kubernetes.UpdateNodePool(ctx, clusterID, pool.ID, &godo.KubernetesNodePoolUpdateRequest{
+		Name:      pool.Name,
+		Tags:      req.Tags,
+		Labels:    req.Labels,
+		AutoScale: godo.PtrTo(true),
+		MinNodes:  godo.PtrTo(0),
+		MaxNodes:  godo.PtrTo(req.MaxNodes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to set min_nodes of node pool to 0: %s", err)
+	}
+
+	return pool, nil
+}
+
+func digitaloceanKubernetesNodePoolUpdate(ctx context.Context, client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID, poolID string, customTags ...string) (*godo.KubernetesNodePool, error) {
 	tags := tag.ExpandTags(pool["tags"].(*schema.Set).List())
 	tags = append(tags, customTags...)
 
@@ -270,7 +353,7 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 		req.Taints = &t
 	}
 
-	p, resp, err := client.Kubernetes.UpdateNodePool(context.Background(), clusterID, poolID, req)
+	p, resp, err := client.Kubernetes.UpdateNodePool(ctx, clusterID, poolID, req)
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -280,7 +363,7 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 		return nil, fmt.Errorf("Unable to update nodepool: %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
+	err = waitForKubernetesNodePoolCreate(ctx, client, timeout, clusterID, p.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +371,7 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 	return p, nil
 }
 
-func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration, id string, poolID string) error {
+func waitForKubernetesNodePoolCreate(ctx context.Context, client *godo.Client, duration time.Duration, id string, poolID string) error {
 	var (
 		tickerInterval = 10 * time.Second
 		timeoutSeconds = duration.Seconds()
@@ -298,7 +381,7 @@ func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration
 
 	ticker := time.NewTicker(tickerInterval)
 	for range ticker.C {
-		pool, _, err := client.Kubernetes.GetNodePool(context.Background(), id, poolID)
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, id, poolID)
 		if err != nil {
 			ticker.Stop()
 			return fmt.Errorf("Error trying to read nodepool state: %s", err)
@@ -327,7 +410,7 @@ func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration
 	return fmt.Errorf("Timeout waiting to create nodepool")
 }
 
-func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData) error {
+func waitForKubernetesNodePoolDelete(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
 	var (
 		tickerInterval = 10 * time.Second
 		timeoutSeconds = d.Timeout(schema.TimeoutDelete).Seconds()
@@ -337,7 +420,7 @@ func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData
 	)
 
 	for range ticker.C {
-		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+		_, resp, err := client.Kubernetes.GetNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 		if err != nil {
 			ticker.Stop()
 
@@ -3,15 +3,75 @@ package kubernetes_test
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDigitalOceanKubernetesNodePoolReadScaledToZero(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v2/kubernetes/clusters/cluster-id/node_pools/pool-id":
+			fmt.Fprint(w, `{"node_pool": {"id": "pool-id", "name": "pool", "size": "s-1vcpu-2gb", "count": 0, "auto_scale": true, "min_nodes": 0, "max_nodes": 3, "nodes": []}}`)
+		case "/v2/sizes":
+			fmt.Fprint(w, `{"sizes": [{"slug": "s-1vcpu-2gb", "price_monthly": 12, "price_hourly": 0.01786}], "meta": {"total": 1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	meta, err := (&config.Config{Token: "12345", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"cluster_id": "cluster-id",
+		"name":       "pool",
+		"size":       "s-1vcpu-2gb",
+		"node_count": 1,
+		"auto_scale": true,
+		"min_nodes":  0,
+		"max_nodes":  3,
+	}
+
+	r := kubernetes.ResourceDigitalOceanKubernetesNodePool()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("pool-id")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if actual := d.Get("actual_node_count").(int); actual != 0 {
+		t.Errorf("Expected actual_node_count to be 0, got %d", actual)
+	}
+
+	// The autoscaler scaled the pool down to zero nodes, which is not a
+	// difference from the configured node_count.
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no diff, got %#v", diff.Attributes)
+	}
+}
+
 func TestAccDigitalOceanKubernetesNodePool_Basic(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -71,6 +131,8 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.#", "0"),
+					testAccCheckDigitalOceanKubernetesNodePoolPrice("digitalocean_kubernetes_node_pool.barfoo", ""),
+					testAccCheckDigitalOceanKubernetesNodePoolPrice("digitalocean_kubernetes_cluster.foobar", "node_pool.0."),
 				),
 			},
 			// Update: add taint
@@ -452,6 +514,53 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesNodePool_ScaleToZero(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource digitalocean_kubernetes_node_pool "barfoo" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  name       = "%s"
+  size       = "s-1vcpu-2gb"
+  node_count = 1
+  auto_scale = true
+  min_nodes  = 0
+  max_nodes  = 3
+}
+				`, testClusterVersionLatest, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "auto_scale", "true"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "min_nodes", "0"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "max_nodes", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDigitalOceanKubernetesConfigBasicWithNodePool(rName string) string {
 	return fmt.Sprintf(`%s
 
@@ -617,3 +726,36 @@ func testAccCheckDigitalOceanKubernetesNodePoolExists(n string, cluster *godo.Ku
 		return nil
 	}
 }
+
+// testAccCheckDigitalOceanKubernetesNodePoolPrice checks the monthly price of
+// the node pool matches the price of its size for its node count.
+func testAccCheckDigitalOceanKubernetesNodePoolPrice(n string, prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		nodeSize, err := size.FindSize(context.Background(), acceptance.TestAccProvider.Meta().(*config.CombinedConfig), attrs[prefix+"size"])
+		if err != nil {
+			return err
+		}
+
+		count, err := strconv.Atoi(attrs[prefix+"node_count"])
+		if err != nil {
+			return fmt.Errorf("Invalid %snode_count: %s", prefix, err)
+		}
+
+		price, err := strconv.ParseFloat(attrs[prefix+"price_monthly"], 64)
+		if err != nil {
+			return fmt.Errorf("Invalid %sprice_monthly: %s", prefix, err)
+		}
+
+		if expected := nodeSize.PriceMonthly * float64(count); math.Abs(price-expected) > 0.001 {
+			return fmt.Errorf("Expected %sprice_monthly to be %v, got %v", prefix, expected, price)
+		}
+
+		return nil
+	}
+}
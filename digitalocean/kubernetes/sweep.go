@@ -35,7 +35,7 @@ func sweepKubernetesClusters(region string) error {
 	log.Printf("[DEBUG] Found %d Kubernetes clusters to sweep", len(clusters))
 
 	for _, c := range clusters {
-		if strings.HasPrefix(c.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(c.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying Kubernetes cluster %s", c.Name)
 			if _, err := client.Kubernetes.Delete(context.Background(), c.ID); err != nil {
 				return err
@@ -361,7 +361,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 	var foundLoadbalancer *godo.LoadBalancer
 
 	if id, ok := d.GetOk("id"); ok {
-		loadbalancer, _, err := client.LoadBalancers.Get(context.Background(), id.(string))
+		loadbalancer, _, err := client.LoadBalancers.Get(ctx, id.(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -376,7 +376,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 		lbList := []godo.LoadBalancer{}
 
 		for {
-			lbs, resp, err := client.LoadBalancers.List(context.Background(), opts)
+			lbs, resp, err := client.LoadBalancers.List(ctx, opts)
 
 			if err != nil {
 				return diag.Errorf("Error retrieving load balancers: %s", err)
@@ -444,7 +444,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 		return diag.Errorf("[DEBUG] Error setting Load Balancer healthcheck - error: %#v", err)
 	}
 
-	forwardingRules, err := flattenForwardingRules(client, foundLoadbalancer.ForwardingRules)
+	forwardingRules, err := flattenForwardingRules(ctx, client, foundLoadbalancer.ForwardingRules)
 	if err != nil {
 		return diag.Errorf("[DEBUG] Error building Load Balancer forwarding rules - error: %#v", err)
 	}
@@ -457,7 +457,7 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 		return diag.Errorf("[DEBUG] Error setting Load Balancer firewall - error: %#v", err)
 	}
 
-	domains, err := flattenDomains(client, foundLoadbalancer.Domains)
+	domains, err := flattenDomains(ctx, client, foundLoadbalancer.Domains)
 	if err != nil {
 		return diag.Errorf("[DEBUG] Error building Load Balancer domains - error: %#v", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
@@ -14,17 +15,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func loadbalancerStateRefreshFunc(client *godo.Client, loadbalancerId string) resource.StateRefreshFunc {
+// The values assumed by the API for the settings which are not set when a
+// Load Balancer is created, which are also the defaults of the schema.
+const (
+	defaultAlgorithm              = "round_robin"
+	defaultStickySessionsType     = "none"
+	defaultCheckIntervalSeconds   = 10
+	defaultResponseTimeoutSeconds = 5
+	defaultUnhealthyThreshold     = 3
+	defaultHealthyThreshold       = 5
+)
+
+// loadBalancerPendingIP is the state reported by loadbalancerStateRefreshFunc
+// for regional Load Balancers which are active but have not been assigned an
+// IP address yet.
+const loadBalancerPendingIP = "pending_ip"
+
+func loadbalancerStateRefreshFunc(ctx context.Context, client *godo.Client, loadbalancerId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		lb, _, err := client.LoadBalancers.Get(context.Background(), loadbalancerId)
+		lb, _, err := client.LoadBalancers.Get(ctx, loadbalancerId)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in LoadbalancerStateRefreshFunc to DigitalOcean for Load Balancer '%s': %s", loadbalancerId, err)
 		}
 
+		if lb.Status == "errored" {
+			return lb, lb.Status, fmt.Errorf("Load Balancer '%s' failed to provision and is in the errored state", loadbalancerId)
+		}
+
+		// Records pointing to the Load Balancer need its IP address, which
+		// may be assigned after it becomes active.
+		if lb.Status == "active" && lb.IP == "" && !strings.EqualFold(lb.Type, "GLOBAL") {
+			return lb, loadBalancerPendingIP, nil
+		}
+
 		return lb, lb.Status, nil
 	}
 }
 
+// waitForLoadBalancerActive waits for the Load Balancer to be active and, for
+// regional Load Balancers, to have an IP address.
+func waitForLoadBalancerActive(ctx context.Context, client *godo.Client, d *schema.ResourceData, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become active", d.Get("name"))
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", loadBalancerPendingIP},
+		Target:     []string{"active"},
+		Refresh:    loadbalancerStateRefreshFunc(ctx, client, d.Id()),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for Load Balancer (%s) to become active: %s", d.Get("name"), err)
+	}
+
+	return nil
+}
+
 func expandStickySessions(config []interface{}) *godo.StickySessions {
 	stickysessionConfig := config[0].(map[string]interface{})
 
@@ -86,7 +131,7 @@ func expandHealthCheck(config []interface{}) *godo.HealthCheck {
 	return healthcheck
 }
 
-func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.ForwardingRule, error) {
+func expandForwardingRules(ctx context.Context, client *godo.Client, config []interface{}, targetPorts map[string]int) ([]godo.ForwardingRule, error) {
 	forwardingRules := make([]godo.ForwardingRule, 0, len(config))
 
 	for _, rawRule := range config {
@@ -100,10 +145,14 @@ func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.Fo
 			TlsPassthrough: rule["tls_passthrough"].(bool),
 		}
 
+		if name, _ := rule["target_port_name"].(string); name != "" {
+			r.TargetPort = targetPorts[name]
+		}
+
 		if name, nameOk := rule["certificate_name"]; nameOk {
 			certName := name.(string)
 			if certName != "" {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := certificate.FindCertificateByName(ctx, client, certName)
 				if err != nil {
 					return nil, err
 				}
@@ -118,11 +167,11 @@ func expandForwardingRules(client *godo.Client, config []interface{}) ([]godo.Fo
 			// certificate name as the primary identifier instead.
 			certName := id.(string)
 			if certName != "" {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := certificate.FindCertificateByName(ctx, client, certName)
 				if err != nil {
 					if strings.Contains(err.Error(), "not found") {
 						log.Println("[DEBUG] Certificate not found looking up by name. Falling back to lookup by ID.")
-						cert, _, err = client.Certificates.Get(context.Background(), certName)
+						cert, _, err = client.Certificates.Get(ctx, certName)
 						if err != nil {
 							return nil, err
 						}
@@ -148,7 +197,14 @@ func hashForwardingRules(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%d-", m["entry_port"].(int)))
 	buf.WriteString(fmt.Sprintf("%s-",
 		strings.ToLower(m["entry_protocol"].(string))))
-	buf.WriteString(fmt.Sprintf("%d-", m["target_port"].(int)))
+	// Rules using a named target port are identified by the name, so that
+	// they are updated in place when the port it resolves to changes.
+	if name, _ := m["target_port_name"].(string); name != "" {
+		buf.WriteString(fmt.Sprintf("name:%s-", name))
+	} else {
+		target, _ := m["target_port"].(int)
+		buf.WriteString(fmt.Sprintf("%d-", target))
+	}
 	buf.WriteString(fmt.Sprintf("%s-",
 		strings.ToLower(m["target_protocol"].(string))))
 
@@ -169,6 +225,48 @@ func hashForwardingRules(v interface{}) int {
 	return util.SDKHashString(buf.String())
 }
 
+// normalizeLoadBalancer fills in the settings that the API leaves empty with
+// the values it assumes for them, so that the state of an imported Load
+// Balancer matches a configuration relying on the schema defaults.
+func normalizeLoadBalancer(lb *godo.LoadBalancer) {
+	if lb.Algorithm == "" {
+		lb.Algorithm = defaultAlgorithm
+	}
+
+	if lb.StickySessions == nil {
+		lb.StickySessions = &godo.StickySessions{}
+	}
+	if lb.StickySessions.Type == "" {
+		lb.StickySessions.Type = defaultStickySessionsType
+	}
+
+	if hc := lb.HealthCheck; hc != nil {
+		if hc.CheckIntervalSeconds == 0 {
+			hc.CheckIntervalSeconds = defaultCheckIntervalSeconds
+		}
+		if hc.ResponseTimeoutSeconds == 0 {
+			hc.ResponseTimeoutSeconds = defaultResponseTimeoutSeconds
+		}
+		if hc.UnhealthyThreshold == 0 {
+			hc.UnhealthyThreshold = defaultUnhealthyThreshold
+		}
+		if hc.HealthyThreshold == 0 {
+			hc.HealthyThreshold = defaultHealthyThreshold
+		}
+	}
+}
+
+// suppressLoadBalancerDefault suppresses the diff between an empty value,
+// e.g. in the state of a Load Balancer imported before it was normalized, and
+// the default value the API assumes for it.
+func suppressLoadBalancerDefault(defaultValue string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		isEmpty := func(v string) bool { return v == "" || v == "0" }
+
+		return (isEmpty(old) && new == defaultValue) || (old == defaultValue && isEmpty(new))
+	}
+}
+
 func flattenDropletIds(list []int) *schema.Set {
 	flatSet := schema.NewSet(schema.HashInt, []interface{}{})
 	for _, v := range list {
@@ -227,7 +325,7 @@ func flattenLBFirewall(firewall *godo.LBFirewall) []map[string]interface{} {
 	return result
 }
 
-func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([]map[string]interface{}, error) {
+func flattenForwardingRules(ctx context.Context, client *godo.Client, rules []godo.ForwardingRule) ([]map[string]interface{}, error) {
 	result := make([]map[string]interface{}, 0, 1)
 
 	for _, rule := range rules {
@@ -243,7 +341,7 @@ func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([
 			// When the certificate type is lets_encrypt, the certificate
 			// ID will change when it's renewed, so we have to rely on the
 			// certificate name as the primary identifier instead.
-			cert, _, err := client.Certificates.Get(context.Background(), rule.CertificateID)
+			cert, _, err := client.Certificates.Get(ctx, rule.CertificateID)
 			if err != nil {
 				return nil, err
 			}
@@ -257,7 +355,7 @@ func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([
 	return result, nil
 }
 
-func expandDomains(client *godo.Client, config []interface{}) ([]*godo.LBDomain, error) {
+func expandDomains(ctx context.Context, client *godo.Client, config []interface{}) ([]*godo.LBDomain, error) {
 	domains := make([]*godo.LBDomain, 0, len(config))
 
 	for _, rawDomain := range config {
@@ -271,7 +369,7 @@ func expandDomains(client *godo.Client, config []interface{}) ([]*godo.LBDomain,
 		if v, ok := domain["certificate_name"]; ok {
 			certName := v.(string)
 			if certName != "" {
-				cert, err := certificate.FindCertificateByName(client, certName)
+				cert, err := certificate.FindCertificateByName(ctx, client, certName)
 				if err != nil {
 					return nil, err
 				}
@@ -313,7 +411,7 @@ func expandGLBSettings(config []interface{}) *godo.GLBSettings {
 	return glbSettings
 }
 
-func flattenDomains(client *godo.Client, domains []*godo.LBDomain) ([]map[string]interface{}, error) {
+func flattenDomains(ctx context.Context, client *godo.Client, domains []*godo.LBDomain) ([]map[string]interface{}, error) {
 	if len(domains) == 0 {
 		return nil, nil
 	}
@@ -332,7 +430,7 @@ func flattenDomains(client *godo.Client, domains []*godo.LBDomain) ([]map[string
 			// When the certificate type is lets_encrypt, the certificate
 			// ID will change when it's renewed, so we have to rely on the
 			// certificate name as the primary identifier instead.
-			cert, _, err := client.Certificates.Get(context.Background(), domain.CertificateID)
+			cert, _, err := client.Certificates.Get(ctx, domain.CertificateID)
 			if err != nil {
 				return nil, err
 			}
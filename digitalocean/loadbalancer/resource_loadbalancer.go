@@ -5,15 +5,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -39,6 +40,11 @@ func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 
 		Schema: resourceDigitalOceanLoadBalancerV1(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			if _, hasHealthCheck := diff.GetOk("healthcheck"); hasHealthCheck {
@@ -86,7 +92,22 @@ func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 				return err
 			}
 
-			return nil
+			// The health check is computed from the API when not configured, so
+			// only validate it against UDP forwarding rules when it is set
+			// explicitly.
+			var healthCheck []interface{}
+			if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() && rawConfig.IsKnown() {
+				if hc := rawConfig.GetAttr("healthcheck"); hc.IsKnown() && !hc.IsNull() && hc.LengthInt() > 0 {
+					healthCheck = diff.Get("healthcheck").([]interface{})
+				}
+			}
+			if rules, ok := diff.Get("forwarding_rule").(*schema.Set); ok {
+				if err := ValidateUDPForwardingRules(rules.List(), healthCheck); err != nil {
+					return err
+				}
+			}
+
+			return targetPortsDiff(ctx, diff, v)
 		},
 	}
 }
@@ -109,11 +130,34 @@ func resourceDigitalOceanLoadBalancerV1() map[string]*schema.Schema {
 	}
 	forwardingRuleSchema["certificate_id"].Computed = true
 	forwardingRuleSchema["certificate_id"].Deprecated = "Certificate IDs may change, for example when a Let's Encrypt certificate is auto-renewed. Please specify 'certificate_name' instead."
+	forwardingRuleSchema["target_port"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 65535),
+	}
+	forwardingRuleSchema["target_port_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(targetPortNameRe, "must only contain letters, digits, underscores, and dashes"),
+		Description:  "the name of the port to forward traffic to, resolved from target_ports or the port:<name>:<port> tags of the Droplets",
+	}
 
 	for k, v := range loadBalancerV0Schema {
 		loadBalancerV1Schema[k] = v
 	}
 	loadBalancerV1Schema["forwarding_rule"].Elem.(*schema.Resource).Schema = forwardingRuleSchema
+	loadBalancerV1Schema["target_ports"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Description: "the ports of the target_port_name of the forwarding rules, by name",
+	}
+	loadBalancerV1Schema["resolved_target_ports"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
 
 	return loadBalancerV1Schema
 }
@@ -141,6 +185,51 @@ func loadbalancerDiffCheck(ctx context.Context, d *schema.ResourceDiff, v interf
 	return nil
 }
 
+// ValidateUDPForwardingRules checks that UDP forwarding rules use UDP for both
+// the entry and target protocol and, when a health check is configured, that
+// it uses TCP or HTTP on a port other than the target port of any UDP rule.
+func ValidateUDPForwardingRules(rules []interface{}, healthCheck []interface{}) error {
+	udpPorts := map[int]bool{}
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entryProtocol, _ := rule["entry_protocol"].(string)
+		targetProtocol, _ := rule["target_protocol"].(string)
+		if entryProtocol == "" || targetProtocol == "" {
+			continue
+		}
+
+		if (entryProtocol == "udp") != (targetProtocol == "udp") {
+			return fmt.Errorf("forwarding rule `entry_protocol` and `target_protocol` must both be `udp` when either is `udp`")
+		}
+
+		if entryProtocol == "udp" {
+			if port, _ := rule["target_port"].(int); port != 0 {
+				udpPorts[port] = true
+			}
+		}
+	}
+
+	if len(udpPorts) == 0 || len(healthCheck) == 0 || healthCheck[0] == nil {
+		return nil
+	}
+
+	hc := healthCheck[0].(map[string]interface{})
+	protocol, _ := hc["protocol"].(string)
+	if protocol != "" && protocol != "tcp" && protocol != "http" {
+		return fmt.Errorf("health check `protocol` must be `tcp` or `http` when using `udp` forwarding rules")
+	}
+
+	if port, _ := hc["port"].(int); udpPorts[port] {
+		return fmt.Errorf("health check `port` must be different from the `target_port` of `udp` forwarding rules, got %d", port)
+	}
+
+	return nil
+}
+
 func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -185,7 +274,7 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: util.ValidateLoadBalancerName,
 			},
 			"urn": {
 				Type:        schema.TypeString,
@@ -193,9 +282,10 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				Description: "the uniform resource name for the load balancer",
 			},
 			"algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "round_robin",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultAlgorithm,
+				DiffSuppressFunc: suppressLoadBalancerDefault(defaultAlgorithm),
 				ValidateFunc: validation.StringInSlice([]string{
 					"round_robin",
 					"least_connections",
@@ -285,28 +375,32 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 							ValidateFunc: validation.NoZeroValues,
 						},
 						"check_interval_seconds": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      10,
-							ValidateFunc: validation.IntBetween(3, 300),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          defaultCheckIntervalSeconds,
+							DiffSuppressFunc: suppressLoadBalancerDefault(strconv.Itoa(defaultCheckIntervalSeconds)),
+							ValidateFunc:     validation.IntBetween(3, 300),
 						},
 						"response_timeout_seconds": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      5,
-							ValidateFunc: validation.IntBetween(3, 300),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          defaultResponseTimeoutSeconds,
+							DiffSuppressFunc: suppressLoadBalancerDefault(strconv.Itoa(defaultResponseTimeoutSeconds)),
+							ValidateFunc:     validation.IntBetween(3, 300),
 						},
 						"unhealthy_threshold": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      3,
-							ValidateFunc: validation.IntBetween(2, 10),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          defaultUnhealthyThreshold,
+							DiffSuppressFunc: suppressLoadBalancerDefault(strconv.Itoa(defaultUnhealthyThreshold)),
+							ValidateFunc:     validation.IntBetween(2, 10),
 						},
 						"healthy_threshold": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      5,
-							ValidateFunc: validation.IntBetween(2, 10),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          defaultHealthyThreshold,
+							DiffSuppressFunc: suppressLoadBalancerDefault(strconv.Itoa(defaultHealthyThreshold)),
+							ValidateFunc:     validation.IntBetween(2, 10),
 						},
 					},
 				},
@@ -320,9 +414,10 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "none",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          defaultStickySessionsType,
+							DiffSuppressFunc: suppressLoadBalancerDefault(defaultStickySessionsType),
 							ValidateFunc: validation.StringInSlice([]string{
 								"cookies",
 								"none",
@@ -579,7 +674,7 @@ func migrateLoadBalancerStateV0toV1(ctx context.Context, rawState map[string]int
 			continue
 		}
 
-		cert, _, err := client.Certificates.Get(context.Background(), fw["certificate_id"].(string))
+		cert, _, err := client.Certificates.Get(ctx, fw["certificate_id"].(string))
 		if err != nil {
 			return rawState, err
 		}
@@ -591,14 +686,21 @@ func migrateLoadBalancerStateV0toV1(ctx context.Context, rawState map[string]int
 	return rawState, nil
 }
 
-func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*godo.LoadBalancerRequest, error) {
-	forwardingRules, err := expandForwardingRules(client, d.Get("forwarding_rule").(*schema.Set).List())
+func buildLoadBalancerRequest(ctx context.Context, meta interface{}, d *schema.ResourceData) (*godo.LoadBalancerRequest, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	targetPorts, err := resolveLoadBalancerTargetPorts(ctx, client, d)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardingRules, err := expandForwardingRules(ctx, client, d.Get("forwarding_rule").(*schema.Set).List(), targetPorts)
 	if err != nil {
 		return nil, err
 	}
 
 	opts := &godo.LoadBalancerRequest{
-		Name:                         d.Get("name").(string),
+		Name:                         meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 		Region:                       d.Get("region").(string),
 		Algorithm:                    d.Get("algorithm").(string),
 		RedirectHttpToHttps:          d.Get("redirect_http_to_https").(bool),
@@ -653,7 +755,7 @@ func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*god
 	}
 
 	if v, ok := d.GetOk("domains"); ok {
-		domains, err := expandDomains(client, v.(*schema.Set).List())
+		domains, err := expandDomains(ctx, client, v.(*schema.Set).List())
 		if err != nil {
 			return nil, err
 		}
@@ -686,29 +788,25 @@ func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] Create a Loadbalancer Request")
 
-	lbOpts, err := buildLoadBalancerRequest(client, d)
+	lbOpts, err := buildLoadBalancerRequest(ctx, meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, []string{lbOpts.Tag}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Loadbalancer Create: %#v", lbOpts)
-	loadbalancer, _, err := client.LoadBalancers.Create(context.Background(), lbOpts)
+	loadbalancer, _, err := client.LoadBalancers.Create(ctx, lbOpts)
 	if err != nil {
 		return diag.Errorf("Error creating Load Balancer: %s", err)
 	}
 
 	d.SetId(loadbalancer.ID)
 
-	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become active", d.Get("name"))
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"new"},
-		Target:     []string{"active"},
-		Refresh:    loadbalancerStateRefreshFunc(client, d.Id()),
-		Timeout:    10 * time.Minute,
-		MinTimeout: 15 * time.Second,
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("Error waiting for Load Balancer (%s) to become active: %s", d.Get("name"), err)
+	if err := waitForLoadBalancerActive(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
@@ -718,7 +816,7 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Reading the details of the Loadbalancer %s", d.Id())
-	loadbalancer, resp, err := client.LoadBalancers.Get(context.Background(), d.Id())
+	loadbalancer, resp, err := client.LoadBalancers.Get(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] DigitalOcean Load Balancer (%s) not found", d.Id())
@@ -728,7 +826,9 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Error retrieving Loadbalancer: %s", err)
 	}
 
-	d.Set("name", loadbalancer.Name)
+	normalizeLoadBalancer(loadbalancer)
+
+	d.Set("name", meta.(*config.CombinedConfig).UnprefixName(loadbalancer.Name))
 	d.Set("urn", loadbalancer.URN())
 	d.Set("ip", loadbalancer.IP)
 	d.Set("status", loadbalancer.Status)
@@ -765,15 +865,21 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("[DEBUG] Error setting Load Balancer healthcheck - error: %#v", err)
 	}
 
-	forwardingRules, err := flattenForwardingRules(client, loadbalancer.ForwardingRules)
+	forwardingRules, err := flattenForwardingRules(ctx, client, loadbalancer.ForwardingRules)
 	if err != nil {
 		return diag.Errorf("[DEBUG] Error building Load Balancer forwarding rules - error: %#v", err)
 	}
 
+	resolvedTargetPorts := setTargetPortNames(d.Get("forwarding_rule").(*schema.Set).List(), forwardingRules)
+
 	if err := d.Set("forwarding_rule", forwardingRules); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Load Balancer forwarding_rule - error: %#v", err)
 	}
 
+	if err := d.Set("resolved_target_ports", resolvedTargetPorts); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Load Balancer resolved_target_ports - error: %#v", err)
+	}
+
 	if err := d.Set("firewall", flattenLBFirewall(loadbalancer.Firewall)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Load Balancer firewall - error: %#v", err)
 	}
@@ -784,17 +890,33 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 func resourceDigitalOceanLoadbalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	lbOpts, err := buildLoadBalancerRequest(client, d)
+	lbOpts, err := buildLoadBalancerRequest(ctx, meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, []string{lbOpts.Tag}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Load Balancer Update: %#v", lbOpts)
-	_, _, err = client.LoadBalancers.Update(context.Background(), d.Id(), lbOpts)
+	_, _, err = client.LoadBalancers.Update(ctx, d.Id(), lbOpts)
 	if err != nil {
 		return diag.Errorf("Error updating Load Balancer: %s", err)
 	}
 
+	if err := waitForLoadBalancerActive(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The project of a load balancer is only set when it is created, so it
+	// is moved to the new project through the project assignment API.
+	if v, ok := d.GetOk("project_id"); ok && d.HasChange("project_id") {
+		if err := project.AssignResource(ctx, client, v.(string), godo.LoadBalancer{ID: d.Id()}.URN()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
 }
 
@@ -802,7 +924,7 @@ func resourceDigitalOceanLoadbalancerDelete(ctx context.Context, d *schema.Resou
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting Load Balancer: %s", d.Id())
-	resp, err := client.LoadBalancers.Delete(context.Background(), d.Id())
+	resp, err := client.LoadBalancers.Delete(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			d.SetId("")
@@ -3,6 +3,7 @@ package loadbalancer_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -411,6 +412,47 @@ func TestAccDigitalOceanLoadbalancer_minimalUDP(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanLoadbalancer_UDPHealthCheck(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanLoadbalancerConfig_UDPHealthCheck(name, "tcp", 53),
+				ExpectError: regexp.MustCompile("health check `port` must be different from the `target_port` of `udp` forwarding rules"),
+			},
+			{
+				Config:      testAccCheckDigitalOceanLoadbalancerConfig_UDPHealthCheck(name, "https", 8443),
+				ExpectError: regexp.MustCompile("health check `protocol` must be `tcp` or `http` when using `udp` forwarding rules"),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_UDPHealthCheck(name, "tcp", 8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"entry_port":      "53",
+							"entry_protocol":  "udp",
+							"target_port":     "53",
+							"target_protocol": "udp",
+						},
+					),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "healthcheck.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "healthcheck.0.port", "8080"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanLoadbalancer_stickySessions(t *testing.T) {
 	var loadbalancer godo.LoadBalancer
 	name := acceptance.RandomTestName()
@@ -839,6 +881,267 @@ func TestLoadbalancerDiffCheck(t *testing.T) {
 	}
 }
 
+func TestLoadbalancerDiffSuppressDefaults(t *testing.T) {
+	s := &terraform.InstanceState{
+		ID: "lb-id",
+		Attributes: map[string]string{
+			"id":                                     "lb-id",
+			"name":                                   "foobar",
+			"region":                                 "nyc3",
+			"algorithm":                              "",
+			"healthcheck.#":                          "1",
+			"healthcheck.0.protocol":                 "tcp",
+			"healthcheck.0.port":                     "22",
+			"healthcheck.0.check_interval_seconds":   "0",
+			"healthcheck.0.response_timeout_seconds": "0",
+			"healthcheck.0.unhealthy_threshold":      "0",
+			"healthcheck.0.healthy_threshold":        "0",
+			"sticky_sessions.#":                      "1",
+			"sticky_sessions.0.type":                 "",
+		},
+	}
+	conf := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "foobar",
+		"region": "nyc3",
+		"healthcheck": []interface{}{
+			map[string]interface{}{
+				"protocol": "tcp",
+				"port":     22,
+			},
+		},
+		"sticky_sessions": []interface{}{
+			map[string]interface{}{},
+		},
+	})
+
+	r := loadbalancer.ResourceDigitalOceanLoadbalancer()
+	diff, err := r.Diff(context.Background(), s, conf, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff == nil {
+		return
+	}
+
+	for _, k := range []string{
+		"algorithm",
+		"healthcheck.0.check_interval_seconds",
+		"healthcheck.0.response_timeout_seconds",
+		"healthcheck.0.unhealthy_threshold",
+		"healthcheck.0.healthy_threshold",
+		"sticky_sessions.0.type",
+	} {
+		if attr, ok := diff.Attributes[k]; ok {
+			t.Errorf("Expected no diff for %s, got %q => %q", k, attr.Old, attr.New)
+		}
+	}
+}
+
+func TestValidateUDPForwardingRules(t *testing.T) {
+	udpRule := map[string]interface{}{
+		"entry_protocol":  "udp",
+		"entry_port":      53,
+		"target_protocol": "udp",
+		"target_port":     53,
+	}
+	healthCheck := func(protocol string, port int) []interface{} {
+		return []interface{}{map[string]interface{}{"protocol": protocol, "port": port}}
+	}
+
+	cases := []struct {
+		name        string
+		rules       []interface{}
+		healthCheck []interface{}
+		expectError string
+	}{
+		{
+			name:  "UDP without health check",
+			rules: []interface{}{udpRule},
+		},
+		{
+			name:        "UDP with TCP health check on a different port",
+			rules:       []interface{}{udpRule},
+			healthCheck: healthCheck("tcp", 8080),
+		},
+		{
+			name:        "UDP with HTTP health check on a different port",
+			rules:       []interface{}{udpRule},
+			healthCheck: healthCheck("http", 80),
+		},
+		{
+			name:        "UDP with HTTPS health check",
+			rules:       []interface{}{udpRule},
+			healthCheck: healthCheck("https", 443),
+			expectError: "health check `protocol` must be `tcp` or `http` when using `udp` forwarding rules",
+		},
+		{
+			name:        "UDP with health check on the target port",
+			rules:       []interface{}{udpRule},
+			healthCheck: healthCheck("tcp", 53),
+			expectError: "health check `port` must be different from the `target_port` of `udp` forwarding rules, got 53",
+		},
+		{
+			name: "UDP entry with TCP target",
+			rules: []interface{}{map[string]interface{}{
+				"entry_protocol":  "udp",
+				"entry_port":      53,
+				"target_protocol": "tcp",
+				"target_port":     53,
+			}},
+			expectError: "forwarding rule `entry_protocol` and `target_protocol` must both be `udp` when either is `udp`",
+		},
+		{
+			name: "TCP with health check on the target port",
+			rules: []interface{}{map[string]interface{}{
+				"entry_protocol":  "tcp",
+				"entry_port":      80,
+				"target_protocol": "tcp",
+				"target_port":     80,
+			}},
+			healthCheck: healthCheck("tcp", 80),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := loadbalancer.ValidateUDPForwardingRules(c.rules, c.healthCheck)
+			if c.expectError == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %s", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != c.expectError {
+				t.Fatalf("Expected %s, got %v", c.expectError, err)
+			}
+		})
+	}
+}
+
+func TestResolveTargetPorts(t *testing.T) {
+	cases := []struct {
+		name        string
+		names       []string
+		targetPorts map[string]int
+		dropletTags [][]string
+		expected    map[string]int
+		expectError string
+	}{
+		{
+			name:        "from the map",
+			names:       []string{"web"},
+			targetPorts: map[string]int{"web": 8080},
+			dropletTags: [][]string{{"port:web:9090"}},
+			expected:    map[string]int{"web": 8080},
+		},
+		{
+			name:        "from the Droplet tags",
+			names:       []string{"web", "metrics"},
+			dropletTags: [][]string{{"app", "port:web:8080", "port:metrics:9100"}, {"port:web:8080"}},
+			expected:    map[string]int{"web": 8080, "metrics": 9100},
+		},
+		{
+			name:        "ignores invalid tags",
+			names:       []string{"web"},
+			dropletTags: [][]string{{"port:web:http", "port:web:70000", "port:webapp:80", "port:web:8080"}},
+			expected:    map[string]int{"web": 8080},
+		},
+		{
+			name:        "conflicting Droplet tags",
+			names:       []string{"web"},
+			dropletTags: [][]string{{"port:web:8080"}, {"port:web:8081"}},
+			expectError: `Droplets have conflicting tags for target port "web": 8080 and 8081`,
+		},
+		{
+			name:        "unresolved",
+			names:       []string{"web"},
+			targetPorts: map[string]int{"api": 8080},
+			dropletTags: [][]string{{"port:api:8080"}},
+			expectError: `unable to resolve target port "web": it is not in target_ports and no Droplet is tagged port:web:<port>`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resolved, err := loadbalancer.ResolveTargetPorts(c.names, c.targetPorts, c.dropletTags)
+			if c.expectError != "" {
+				if err == nil || err.Error() != c.expectError {
+					t.Fatalf("Expected %s, got %v", c.expectError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %s", err)
+			}
+			if !reflect.DeepEqual(resolved, c.expected) {
+				t.Fatalf("Expected %v, got %v", c.expected, resolved)
+			}
+		})
+	}
+}
+
+func TestAccDigitalOceanLoadbalancer_targetPortName(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_targetPortName(name, "8080"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "forwarding_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"entry_port":       "80",
+							"target_port":      "8080",
+							"target_port_name": "web",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"entry_port":       "9100",
+							"target_port":      "9101",
+							"target_port_name": "metrics",
+						},
+					),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "resolved_target_ports.web", "8080"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "resolved_target_ports.metrics", "9101"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_targetPortName(name, "8081"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"entry_port":       "80",
+							"target_port":      "8081",
+							"target_port_name": "web",
+						},
+					),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "resolved_target_ports.web", "8081"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanGlobalLoadbalancer(t *testing.T) {
 	var loadbalancer godo.LoadBalancer
 	name := acceptance.RandomTestName()
@@ -1076,6 +1379,59 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, name, name)
 }
 
+func testAccCheckDigitalOceanLoadbalancerConfig_targetPortName(name, webPort string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_tag" "app" {
+  name = "%s"
+}
+
+resource "digitalocean_tag" "web_port" {
+  name = "port:web:%s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  tags   = [digitalocean_tag.app.id, digitalocean_tag.web_port.id]
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port_name = "web"
+    target_protocol  = "http"
+  }
+
+  forwarding_rule {
+    entry_port     = 9100
+    entry_protocol = "tcp"
+
+    target_port_name = "metrics"
+    target_protocol  = "tcp"
+  }
+
+  target_ports = {
+    metrics = 9101
+  }
+
+  healthcheck {
+    port     = 22
+    protocol = "tcp"
+  }
+
+  droplet_tag = digitalocean_tag.app.name
+
+  depends_on = [digitalocean_droplet.foobar]
+}`, name, webPort, name, name)
+}
+
 func testAccCheckDigitalOceanLoadbalancerConfig_minimal(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -1152,6 +1508,43 @@ resource "digitalocean_loadbalancer" "foobar" {
 }`, name, name)
 }
 
+func testAccCheckDigitalOceanLoadbalancerConfig_UDPHealthCheck(name string, protocol string, port int) string {
+	path := ""
+	if protocol != "tcp" {
+		path = `path     = "/"`
+	}
+
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+  size   = "lb-small"
+
+  forwarding_rule {
+    entry_port     = 53
+    entry_protocol = "udp"
+
+    target_port     = 53
+    target_protocol = "udp"
+  }
+
+  healthcheck {
+    port     = %d
+    protocol = "%s"
+    %s
+  }
+
+  droplet_ids = [digitalocean_droplet.foobar.id]
+}`, name, name, port, protocol, path)
+}
+
 func testAccCheckDigitalOceanLoadbalancerConfig_stickySessions(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -0,0 +1,286 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// targetPortTagPrefix is the prefix of the Droplet tags naming the ports of
+// the services running on them, e.g. port:http:8080.
+const targetPortTagPrefix = "port:"
+
+var targetPortNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+type targetPortGetter interface {
+	Get(string) interface{}
+}
+
+// targetPortNames returns the sorted, distinct target_port_name of the
+// forwarding rules.
+func targetPortNames(rules []interface{}) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, raw := range rules {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := rule["target_port_name"].(string); name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveLoadBalancerTargetPorts resolves the target_port_name of the
+// forwarding rules, looking up the Droplets of the load balancer only if
+// some names are not in target_ports.
+func resolveLoadBalancerTargetPorts(ctx context.Context, client *godo.Client, d targetPortGetter) (map[string]int, error) {
+	names := targetPortNames(d.Get("forwarding_rule").(*schema.Set).List())
+	if len(names) == 0 {
+		return map[string]int{}, nil
+	}
+
+	targetPorts := map[string]int{}
+	for name, port := range d.Get("target_ports").(map[string]interface{}) {
+		targetPorts[name] = port.(int)
+	}
+
+	var dropletTags [][]string
+	for _, name := range names {
+		if _, ok := targetPorts[name]; ok {
+			continue
+		}
+
+		droplets, err := listLoadBalancerDroplets(ctx, client, d)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Droplets to resolve target ports: %s", err)
+		}
+		for _, droplet := range droplets {
+			dropletTags = append(dropletTags, droplet.Tags)
+		}
+		break
+	}
+
+	return ResolveTargetPorts(names, targetPorts, dropletTags)
+}
+
+// ResolveTargetPorts returns the port of each of the names, taken from
+// targetPorts or else from the port:<name>:<port> tags of the Droplets, which
+// must all agree.
+func ResolveTargetPorts(names []string, targetPorts map[string]int, dropletTags [][]string) (map[string]int, error) {
+	resolved := make(map[string]int, len(names))
+
+	for _, name := range names {
+		if port, ok := targetPorts[name]; ok {
+			resolved[name] = port
+			continue
+		}
+
+		port := 0
+		for _, tags := range dropletTags {
+			for _, t := range tags {
+				tagPort, ok := parseTargetPortTag(t, name)
+				if !ok {
+					continue
+				}
+				if port != 0 && port != tagPort {
+					return nil, fmt.Errorf("Droplets have conflicting tags for target port %q: %d and %d", name, port, tagPort)
+				}
+				port = tagPort
+			}
+		}
+
+		if port == 0 {
+			return nil, fmt.Errorf("unable to resolve target port %q: it is not in target_ports and no Droplet is tagged %s%s:<port>", name, targetPortTagPrefix, name)
+		}
+		resolved[name] = port
+	}
+
+	return resolved, nil
+}
+
+func parseTargetPortTag(t, name string) (int, bool) {
+	rest := strings.TrimPrefix(t, targetPortTagPrefix+name+":")
+	if rest == t {
+		return 0, false
+	}
+
+	port, err := strconv.Atoi(rest)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, false
+	}
+	return port, true
+}
+
+func listLoadBalancerDroplets(ctx context.Context, client *godo.Client, d targetPortGetter) ([]godo.Droplet, error) {
+	if dropletTag, _ := d.Get("droplet_tag").(string); dropletTag != "" {
+		opts := &godo.ListOptions{
+			Page:    1,
+			PerPage: 200,
+		}
+
+		var droplets []godo.Droplet
+		for {
+			page, resp, err := client.Droplets.ListByTag(ctx, dropletTag, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			droplets = append(droplets, page...)
+
+			if resp.Links == nil || resp.Links.IsLastPage() {
+				break
+			}
+
+			current, err := resp.Links.CurrentPage()
+			if err != nil {
+				return nil, err
+			}
+
+			opts.Page = current + 1
+		}
+
+		return droplets, nil
+	}
+
+	var droplets []godo.Droplet
+	for _, id := range d.Get("droplet_ids").(*schema.Set).List() {
+		droplet, _, err := client.Droplets.Get(ctx, id.(int))
+		if err != nil {
+			return nil, err
+		}
+		droplets = append(droplets, *droplet)
+	}
+
+	return droplets, nil
+}
+
+// validateTargetPorts checks that each forwarding rule of the configuration
+// sets one of target_port or target_port_name. The configuration is used as
+// target_port is computed for the rules using target_port_name.
+func validateTargetPorts(diff *schema.ResourceDiff) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	rules := rawConfig.GetAttr("forwarding_rule")
+	if rules.IsNull() || !rules.IsKnown() {
+		return nil
+	}
+
+	for it := rules.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		if rule.IsNull() || !rule.IsKnown() {
+			continue
+		}
+
+		port, name := rule.GetAttr("target_port"), rule.GetAttr("target_port_name")
+		if !port.IsKnown() || !name.IsKnown() {
+			continue
+		}
+
+		hasPort := !port.IsNull()
+		hasName := !name.IsNull() && name.AsString() != ""
+		if hasPort == hasName {
+			return fmt.Errorf("forwarding rules must set exactly one of `target_port` or `target_port_name`")
+		}
+	}
+
+	return nil
+}
+
+// targetPortsDiff validates the target ports of the forwarding rules and
+// resolves their names, so that the load balancer is updated when a named
+// port changes.
+func targetPortsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateTargetPorts(diff); err != nil {
+		return err
+	}
+
+	if !diff.NewValueKnown("forwarding_rule") {
+		return diff.SetNewComputed("resolved_target_ports")
+	}
+
+	if len(targetPortNames(diff.Get("forwarding_rule").(*schema.Set).List())) == 0 {
+		if len(diff.Get("resolved_target_ports").(map[string]interface{})) > 0 {
+			return diff.SetNew("resolved_target_ports", map[string]interface{}{})
+		}
+		return nil
+	}
+
+	for _, k := range []string{"target_ports", "droplet_tag", "droplet_ids"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("resolved_target_ports")
+		}
+	}
+
+	resolved, err := resolveLoadBalancerTargetPorts(ctx, meta.(*config.CombinedConfig).GodoClient(), diff)
+	if err != nil {
+		// The Droplets of a new load balancer may be created along with it,
+		// so the ports are resolved again when it is created.
+		if diff.Id() == "" {
+			return diff.SetNewComputed("resolved_target_ports")
+		}
+		return err
+	}
+
+	current := diff.Get("resolved_target_ports").(map[string]interface{})
+	changed := len(current) != len(resolved)
+	for name, port := range resolved {
+		if p, ok := current[name].(int); !ok || p != port {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	newPorts := make(map[string]interface{}, len(resolved))
+	for name, port := range resolved {
+		newPorts[name] = port
+	}
+	return diff.SetNew("resolved_target_ports", newPorts)
+}
+
+// setTargetPortNames copies the target_port_name of the configured forwarding
+// rules to the rules read from the API, which only know the resolved port,
+// and returns the resolved ports by name.
+func setTargetPortNames(configured []interface{}, rules []map[string]interface{}) map[string]interface{} {
+	resolved := map[string]interface{}{}
+
+	for _, rule := range rules {
+		for _, raw := range configured {
+			c, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _ := c["target_port_name"].(string)
+			if name == "" ||
+				c["entry_port"] != rule["entry_port"] ||
+				!strings.EqualFold(c["entry_protocol"].(string), rule["entry_protocol"].(string)) ||
+				!strings.EqualFold(c["target_protocol"].(string), rule["target_protocol"].(string)) {
+				continue
+			}
+
+			rule["target_port_name"] = name
+			resolved[name] = rule["target_port"]
+			break
+		}
+	}
+
+	return resolved
+}
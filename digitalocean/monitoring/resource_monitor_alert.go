@@ -2,7 +2,9 @@ package monitoring
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -153,9 +155,22 @@ func ResourceDigitalOceanMonitorAlert() *schema.Resource {
 				}, false),
 			},
 		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			// Membership of tagged Droplets is resolved by the API whenever the
+			// alert is evaluated, but this is only supported for Droplet alerts.
+			alertType := diff.Get("type").(string)
+			if diff.Get("tags").(*schema.Set).Len() > 0 && !strings.HasPrefix(alertType, dropletAlertTypePrefix) {
+				return fmt.Errorf("`tags` can only be used to select Droplets for alerts of the type %s*, use `entities` for %s", dropletAlertTypePrefix, alertType)
+			}
+
+			return nil
+		},
 	}
 }
 
+const dropletAlertTypePrefix = "v1/insights/droplet/"
+
 func resourceDigitalOceanMonitorAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -171,8 +186,12 @@ func resourceDigitalOceanMonitorAlertCreate(ctx context.Context, d *schema.Resou
 		Alerts:      expandAlerts(d.Get("alerts").([]interface{})),
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, alertCreateRequest.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Alert Policy create configuration: %#v", alertCreateRequest)
-	alertPolicy, _, err := client.Monitoring.CreateAlertPolicy(context.Background(), alertCreateRequest)
+	alertPolicy, _, err := client.Monitoring.CreateAlertPolicy(ctx, alertCreateRequest)
 	if err != nil {
 		return diag.Errorf("Error creating Alert Policy: %s", err)
 	}
@@ -287,6 +306,10 @@ func resourceDigitalOceanMonitorAlertUpdate(ctx context.Context, d *schema.Resou
 		Alerts:      expandAlerts(d.Get("alerts").([]interface{})),
 	}
 
+	if err := tag.CreateMissingTags(ctx, meta, updateRequest.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, _, err := client.Monitoring.UpdateAlertPolicy(ctx, d.Id(), updateRequest)
 	if err != nil {
 		return diag.Errorf("Error updating monitoring alert: %s", err)
@@ -327,7 +350,7 @@ func resourceDigitalOceanMonitorAlertDelete(ctx context.Context, d *schema.Resou
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting the monitor alert")
-	_, err := client.Monitoring.DeleteAlertPolicy(context.Background(), d.Id())
+	_, err := client.Monitoring.DeleteAlertPolicy(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting monitor alert: %s", err)
 	}
@@ -3,6 +3,7 @@ package monitoring_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
@@ -243,6 +244,10 @@ func TestAccDigitalOceanMonitorAlertWithTag(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.0", tagName),
 				),
 			},
+			{
+				Config:      fmt.Sprintf(testAccAlertPolicyWithTag, tagName, randName, randName, "5m", "v1/insights/lbaas/avg_cpu_utilization_percent", randName),
+				ExpectError: regexp.MustCompile("`tags` can only be used to select Droplets"),
+			},
 		},
 	})
 }
@@ -34,7 +34,7 @@ func sweepMonitoringAlerts(region string) error {
 	}
 
 	for _, a := range alerts {
-		if strings.HasPrefix(a.Description, sweep.TestNamePrefix) {
+		if strings.HasPrefix(a.Description, sweep.NamePrefix()) {
 			log.Printf("[DEBUG] Destroying alert %s", a.Description)
 
 			if _, err := client.Monitoring.DeleteAlertPolicy(context.Background(), a.UUID); err != nil {
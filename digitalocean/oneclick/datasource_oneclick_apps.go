@@ -0,0 +1,111 @@
+package oneclick
+
+import (
+	"context"
+	"sort"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanOneClickApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanOneClickAppsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"droplet", "kubernetes"}, false),
+				Description:  "the type of 1-Click Apps to list, either droplet or kubernetes. Defaults to all types",
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "the ID of the current image of a droplet 1-Click App, which can be used to pin a Droplet to it",
+						},
+						"image_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"slugs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanOneClickAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	appType := d.Get("type").(string)
+
+	oneClicks, _, err := client.OneClick.List(ctx, appType)
+	if err != nil {
+		return diag.Errorf("Error retrieving 1-Click Apps: %s", err)
+	}
+
+	images := map[string]godo.Image{}
+	if appType != "kubernetes" {
+		images, err = listApplicationImages(ctx, client)
+		if err != nil {
+			return diag.Errorf("Error retrieving 1-Click App images: %s", err)
+		}
+	}
+
+	sort.Slice(oneClicks, func(i, j int) bool {
+		if oneClicks[i].Type != oneClicks[j].Type {
+			return oneClicks[i].Type < oneClicks[j].Type
+		}
+		return oneClicks[i].Slug < oneClicks[j].Slug
+	})
+
+	apps := make([]map[string]interface{}, 0, len(oneClicks))
+	slugs := make([]string, 0, len(oneClicks))
+	for _, oc := range oneClicks {
+		app := map[string]interface{}{
+			"slug": oc.Slug,
+			"type": oc.Type,
+		}
+
+		if image, ok := images[oc.Slug]; ok && oc.Type == "droplet" {
+			app["image_id"] = image.ID
+			app["image_name"] = image.Name
+		}
+
+		apps = append(apps, app)
+		slugs = append(slugs, oc.Slug)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("apps", apps); err != nil {
+		return diag.Errorf("Error setting apps: %s", err)
+	}
+
+	if err := d.Set("slugs", slugs); err != nil {
+		return diag.Errorf("Error setting slugs: %s", err)
+	}
+
+	return nil
+}
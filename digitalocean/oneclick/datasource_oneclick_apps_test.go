@@ -0,0 +1,50 @@
+package oneclick_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanOneClickApps_Droplet(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_1click_apps" "droplet" {
+  type = "droplet"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_1click_apps.droplet", "apps.0.slug"),
+					resource.TestCheckResourceAttr("data.digitalocean_1click_apps.droplet", "apps.0.type", "droplet"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_1click_apps.droplet", "apps.0.image_id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_1click_apps.droplet", "slugs.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanOneClickApps_Kubernetes(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_1click_apps" "kubernetes" {
+  type = "kubernetes"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.digitalocean_1click_apps.kubernetes", "slugs.*", "metrics-server"),
+					resource.TestCheckResourceAttr("data.digitalocean_1click_apps.kubernetes", "apps.0.type", "kubernetes"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package oneclick
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+)
+
+// ResolveDropletImage returns the ID of the current image of the 1-Click App
+// with the given slug, or 0 if the slug is not the slug of a 1-Click App, in
+// which case it is used as an image slug. An error is returned if the slug
+// is the slug of a Kubernetes 1-Click App or if the image of the App can not
+// be found, as the API does not point these out when creating Droplets.
+func ResolveDropletImage(ctx context.Context, client *godo.Client, slug string) (int, error) {
+	oneClicks, _, err := client.OneClick.List(ctx, "")
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving 1-Click Apps: %s", err)
+	}
+
+	var app *godo.OneClick
+	for _, oc := range oneClicks {
+		if oc.Slug == slug {
+			app = oc
+			if oc.Type == "droplet" {
+				break
+			}
+		}
+	}
+
+	if app == nil {
+		return 0, nil
+	}
+
+	if app.Type != "droplet" {
+		return 0, fmt.Errorf("%s is a %s 1-Click App and can not be used as a Droplet image", slug, app.Type)
+	}
+
+	images, err := listApplicationImages(ctx, client)
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving 1-Click App images: %s", err)
+	}
+
+	image, ok := images[slug]
+	if !ok {
+		return 0, fmt.Errorf("no image found for the 1-Click App %s", slug)
+	}
+
+	return image.ID, nil
+}
+
+// listApplicationImages returns the 1-Click application images keyed by their
+// slug, which matches the slug of the droplet 1-Click App.
+func listApplicationImages(ctx context.Context, client *godo.Client) (map[string]godo.Image, error) {
+	images := map[string]godo.Image{}
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		page, resp, err := client.Images.ListApplication(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, image := range page {
+			if image.Slug != "" {
+				images[image.Slug] = image
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		p, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = p + 1
+	}
+
+	return images, nil
+}
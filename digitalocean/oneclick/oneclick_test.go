@@ -0,0 +1,67 @@
+package oneclick_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/oneclick"
+)
+
+func TestResolveDropletImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v2/1-clicks":
+			fmt.Fprint(w, `{"1_clicks": [
+				{"slug": "docker-20-04", "type": "droplet"},
+				{"slug": "wordpress-20-04", "type": "droplet"},
+				{"slug": "metrics-server", "type": "kubernetes"}
+			]}`)
+		case "/v2/images":
+			fmt.Fprint(w, `{"images": [{"id": 123, "slug": "docker-20-04", "name": "Docker on Ubuntu 20.04"}], "meta": {"total": 1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id": "not_found", "message": "not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := (&config.Config{Token: "12345", APIEndpoint: server.URL}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Slug        string
+		ExpectedID  int
+		ExpectError string
+	}{
+		{Slug: "docker-20-04", ExpectedID: 123},
+		{Slug: "ubuntu-22-04-x64", ExpectedID: 0},
+		{Slug: "metrics-server", ExpectError: "is a kubernetes 1-Click App"},
+		{Slug: "wordpress-20-04", ExpectError: "no image found"},
+	}
+
+	for _, tc := range cases {
+		id, err := oneclick.ResolveDropletImage(context.Background(), client.GodoClient(), tc.Slug)
+		if tc.ExpectError != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+				t.Errorf("expected an error containing %q for %s, got %v", tc.ExpectError, tc.Slug, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %s: %s", tc.Slug, err)
+		}
+		if id != tc.ExpectedID {
+			t.Errorf("expected image %d for %s, got %d", tc.ExpectedID, tc.Slug, id)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ProjectIDSchema returns the schema of the project_id argument of resources
+// which are assigned to a project after they are created.
+func ProjectIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "the ID of the project the resource is assigned to",
+	}
+}
+
+// AssignResource moves the resource with the given URN to the project,
+// removing it from the project it was assigned to.
+func AssignResource(ctx context.Context, client *godo.Client, projectID, urn string) error {
+	log.Printf("[INFO] Assigning %s to project %s", urn, projectID)
+	_, _, err := client.Projects.AssignResources(ctx, projectID, urn)
+	if err != nil {
+		return fmt.Errorf("Error assigning %s to project %s: %s", urn, projectID, err)
+	}
+
+	return nil
+}
+
+// UpdateProjectID moves the resource with the given URN to its new
+// project_id, or back to the default project if the project_id was removed.
+func UpdateProjectID(ctx context.Context, client *godo.Client, d *schema.ResourceData, urn string) error {
+	if !d.HasChange("project_id") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("project_id"); ok {
+		return AssignResource(ctx, client, v.(string), urn)
+	}
+
+	defaultProject, _, err := client.Projects.GetDefault(ctx)
+	if err != nil {
+		return fmt.Errorf("Error locating default project: %s", err)
+	}
+
+	return AssignResource(ctx, client, defaultProject.ID, urn)
+}
+
+// ReadProjectID clears the project_id of the resource with the given URN if
+// the resource is no longer assigned to that project, so that the next apply
+// moves it back. The API does not return the project of most resources, so
+// only the resources of the project in the state are listed.
+func ReadProjectID(ctx context.Context, client *godo.Client, d *schema.ResourceData, urn string) error {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return nil
+	}
+
+	assigned, err := resourceInProject(ctx, client, projectID.(string), urn)
+	if err != nil {
+		return err
+	}
+
+	if !assigned {
+		log.Printf("[WARN] %s is no longer assigned to project %s", urn, projectID)
+		d.Set("project_id", "")
+	}
+
+	return nil
+}
+
+func resourceInProject(ctx context.Context, client *godo.Client, projectID, urn string) (bool, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		resources, resp, err := client.Projects.ListResources(ctx, projectID, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return false, nil
+			}
+			return false, fmt.Errorf("Error loading resources of project %s: %s", projectID, err)
+		}
+
+		for _, r := range resources {
+			if r.URN == urn {
+				return true, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return false, nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return false, fmt.Errorf("Error loading resources of project %s: %s", projectID, err)
+		}
+
+		opts.Page = page + 1
+	}
+}
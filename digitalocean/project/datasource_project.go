@@ -34,7 +34,7 @@ func dataSourceDigitalOceanProjectRead(ctx context.Context, d *schema.ResourceDa
 	// Load the specified project, otherwise load the default project.
 	var foundProject *godo.Project
 	if projectId, ok := d.GetOk("id"); ok {
-		thisProject, _, err := client.Projects.Get(context.Background(), projectId.(string))
+		thisProject, _, err := client.Projects.Get(ctx, projectId.(string))
 		if err != nil {
 			return diag.Errorf("Unable to load project ID %s: %s", projectId, err)
 		}
@@ -61,7 +61,7 @@ func dataSourceDigitalOceanProjectRead(ctx context.Context, d *schema.ResourceDa
 		// Single result so choose that project.
 		foundProject = &projectsWithName[0]
 	} else {
-		defaultProject, _, err := client.Projects.GetDefault(context.Background())
+		defaultProject, _, err := client.Projects.GetDefault(ctx)
 		if err != nil {
 			return diag.Errorf("Unable to load default project: %s", err)
 		}
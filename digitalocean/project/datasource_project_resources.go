@@ -0,0 +1,120 @@
+package project
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanProjectResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanProjectResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the ID of the project. Defaults to the default project",
+			},
+			"urns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the sorted uniform resource names (URNs) of all resources assigned to the project",
+			},
+			"resource_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "the resource type as it appears in the URN, e.g. droplet",
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"urns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				Description: "the URNs of the resources assigned to the project grouped by resource type",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanProjectResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	projectID := d.Get("project").(string)
+	if projectID == "" {
+		defaultProject, _, err := client.Projects.GetDefault(ctx)
+		if err != nil {
+			return diag.Errorf("Unable to load default project: %s", err)
+		}
+		projectID = defaultProject.ID
+	}
+
+	urns, err := LoadResourceURNs(ctx, client, projectID)
+	if err != nil {
+		return diag.Errorf("Error loading project resource URNs for project ID %s: %s", projectID, err)
+	}
+
+	sorted := append([]string{}, *urns...)
+	sort.Strings(sorted)
+
+	d.SetId(projectID)
+	d.Set("project", projectID)
+
+	if err := d.Set("urns", sorted); err != nil {
+		return diag.Errorf("Error setting urns: %s", err)
+	}
+
+	if err := d.Set("resource_types", flattenProjectResourceTypes(sorted)); err != nil {
+		return diag.Errorf("Error setting resource_types: %s", err)
+	}
+
+	return nil
+}
+
+// flattenProjectResourceTypes groups the URNs by resource type, where a URN
+// has the format do:<type>:<id>. The groups and their URNs are sorted.
+func flattenProjectResourceTypes(urns []string) []map[string]interface{} {
+	grouped := map[string][]string{}
+	for _, urn := range urns {
+		resourceType := "unknown"
+		if parts := strings.SplitN(urn, ":", 3); len(parts) == 3 {
+			resourceType = parts[1]
+		}
+		grouped[resourceType] = append(grouped[resourceType], urn)
+	}
+
+	types := make([]string, 0, len(grouped))
+	for t := range grouped {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	result := make([]map[string]interface{}, 0, len(types))
+	for _, t := range types {
+		result = append(result, map[string]interface{}{
+			"type":  t,
+			"count": len(grouped[t]),
+			"urns":  grouped[t],
+		})
+	}
+
+	return result
+}
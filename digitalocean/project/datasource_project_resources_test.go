@@ -0,0 +1,92 @@
+package project_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanProjectResources_Basic(t *testing.T) {
+	projectName := acceptance.RandomTestName("project")
+	dropletName := acceptance.RandomTestName("droplet")
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_project" "foo" {
+  name      = "%s"
+  resources = [digitalocean_droplet.foo.urn, digitalocean_droplet.bar.urn]
+}
+
+resource "digitalocean_droplet" "foo" {
+  name   = "%s-foo"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "bar" {
+  name   = "%s-bar"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+`, projectName, dropletName, dropletName)
+
+	dataSourceConfig := `
+data "digitalocean_project_resources" "foo" {
+  project = digitalocean_project.foo.id
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_project_resources.foo", "project",
+						"digitalocean_project.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foo", "urns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.digitalocean_project_resources.foo", "urns.*",
+						"digitalocean_droplet.foo", "urn"),
+					resource.TestCheckTypeSetElemAttrPair("data.digitalocean_project_resources.foo", "urns.*",
+						"digitalocean_droplet.bar", "urn"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foo", "resource_types.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foo", "resource_types.0.type", "droplet"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foo", "resource_types.0.count", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foo", "resource_types.0.urns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanProjectResources_DefaultProject(t *testing.T) {
+	config := `
+data "digitalocean_project" "default" {
+}
+
+data "digitalocean_project_resources" "default" {
+}
+`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_project_resources.default", "project",
+						"data.digitalocean_project.default", "id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_project_resources.default", "urns.#"),
+				),
+			},
+		},
+	})
+}
@@ -59,6 +59,7 @@ data "digitalocean_project" "barfoo" {
 					resource.TestCheckResourceAttrSet("data.digitalocean_project.bar", "id"),
 					resource.TestCheckResourceAttr("data.digitalocean_project.bar", "is_default", "false"),
 					resource.TestCheckResourceAttr("data.digitalocean_project.bar", "name", nonDefaultProjectName),
+					resource.TestCheckResourceAttr("data.digitalocean_project.bar", "resource_count", "0"),
 					resource.TestCheckResourceAttr("data.digitalocean_project.barfoo", "is_default", "false"),
 					resource.TestCheckResourceAttr("data.digitalocean_project.barfoo", "name", nonDefaultProjectName),
 				),
@@ -48,6 +48,10 @@ func projectSchema() map[string]*schema.Schema {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{Type: schema.TypeString},
 		},
+		"resource_count": {
+			Type:        schema.TypeInt,
+			Description: "the number of resources associated with the project",
+		},
 	}
 }
 
@@ -107,7 +111,7 @@ func flattenDigitalOceanProject(rawProject interface{}, meta interface{}, extra
 	flattenedProject["created_at"] = project.CreatedAt
 	flattenedProject["updated_at"] = project.UpdatedAt
 
-	urns, err := LoadResourceURNs(client, project.ID)
+	urns, err := LoadResourceURNs(context.Background(), client, project.ID)
 	if err != nil {
 		return nil, fmt.Errorf("Error loading project resource URNs for project ID %s: %s", project.ID, err)
 	}
@@ -117,11 +121,12 @@ func flattenDigitalOceanProject(rawProject interface{}, meta interface{}, extra
 		flattenedURNS.Add(urn)
 	}
 	flattenedProject["resources"] = flattenedURNS
+	flattenedProject["resource_count"] = len(*urns)
 
 	return flattenedProject, nil
 }
 
-func LoadResourceURNs(client *godo.Client, projectId string) (*[]string, error) {
+func LoadResourceURNs(ctx context.Context, client *godo.Client, projectId string) (*[]string, error) {
 	opts := &godo.ListOptions{
 		Page:    1,
 		PerPage: 200,
@@ -129,7 +134,7 @@ func LoadResourceURNs(client *godo.Client, projectId string) (*[]string, error)
 
 	resourceList := []godo.ProjectResource{}
 	for {
-		resources, resp, err := client.Projects.ListResources(context.Background(), projectId, opts)
+		resources, resp, err := client.Projects.ListResources(ctx, projectId, opts)
 		if err != nil {
 			return nil, fmt.Errorf("Error loading project resources: %s", err)
 		}
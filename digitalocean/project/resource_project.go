@@ -93,6 +93,11 @@ func ResourceDigitalOceanProject() *schema.Resource {
 				Description: "the resources associated with the project",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"resource_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the number of resources associated with the project",
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -118,7 +123,7 @@ func resourceDigitalOceanProjectCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[DEBUG] Project create request: %#v", projectRequest)
-	project, _, err := client.Projects.Create(context.Background(), projectRequest)
+	project, _, err := client.Projects.Create(ctx, projectRequest)
 
 	if err != nil {
 		return diag.Errorf("Error creating Project: %s", err)
@@ -126,12 +131,12 @@ func resourceDigitalOceanProjectCreate(ctx context.Context, d *schema.ResourceDa
 
 	if v, ok := d.GetOk("resources"); ok {
 
-		resources, err := assignResourcesToProject(client, project.ID, v.(*schema.Set))
+		resources, err := assignResourcesToProject(ctx, client, project.ID, v.(*schema.Set))
 
 		if err != nil {
 
 			if project.ID != "" {
-				_, err := client.Projects.Delete(context.Background(), project.ID)
+				_, err := client.Projects.Delete(ctx, project.ID)
 				if err != nil {
 					log.Printf("[DEBUG] Adding resources to project unsuccessful and project deletion unsuccessful: %s", project.ID)
 				}
@@ -154,7 +159,7 @@ func resourceDigitalOceanProjectCreate(ctx context.Context, d *schema.ResourceDa
 			IsDefault:   v.(bool),
 		}
 
-		_, _, err := client.Projects.Update(context.Background(), project.ID, updateReq)
+		_, _, err := client.Projects.Update(ctx, project.ID, updateReq)
 		if err != nil {
 			return diag.Errorf("Error setting project as default: %s", err)
 		}
@@ -169,7 +174,7 @@ func resourceDigitalOceanProjectCreate(ctx context.Context, d *schema.ResourceDa
 func resourceDigitalOceanProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	project, resp, err := client.Projects.Get(context.Background(), d.Id())
+	project, resp, err := client.Projects.Get(ctx, d.Id())
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -209,7 +214,7 @@ func resourceDigitalOceanProjectRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	urns, err := LoadResourceURNs(client, project.ID)
+	urns, err := LoadResourceURNs(ctx, client, project.ID)
 	if err != nil {
 		return diag.Errorf("Error reading Project: %s", err)
 	}
@@ -217,6 +222,9 @@ func resourceDigitalOceanProjectRead(ctx context.Context, d *schema.ResourceData
 	if err = d.Set("resources", urns); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("resource_count", len(*urns)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -235,7 +243,7 @@ func resourceDigitalOceanProjectUpdate(ctx context.Context, d *schema.ResourceDa
 		IsDefault:   d.Get("is_default"),
 	}
 
-	_, _, err := client.Projects.Update(context.Background(), projectId, projectRequest)
+	_, _, err := client.Projects.Update(ctx, projectId, projectRequest)
 
 	if err != nil {
 		return diag.Errorf("Error updating Project: %s", err)
@@ -247,14 +255,14 @@ func resourceDigitalOceanProjectUpdate(ctx context.Context, d *schema.ResourceDa
 		remove, add := util.GetSetChanges(oldURNs.(*schema.Set), newURNs.(*schema.Set))
 
 		if remove.Len() > 0 {
-			_, err = assignResourcesToDefaultProject(client, remove)
+			_, err = assignResourcesToDefaultProject(ctx, client, remove)
 			if err != nil {
 				return diag.Errorf("Error assigning resources to default project: %s", err)
 			}
 		}
 
 		if add.Len() > 0 {
-			_, err = assignResourcesToProject(client, projectId, add)
+			_, err = assignResourcesToProject(ctx, client, projectId, add)
 			if err != nil {
 				return diag.Errorf("Error Updating project: %s", err)
 			}
@@ -274,7 +282,7 @@ func resourceDigitalOceanProjectDelete(ctx context.Context, d *schema.ResourceDa
 	projectID := d.Id()
 
 	if v, ok := d.GetOk("resources"); ok {
-		_, err := assignResourcesToDefaultProject(client, v.(*schema.Set))
+		_, err := assignResourcesToDefaultProject(ctx, client, v.(*schema.Set))
 		if err != nil {
 			return diag.Errorf("Error assigning resource to default project: %s", err)
 		}
@@ -284,8 +292,8 @@ func resourceDigitalOceanProjectDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Moving resources is async and projects can not be deleted till empty. Retries may be required.
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.Projects.Delete(context.Background(), projectID)
+	err := util.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.Projects.Delete(ctx, projectID)
 		if err != nil {
 			if util.IsDigitalOceanError(err, http.StatusPreconditionFailed, "cannot delete a project with resources") {
 				log.Printf("[DEBUG] Received %s, retrying project deletion", err.Error())
@@ -307,16 +315,16 @@ func resourceDigitalOceanProjectDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func assignResourcesToDefaultProject(client *godo.Client, resources *schema.Set) (*[]interface{}, error) {
-	defaultProject, _, defaultProjErr := client.Projects.GetDefault(context.Background())
+func assignResourcesToDefaultProject(ctx context.Context, client *godo.Client, resources *schema.Set) (*[]interface{}, error) {
+	defaultProject, _, defaultProjErr := client.Projects.GetDefault(ctx)
 	if defaultProjErr != nil {
 		return nil, fmt.Errorf("Error locating default project %s", defaultProjErr)
 	}
 
-	return assignResourcesToProject(client, defaultProject.ID, resources)
+	return assignResourcesToProject(ctx, client, defaultProject.ID, resources)
 }
 
-func assignResourcesToProject(client *godo.Client, projectID string, resources *schema.Set) (*[]interface{}, error) {
+func assignResourcesToProject(ctx context.Context, client *godo.Client, projectID string, resources *schema.Set) (*[]interface{}, error) {
 	var urns []interface{}
 
 	for _, resource := range resources.List() {
@@ -331,7 +339,7 @@ func assignResourcesToProject(client *godo.Client, projectID string, resources *
 		urns = append(urns, resource.(string))
 	}
 
-	_, _, err := client.Projects.AssignResources(context.Background(), projectID, urns...)
+	_, _, err := client.Projects.AssignResources(ctx, projectID, urns...)
 	if err != nil {
 		return nil, fmt.Errorf("Error assigning resources: %s", err)
 	}
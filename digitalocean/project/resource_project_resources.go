@@ -40,7 +40,7 @@ func resourceDigitalOceanProjectResourcesUpdate(ctx context.Context, d *schema.R
 
 	projectId := d.Get("project").(string)
 
-	_, resp, err := client.Projects.Get(context.Background(), projectId)
+	_, resp, err := client.Projects.Get(ctx, projectId)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			// Project does not exist. Mark this resource as not existing.
@@ -56,14 +56,14 @@ func resourceDigitalOceanProjectResourcesUpdate(ctx context.Context, d *schema.R
 		remove, add := util.GetSetChanges(oldURNs.(*schema.Set), newURNs.(*schema.Set))
 
 		if remove.Len() > 0 {
-			_, err = assignResourcesToDefaultProject(client, remove)
+			_, err = assignResourcesToDefaultProject(ctx, client, remove)
 			if err != nil {
 				return diag.Errorf("Error assigning resources to default project: %s", err)
 			}
 		}
 
 		if add.Len() > 0 {
-			_, err = assignResourcesToProject(client, projectId, add)
+			_, err = assignResourcesToProject(ctx, client, projectId, add)
 			if err != nil {
 				return diag.Errorf("Error assigning resources to project %s: %s", projectId, err)
 			}
@@ -84,7 +84,7 @@ func resourceDigitalOceanProjectResourcesRead(ctx context.Context, d *schema.Res
 
 	projectId := d.Id()
 
-	_, resp, err := client.Projects.Get(context.Background(), projectId)
+	_, resp, err := client.Projects.Get(ctx, projectId)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			// Project does not exist. Mark this resource as not existing.
@@ -99,7 +99,7 @@ func resourceDigitalOceanProjectResourcesRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	apiURNs, err := LoadResourceURNs(client, projectId)
+	apiURNs, err := LoadResourceURNs(ctx, client, projectId)
 	if err != nil {
 		return diag.Errorf("Error while retrieving project resources: %s", err)
 	}
@@ -130,7 +130,7 @@ func resourceDigitalOceanProjectResourcesDelete(ctx context.Context, d *schema.R
 	projectId := d.Get("project").(string)
 	urns := d.Get("resources").(*schema.Set)
 
-	_, resp, err := client.Projects.Get(context.Background(), projectId)
+	_, resp, err := client.Projects.Get(ctx, projectId)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			// Project does not exist. Mark this resource as not existing.
@@ -142,7 +142,7 @@ func resourceDigitalOceanProjectResourcesDelete(ctx context.Context, d *schema.R
 	}
 
 	if urns.Len() > 0 {
-		if _, err = assignResourcesToDefaultProject(client, urns); err != nil {
+		if _, err = assignResourcesToDefaultProject(ctx, client, urns); err != nil {
 			return diag.Errorf("Error assigning resources to default project: %s", err)
 		}
 	}
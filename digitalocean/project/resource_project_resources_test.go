@@ -89,7 +89,7 @@ func testProjectMembershipCount(name string, expectedCount int) resource.TestChe
 			return fmt.Errorf("project attribute not set")
 		}
 
-		resources, err := project.LoadResourceURNs(client, projectId)
+		resources, err := project.LoadResourceURNs(context.Background(), client, projectId)
 		if err != nil {
 			return fmt.Errorf("Error retrieving project resources: %s", err)
 		}
@@ -223,6 +223,7 @@ func TestAccDigitalOceanProject_CreateWithDropletResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "1"),
 				),
 			},
 			{
@@ -235,6 +236,7 @@ func TestAccDigitalOceanProject_CreateWithDropletResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "0"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "0"),
 				),
 			},
 		},
@@ -283,6 +285,7 @@ func TestAccDigitalOceanProject_UpdateWithDropletResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "1"),
 				),
 			},
 			{
@@ -295,6 +298,7 @@ func TestAccDigitalOceanProject_UpdateWithDropletResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "0"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "0"),
 				),
 			},
 		},
@@ -321,6 +325,7 @@ func TestAccDigitalOceanProject_UpdateFromDropletToSpacesResource(t *testing.T)
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "1"),
 					resource.TestCheckResourceAttrSet("digitalocean_droplet.foobar", "urn"),
 				),
 			},
@@ -335,6 +340,7 @@ func TestAccDigitalOceanProject_UpdateFromDropletToSpacesResource(t *testing.T)
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", expectedName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "1"),
 					resource.TestCheckResourceAttrSet("digitalocean_spaces_bucket.foobar", "urn"),
 				),
 			},
@@ -364,6 +370,7 @@ func TestAccDigitalOceanProject_WithManyResources(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_project.myproj", "name", projectName),
 					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resources.#", "10"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "resource_count", "10"),
 				),
 			},
 		},
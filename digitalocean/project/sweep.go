@@ -39,7 +39,7 @@ func sweepProjects(region string) error {
 	}
 
 	for _, p := range projects {
-		if strings.HasPrefix(p.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(p.Name, sweep.NamePrefix()) {
 			log.Printf("[DEBUG] Destroying project %s", p.Name)
 
 			resp, err := client.Projects.Delete(context.Background(), p.ID)
@@ -1,6 +1,9 @@
 package digitalocean
 
 import (
+	"regexp"
+
+	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/account"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/app"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/cdn"
@@ -10,10 +13,13 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/genai"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/loadbalancer"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/monitoring"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/oneclick"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/region"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/registry"
@@ -22,13 +28,16 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/snapshot"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/spaces"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/sshkey"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/status"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/uptime"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/urn"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/volume"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpc"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/vpcpeering"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a schema.Provider for DigitalOcean.
@@ -42,6 +51,7 @@ func Provider() *schema.Provider {
 					"DIGITALOCEAN_TOKEN",
 					"DIGITALOCEAN_ACCESS_TOKEN",
 				}, nil),
+				Sensitive:   true,
 				Description: "The token key for API operations.",
 			},
 			"api_endpoint": {
@@ -66,6 +76,7 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SPACES_SECRET_ACCESS_KEY", nil),
+				Sensitive:   true,
 				Description: "The secret access key for Spaces API operations.",
 			},
 			"requests_per_second": {
@@ -92,49 +103,140 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_WAIT_MAX", 30.0),
 				Description: "The maximum wait time (in seconds) between failed API requests.",
 			},
+			"api_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_API_TIMEOUT", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum time (in seconds) to wait for the response to a single API request, or 0 to only rely on the timeouts of the resources.",
+			},
+			"action_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_ACTION_CONCURRENCY", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of Droplet actions to run concurrently. When set, actions against the same Droplet are serialized across resources.",
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_PAGE_SIZE", 200),
+				ValidateFunc: validation.IntBetween(1, 200),
+				Description:  "The number of items requested per page by list API requests.",
+			},
+			"preflight_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_PREFLIGHT_CHECK", "off"),
+				ValidateFunc: validation.StringInSlice([]string{"off", "fail", "wait"}, false),
+				Description:  "Whether to check the status of the DigitalOcean platform before any API request, failing (fail) or waiting (wait) while a major incident is ongoing.",
+			},
+			"preflight_status_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_PREFLIGHT_STATUS_URL", config.DefaultStatusURL),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The URL of the status page API checked by preflight_check.",
+			},
+			"preflight_wait_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_PREFLIGHT_WAIT_TIMEOUT", 900),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum time (in seconds) to wait for an incident to be resolved when preflight_check is wait.",
+			},
+			"resource_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_RESOURCE_NAME_PREFIX", ""),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]*$`), "must contain only lowercase letters, numbers, and hyphens"),
+				Description:  "A prefix added to the names of the Droplets, Volumes, Load Balancers, Firewalls, and VPCs created by the provider, e.g. to tell apart the resources of ephemeral stacks sharing an account.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_READ_ONLY", false),
+				Description: "If true, the provider returns an error before making any change to infrastructure. Plans and refreshes are unaffected.",
+			},
+			"exclude_sensitive_outputs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_EXCLUDE_SENSITIVE_OUTPUTS", false),
+				Description: "If true, computed sensitive attributes such as passwords, connection URIs, and credentials are not stored in the state.",
+			},
+			"create_missing_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_CREATE_MISSING_TAGS", false),
+				Description: "If true, tags referenced by resources such as firewalls and load balancers are created if they do not exist.",
+			},
+			"metrics_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_METRICS_ENDPOINT", ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The OTLP/HTTP endpoint to export anonymous telemetry of the operations and API requests of the provider to as OpenTelemetry traces, e.g. http://localhost:4318/v1/traces.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"digitalocean_account":                  account.DataSourceDigitalOceanAccount(),
-			"digitalocean_app":                      app.DataSourceDigitalOceanApp(),
-			"digitalocean_certificate":              certificate.DataSourceDigitalOceanCertificate(),
-			"digitalocean_container_registry":       registry.DataSourceDigitalOceanContainerRegistry(),
-			"digitalocean_database_cluster":         database.DataSourceDigitalOceanDatabaseCluster(),
-			"digitalocean_database_connection_pool": database.DataSourceDigitalOceanDatabaseConnectionPool(),
-			"digitalocean_database_ca":              database.DataSourceDigitalOceanDatabaseCA(),
-			"digitalocean_database_replica":         database.DataSourceDigitalOceanDatabaseReplica(),
-			"digitalocean_database_user":            database.DataSourceDigitalOceanDatabaseUser(),
-			"digitalocean_domain":                   domain.DataSourceDigitalOceanDomain(),
-			"digitalocean_domains":                  domain.DataSourceDigitalOceanDomains(),
-			"digitalocean_droplet":                  droplet.DataSourceDigitalOceanDroplet(),
-			"digitalocean_droplets":                 droplet.DataSourceDigitalOceanDroplets(),
-			"digitalocean_droplet_snapshot":         snapshot.DataSourceDigitalOceanDropletSnapshot(),
-			"digitalocean_firewall":                 firewall.DataSourceDigitalOceanFirewall(),
-			"digitalocean_floating_ip":              reservedip.DataSourceDigitalOceanFloatingIP(),
-			"digitalocean_image":                    image.DataSourceDigitalOceanImage(),
-			"digitalocean_images":                   image.DataSourceDigitalOceanImages(),
-			"digitalocean_kubernetes_cluster":       kubernetes.DataSourceDigitalOceanKubernetesCluster(),
-			"digitalocean_kubernetes_versions":      kubernetes.DataSourceDigitalOceanKubernetesVersions(),
-			"digitalocean_loadbalancer":             loadbalancer.DataSourceDigitalOceanLoadbalancer(),
-			"digitalocean_project":                  project.DataSourceDigitalOceanProject(),
-			"digitalocean_projects":                 project.DataSourceDigitalOceanProjects(),
-			"digitalocean_record":                   domain.DataSourceDigitalOceanRecord(),
-			"digitalocean_records":                  domain.DataSourceDigitalOceanRecords(),
-			"digitalocean_region":                   region.DataSourceDigitalOceanRegion(),
-			"digitalocean_regions":                  region.DataSourceDigitalOceanRegions(),
-			"digitalocean_reserved_ip":              reservedip.DataSourceDigitalOceanReservedIP(),
-			"digitalocean_sizes":                    size.DataSourceDigitalOceanSizes(),
-			"digitalocean_spaces_bucket":            spaces.DataSourceDigitalOceanSpacesBucket(),
-			"digitalocean_spaces_buckets":           spaces.DataSourceDigitalOceanSpacesBuckets(),
-			"digitalocean_spaces_bucket_object":     spaces.DataSourceDigitalOceanSpacesBucketObject(),
-			"digitalocean_spaces_bucket_objects":    spaces.DataSourceDigitalOceanSpacesBucketObjects(),
-			"digitalocean_ssh_key":                  sshkey.DataSourceDigitalOceanSSHKey(),
-			"digitalocean_ssh_keys":                 sshkey.DataSourceDigitalOceanSSHKeys(),
-			"digitalocean_tag":                      tag.DataSourceDigitalOceanTag(),
-			"digitalocean_tags":                     tag.DataSourceDigitalOceanTags(),
-			"digitalocean_volume_snapshot":          snapshot.DataSourceDigitalOceanVolumeSnapshot(),
-			"digitalocean_volume":                   volume.DataSourceDigitalOceanVolume(),
-			"digitalocean_vpc":                      vpc.DataSourceDigitalOceanVPC(),
-			"digitalocean_vpc_peering":              vpcpeering.DataSourceDigitalOceanVPCPeering(),
+			"digitalocean_1click_apps":               oneclick.DataSourceDigitalOceanOneClickApps(),
+			"digitalocean_account":                   account.DataSourceDigitalOceanAccount(),
+			"digitalocean_app":                       app.DataSourceDigitalOceanApp(),
+			"digitalocean_app_instance_sizes":        app.DataSourceDigitalOceanAppInstanceSizes(),
+			"digitalocean_certificate":               certificate.DataSourceDigitalOceanCertificate(),
+			"digitalocean_container_registry":        registry.DataSourceDigitalOceanContainerRegistry(),
+			"digitalocean_database_cluster":          database.DataSourceDigitalOceanDatabaseCluster(),
+			"digitalocean_database_connection_pool":  database.DataSourceDigitalOceanDatabaseConnectionPool(),
+			"digitalocean_database_connection_pools": database.DataSourceDigitalOceanDatabaseConnectionPools(),
+			"digitalocean_database_ca":               database.DataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_replica":          database.DataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_user":             database.DataSourceDigitalOceanDatabaseUser(),
+			"digitalocean_domain":                    domain.DataSourceDigitalOceanDomain(),
+			"digitalocean_domains":                   domain.DataSourceDigitalOceanDomains(),
+			"digitalocean_droplet":                   droplet.DataSourceDigitalOceanDroplet(),
+			"digitalocean_droplet_actions":           droplet.DataSourceDigitalOceanDropletActions(),
+			"digitalocean_droplet_neighbors":         droplet.DataSourceDigitalOceanDropletNeighbors(),
+			"digitalocean_droplets":                  droplet.DataSourceDigitalOceanDroplets(),
+			"digitalocean_droplet_snapshot":          snapshot.DataSourceDigitalOceanDropletSnapshot(),
+			"digitalocean_droplet_snapshots":         snapshot.DataSourceDigitalOceanDropletSnapshots(),
+			"digitalocean_firewall":                  firewall.DataSourceDigitalOceanFirewall(),
+			"digitalocean_firewalls":                 firewall.DataSourceDigitalOceanFirewalls(),
+			"digitalocean_functions_namespaces":      functions.DataSourceDigitalOceanFunctionsNamespaces(),
+			"digitalocean_floating_ip":               reservedip.DataSourceDigitalOceanFloatingIP(),
+			"digitalocean_genai_models":              genai.DataSourceDigitalOceanGenAIModels(),
+			"digitalocean_image":                     image.DataSourceDigitalOceanImage(),
+			"digitalocean_images":                    image.DataSourceDigitalOceanImages(),
+			"digitalocean_kubernetes_cluster":        kubernetes.DataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_versions":       kubernetes.DataSourceDigitalOceanKubernetesVersions(),
+			"digitalocean_loadbalancer":              loadbalancer.DataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_project":                   project.DataSourceDigitalOceanProject(),
+			"digitalocean_projects":                  project.DataSourceDigitalOceanProjects(),
+			"digitalocean_project_resources":         project.DataSourceDigitalOceanProjectResources(),
+			"digitalocean_record":                    domain.DataSourceDigitalOceanRecord(),
+			"digitalocean_records":                   domain.DataSourceDigitalOceanRecords(),
+			"digitalocean_region":                    region.DataSourceDigitalOceanRegion(),
+			"digitalocean_regions":                   region.DataSourceDigitalOceanRegions(),
+			"digitalocean_reserved_ip":               reservedip.DataSourceDigitalOceanReservedIP(),
+			"digitalocean_reserved_ips":              reservedip.DataSourceDigitalOceanReservedIPs(),
+			"digitalocean_sizes":                     size.DataSourceDigitalOceanSizes(),
+			"digitalocean_spaces_bucket":             spaces.DataSourceDigitalOceanSpacesBucket(),
+			"digitalocean_spaces_buckets":            spaces.DataSourceDigitalOceanSpacesBuckets(),
+			"digitalocean_spaces_bucket_object":      spaces.DataSourceDigitalOceanSpacesBucketObject(),
+			"digitalocean_spaces_bucket_objects":     spaces.DataSourceDigitalOceanSpacesBucketObjects(),
+			"digitalocean_spaces_presigned_url":      spaces.DataSourceDigitalOceanSpacesPresignedURL(),
+			"digitalocean_ssh_key":                   sshkey.DataSourceDigitalOceanSSHKey(),
+			"digitalocean_ssh_keys":                  sshkey.DataSourceDigitalOceanSSHKeys(),
+			"digitalocean_status":                    status.DataSourceDigitalOceanStatus(),
+			"digitalocean_tag":                       tag.DataSourceDigitalOceanTag(),
+			"digitalocean_tags":                      tag.DataSourceDigitalOceanTags(),
+			"digitalocean_uptime_alerts":             uptime.DataSourceDigitalOceanUptimeAlerts(),
+			"digitalocean_uptime_checks":             uptime.DataSourceDigitalOceanUptimeChecks(),
+			"digitalocean_urn":                       urn.DataSourceDigitalOceanURN(),
+			"digitalocean_volume_snapshot":           snapshot.DataSourceDigitalOceanVolumeSnapshot(),
+			"digitalocean_volume_snapshots":          snapshot.DataSourceDigitalOceanVolumeSnapshots(),
+			"digitalocean_volume":                    volume.DataSourceDigitalOceanVolume(),
+			"digitalocean_vpc":                       vpc.DataSourceDigitalOceanVPC(),
+			"digitalocean_vpc_peering":               vpcpeering.DataSourceDigitalOceanVPCPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -153,12 +255,17 @@ func Provider() *schema.Provider {
 			"digitalocean_database_postgresql_config":            database.ResourceDigitalOceanDatabasePostgreSQLConfig(),
 			"digitalocean_database_mysql_config":                 database.ResourceDigitalOceanDatabaseMySQLConfig(),
 			"digitalocean_database_kafka_topic":                  database.ResourceDigitalOceanDatabaseKafkaTopic(),
+			"digitalocean_database_kafka_topic_retention":        database.ResourceDigitalOceanDatabaseKafkaTopicRetention(),
 			"digitalocean_domain":                                domain.ResourceDigitalOceanDomain(),
 			"digitalocean_droplet":                               droplet.ResourceDigitalOceanDroplet(),
 			"digitalocean_droplet_snapshot":                      snapshot.ResourceDigitalOceanDropletSnapshot(),
 			"digitalocean_firewall":                              firewall.ResourceDigitalOceanFirewall(),
+			"digitalocean_firewall_rule_set":                     firewall.ResourceDigitalOceanFirewallRuleSet(),
 			"digitalocean_floating_ip":                           reservedip.ResourceDigitalOceanFloatingIP(),
 			"digitalocean_floating_ip_assignment":                reservedip.ResourceDigitalOceanFloatingIPAssignment(),
+			"digitalocean_genai_agent":                           genai.ResourceDigitalOceanGenAIAgent(),
+			"digitalocean_genai_knowledge_base":                  genai.ResourceDigitalOceanGenAIKnowledgeBase(),
+			"digitalocean_kubernetes_addon":                      kubernetes.ResourceDigitalOceanKubernetesAddon(),
 			"digitalocean_kubernetes_cluster":                    kubernetes.ResourceDigitalOceanKubernetesCluster(),
 			"digitalocean_kubernetes_node_pool":                  kubernetes.ResourceDigitalOceanKubernetesNodePool(),
 			"digitalocean_loadbalancer":                          loadbalancer.ResourceDigitalOceanLoadbalancer(),
@@ -168,8 +275,10 @@ func Provider() *schema.Provider {
 			"digitalocean_record":                                domain.ResourceDigitalOceanRecord(),
 			"digitalocean_reserved_ip":                           reservedip.ResourceDigitalOceanReservedIP(),
 			"digitalocean_reserved_ip_assignment":                reservedip.ResourceDigitalOceanReservedIPAssignment(),
+			"digitalocean_reserved_ip_failover":                  reservedip.ResourceDigitalOceanReservedIPFailover(),
 			"digitalocean_spaces_bucket":                         spaces.ResourceDigitalOceanBucket(),
 			"digitalocean_spaces_bucket_cors_configuration":      spaces.ResourceDigitalOceanBucketCorsConfiguration(),
+			"digitalocean_spaces_bucket_directory":               spaces.ResourceDigitalOceanSpacesBucketDirectory(),
 			"digitalocean_spaces_bucket_object":                  spaces.ResourceDigitalOceanSpacesBucketObject(),
 			"digitalocean_spaces_bucket_policy":                  spaces.ResourceDigitalOceanSpacesBucketPolicy(),
 			"digitalocean_ssh_key":                               sshkey.ResourceDigitalOceanSSHKey(),
@@ -178,27 +287,66 @@ func Provider() *schema.Provider {
 			"digitalocean_uptime_alert":                          uptime.ResourceDigitalOceanUptimeAlert(),
 			"digitalocean_volume":                                volume.ResourceDigitalOceanVolume(),
 			"digitalocean_volume_attachment":                     volume.ResourceDigitalOceanVolumeAttachment(),
+			"digitalocean_volume_attachments":                    volume.ResourceDigitalOceanVolumeAttachments(),
 			"digitalocean_volume_snapshot":                       snapshot.ResourceDigitalOceanVolumeSnapshot(),
 			"digitalocean_vpc":                                   vpc.ResourceDigitalOceanVPC(),
 			"digitalocean_vpc_peering":                           vpcpeering.ResourceDigitalOceanVPCPeering(),
 			"digitalocean_custom_image":                          image.ResourceDigitalOceanCustomImage(),
+			"digitalocean_image_retention_policy":                image.ResourceDigitalOceanImageRetentionPolicy(),
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		guardReadOnly(name, r)
+		excludeSensitiveOutputs(r)
+		recordTelemetry(name, r)
+		reportRequestIDs(r)
+	}
+
+	for name, r := range p.DataSourcesMap {
+		excludeSensitiveOutputs(r)
+		recordTelemetry(name, r)
+		reportRequestIDs(r)
+	}
+
 	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
-		terraformVersion := p.TerraformVersion
-		if terraformVersion == "" {
-			// Terraform 0.12 introduced this field to the protocol
-			// We can therefore assume that if it's missing it's 0.10 or 0.11
-			terraformVersion = "0.11+compatible"
-		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(d, providerTerraformVersion(p))
 	}
 
 	return p
 }
 
+// ProviderWithGodoClient returns the provider making all its API requests with
+// the given godo client, e.g. to embed it in other tools or test harnesses
+// controlling the HTTP layer. See config.NewCombinedConfig for the provider
+// settings which are then ignored. The transport of the client is left as is,
+// so the request IDs of failed requests are not reported in errors.
+func ProviderWithGodoClient(client *godo.Client) *schema.Provider {
+	p := Provider()
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		conf := providerConfig(d, providerTerraformVersion(p))
+		return config.NewCombinedConfig(&conf, client)
+	}
+
+	return p
+}
+
+func providerTerraformVersion(p *schema.Provider) string {
+	if p.TerraformVersion == "" {
+		// Terraform 0.12 introduced this field to the protocol
+		// We can therefore assume that if it's missing it's 0.10 or 0.11
+		return "0.11+compatible"
+	}
+	return p.TerraformVersion
+}
+
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+	conf := providerConfig(d, terraformVersion)
+
+	return conf.Client()
+}
+
+func providerConfig(d *schema.ResourceData, terraformVersion string) config.Config {
 	conf := config.Config{
 		Token:             d.Get("token").(string),
 		APIEndpoint:       d.Get("api_endpoint").(string),
@@ -208,12 +356,26 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		HTTPRetryMax:      d.Get("http_retry_max").(int),
 		HTTPRetryWaitMin:  d.Get("http_retry_wait_min").(float64),
 		HTTPRetryWaitMax:  d.Get("http_retry_wait_max").(float64),
-		TerraformVersion:  terraformVersion,
+		APITimeout:        d.Get("api_timeout").(int),
+		ActionConcurrency: d.Get("action_concurrency").(int),
+		ReadOnly:          d.Get("read_only").(bool),
+		PageSize:          d.Get("page_size").(int),
+
+		PreflightCheck:       d.Get("preflight_check").(string),
+		PreflightStatusURL:   d.Get("preflight_status_url").(string),
+		PreflightWaitTimeout: d.Get("preflight_wait_timeout").(int),
+
+		ResourceNamePrefix: d.Get("resource_name_prefix").(string),
+
+		ExcludeSensitiveOutputs: d.Get("exclude_sensitive_outputs").(bool),
+		CreateMissingTags:       d.Get("create_missing_tags").(bool),
+		MetricsEndpoint:         d.Get("metrics_endpoint").(string),
+		TerraformVersion:        terraformVersion,
 	}
 
 	if endpoint, ok := d.GetOk("spaces_endpoint"); ok {
 		conf.SpacesAPIEndpoint = endpoint.(string)
 	}
 
-	return conf.Client()
+	return conf
 }
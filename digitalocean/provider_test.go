@@ -2,9 +2,13 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -70,6 +74,30 @@ func TestURLDefault(t *testing.T) {
 	}
 }
 
+func TestResourceNamePrefix(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                "12345",
+		"resource_name_prefix": "stack-1-",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	combined := rawProvider.Meta().(*config.CombinedConfig)
+	if name := combined.PrefixName("web"); name != "stack-1-web" {
+		t.Fatalf("Expected %s, got %s", "stack-1-web", name)
+	}
+	if name := combined.UnprefixName("stack-1-web"); name != "web" {
+		t.Fatalf("Expected %s, got %s", "web", name)
+	}
+	if name := combined.UnprefixName("other-web"); name != "other-web" {
+		t.Fatalf("Expected %s, got %s", "other-web", name)
+	}
+}
+
 func diagnosticsToString(diags diag.Diagnostics) string {
 	diagsAsStrings := make([]string, len(diags))
 	for i, diag := range diags {
@@ -143,3 +171,386 @@ func TestSpaceAPIEndpointOverride(t *testing.T) {
 		t.Fatalf("Expected %s, got %s", expectedEndpoint, *client.Config.Endpoint)
 	}
 }
+
+func TestReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected API request in read-only mode: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":        "12345",
+		"api_endpoint": server.URL,
+		"read_only":    true,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	meta := rawProvider.Meta()
+	if !meta.(*config.CombinedConfig).ReadOnly() {
+		t.Fatal("Expected provider to be read-only")
+	}
+
+	r := rawProvider.ResourcesMap["digitalocean_ssh_key"]
+	d := r.TestResourceData()
+	d.SetId("1234")
+
+	operations := map[string]func() diag.Diagnostics{
+		"create": func() diag.Diagnostics { return r.CreateContext(context.Background(), d, meta) },
+		"update": func() diag.Diagnostics { return r.UpdateContext(context.Background(), d, meta) },
+		"delete": func() diag.Diagnostics { return r.DeleteContext(context.Background(), d, meta) },
+	}
+
+	for name, op := range operations {
+		diags := op()
+		if !diags.HasError() || !strings.Contains(diags[0].Detail, "read_only = true") {
+			t.Errorf("Expected %s to fail with a read-only error, got: %v", name, diags)
+		}
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	var perPage []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perPage = append(perPage, r.URL.Query().Get("per_page"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"regions": [], "account": {}}`))
+	}))
+	defer server.Close()
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":        "12345",
+		"api_endpoint": server.URL,
+		"page_size":    50,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := rawProvider.Meta().(*config.CombinedConfig).GodoClient()
+	if _, _, err := client.Regions.List(context.Background(), &godo.ListOptions{Page: 1, PerPage: 200}); err != nil {
+		t.Fatalf("Unexpected error listing regions: %s", err)
+	}
+	if _, _, err := client.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Unexpected error getting account: %s", err)
+	}
+
+	if len(perPage) != 2 || perPage[0] != "50" || perPage[1] != "" {
+		t.Fatalf("Expected per_page to be set to 50 for the list request only, got %q", perPage)
+	}
+}
+
+type countingTransport struct {
+	requests int
+	base     http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return t.base.RoundTrip(req)
+}
+
+func TestProviderWithGodoClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"account": {"uuid": "fake"}}`))
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{base: http.DefaultTransport}
+	godoClient, err := godo.New(&http.Client{Transport: transport}, godo.SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rawProvider := ProviderWithGodoClient(godoClient)
+	raw := map[string]interface{}{
+		"resource_name_prefix": "ci-",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	meta := rawProvider.Meta().(*config.CombinedConfig)
+	if meta.GodoClient() != godoClient {
+		t.Fatalf("Expected the provider to use the given godo client")
+	}
+	if name := meta.PrefixName("web"); name != "ci-web" {
+		t.Fatalf("Expected the provider settings to be applied, got name %q", name)
+	}
+
+	account, _, err := meta.GodoClient().Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error getting account: %s", err)
+	}
+	if account.UUID != "fake" || transport.requests != 1 {
+		t.Fatalf("Expected the request to be made with the given transport, got account %q and %d requests", account.UUID, transport.requests)
+	}
+}
+
+func TestAPITimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"account": {}}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":          "12345",
+		"api_endpoint":   server.URL,
+		"api_timeout":    1,
+		"http_retry_max": 0,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := rawProvider.Meta().(*config.CombinedConfig).GodoClient()
+	if _, _, err := client.Account.Get(context.Background()); err == nil || !strings.Contains(err.Error(), "Timeout") {
+		t.Fatalf("Expected the request to time out, got: %v", err)
+	}
+}
+
+func TestPreflightCheck(t *testing.T) {
+	indicator := "none"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status": {"indicator": %q, "description": "Test status"}}`, indicator)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		mode      string
+		indicator string
+		expectErr bool
+	}{
+		{"fail", "none", false},
+		{"fail", "minor", false},
+		{"fail", "major", true},
+		{"fail", "critical", true},
+		{"off", "critical", false},
+		{"wait", "critical", true},
+	}
+
+	for _, c := range cases {
+		indicator = c.indicator
+
+		rawProvider := Provider()
+		raw := map[string]interface{}{
+			"token":                  "12345",
+			"preflight_check":        c.mode,
+			"preflight_status_url":   server.URL,
+			"preflight_wait_timeout": 0,
+		}
+
+		diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+		if diags.HasError() != c.expectErr {
+			t.Errorf("preflight_check %s with status %s: expected error to be %t, got %s", c.mode, c.indicator, c.expectErr, diagnosticsToString(diags))
+		}
+	}
+}
+
+func TestExcludeSensitiveOutputs(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"config": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"token": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.Set("password", "secret")
+			d.Set("config", []interface{}{
+				map[string]interface{}{"host": "https://example.com", "token": "secret"},
+			})
+			return nil
+		},
+	}
+	excludeSensitiveOutputs(r)
+
+	for _, exclude := range []bool{false, true} {
+		rawProvider := Provider()
+		raw := map[string]interface{}{
+			"token":                     "12345",
+			"exclude_sensitive_outputs": exclude,
+		}
+
+		diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+		if diags.HasError() {
+			t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+		}
+
+		d := r.TestResourceData()
+		d.SetId("1234")
+		d.Set("name", "example")
+
+		if diags := r.ReadContext(context.Background(), d, rawProvider.Meta()); diags.HasError() {
+			t.Fatalf("read failed: %s", diagnosticsToString(diags))
+		}
+
+		expected := "secret"
+		if exclude {
+			expected = ""
+		}
+
+		if password := d.Get("password").(string); password != expected {
+			t.Errorf("exclude_sensitive_outputs = %t: expected password %q, got %q", exclude, expected, password)
+		}
+
+		if token := d.Get("config.0.token").(string); token != expected {
+			t.Errorf("exclude_sensitive_outputs = %t: expected token %q, got %q", exclude, expected, token)
+		}
+
+		if host := d.Get("config.0.host").(string); host != "https://example.com" {
+			t.Errorf("exclude_sensitive_outputs = %t: expected host to be kept, got %q", exclude, host)
+		}
+
+		if name := d.Get("name").(string); name != "example" {
+			t.Errorf("exclude_sensitive_outputs = %t: expected name to be kept, got %q", exclude, name)
+		}
+	}
+}
+
+func TestReportRequestIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-request-id", "req-"+strings.TrimPrefix(r.URL.Path, "/v2/droplets/"))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"id": "unprocessable_entity", "message": "Droplet is locked"}`))
+	}))
+	defer server.Close()
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":        "12345",
+		"api_endpoint": server.URL,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"quote_error": {Type: schema.TypeBool, Optional: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			client := meta.(*config.CombinedConfig).GodoClient()
+			_, resp, err := client.Droplets.Get(ctx, 1)
+			if d.Get("quote_error").(bool) {
+				return diag.Errorf("Error retrieving droplet: %s", err)
+			}
+			return diag.Errorf("Error retrieving droplet: unexpected status %d", resp.StatusCode)
+		},
+	}
+	reportRequestIDs(r)
+
+	for _, quoteError := range []bool{false, true} {
+		d := r.TestResourceData()
+		d.SetId("1")
+		d.Set("quote_error", quoteError)
+
+		diags := r.ReadContext(context.Background(), d, rawProvider.Meta())
+		if len(diags) != 1 || !diags.HasError() {
+			t.Fatalf("quote_error = %t: expected an error, got %v", quoteError, diags)
+		}
+
+		message := diags[0].Summary + " " + diags[0].Detail
+		if count := strings.Count(message, "req-1"); count != 1 {
+			t.Errorf("quote_error = %t: expected the request ID to be mentioned once, got %q", quoteError, message)
+		}
+		if !quoteError && diags[0].Detail != "DigitalOcean API request ID: req-1" {
+			t.Errorf("Expected the request ID in the detail, got %q", diags[0].Detail)
+		}
+	}
+}
+
+func TestReportRequestIDsResetOnSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-request-id", "req-"+strings.TrimPrefix(r.URL.Path, "/v2/droplets/"))
+		if r.URL.Path == "/v2/droplets/1" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"id": "not_found", "message": "The resource you were accessing could not be found."}`))
+			return
+		}
+		w.Write([]byte(`{"droplet": {"id": 2}}`))
+	}))
+	defer server.Close()
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":        "12345",
+		"api_endpoint": server.URL,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			client := meta.(*config.CombinedConfig).GodoClient()
+			// The 404 is tolerated, the operation fails after the next request.
+			client.Droplets.Get(ctx, 1)
+			if _, _, err := client.Droplets.Get(ctx, 2); err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.Errorf("Error reading droplet: invalid configuration")
+		},
+	}
+	reportRequestIDs(r)
+
+	d := r.TestResourceData()
+	d.SetId("2")
+
+	diags = r.ReadContext(context.Background(), d, rawProvider.Meta())
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("Expected an error, got %v", diags)
+	}
+	if diags[0].Detail != "" {
+		t.Errorf("Expected no request ID once a later request succeeded, got %q", diags[0].Detail)
+	}
+}
@@ -0,0 +1,41 @@
+package digitalocean
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// guardReadOnly wraps the create, update, and delete functions of the resource
+// so that they return an error before calling the API when the provider is
+// configured with read_only = true.
+func guardReadOnly(name string, r *schema.Resource) {
+	wrap := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if c, ok := meta.(*config.CombinedConfig); ok && c.ReadOnly() {
+				return diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  "Provider is read-only",
+						Detail: "The DigitalOcean provider is configured with read_only = true, so " + name +
+							" can not be " + operation + ". Disable read_only to apply changes.",
+					},
+				}
+			}
+
+			return f(ctx, d, meta)
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap("created", r.CreateContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap("updated", r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap("deleted", r.DeleteContext)
+	}
+}
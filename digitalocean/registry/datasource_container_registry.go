@@ -3,6 +3,8 @@ package registry
 import (
 	"context"
 
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,31 @@ func DataSourceDigitalOceanContainerRegistry() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subscription_tier_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the name of the subscription tier",
+			},
+			"included_repositories": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the number of repositories included in the subscription tier, 0 if unlimited",
+			},
+			"included_storage_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the amount of storage included in the subscription tier in bytes",
+			},
+			"included_bandwidth_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the amount of outbound data transfer included in the subscription tier in bytes",
+			},
+			"allow_storage_overage": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether storage beyond the included amount is allowed, and billed, by the subscription tier",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -38,6 +65,21 @@ func DataSourceDigitalOceanContainerRegistry() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"storage_usage_bytes_updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the time at which storage_usage_bytes was last updated",
+			},
+			"storage_usage_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "the storage used as a percentage of the storage included in the subscription tier",
+			},
+			"repository_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "the number of repositories in the registry",
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,5 +89,75 @@ func DataSourceDigitalOceanContainerRegistry() *schema.Resource {
 }
 
 func dataSourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceDigitalOceanContainerRegistryRead(ctx, d, meta)
+	client := meta.(*config.CombinedConfig).GodoClient()
+	name := d.Get("name").(string)
+
+	reg, _, err := client.Registry.Get(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	if reg.Name != name {
+		return diag.Errorf("Error retrieving container registry: registry %s not found", name)
+	}
+
+	setContainerRegistryAttributes(d, reg)
+	d.Set("storage_usage_bytes_updated_at", reg.StorageUsageBytesUpdatedAt.UTC().String())
+
+	sub, _, err := client.Registry.GetSubscription(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving container registry subscription: %s", err)
+	}
+
+	storageUsagePercent := 0.0
+	if sub.Tier != nil {
+		d.Set("subscription_tier_slug", sub.Tier.Slug)
+		d.Set("subscription_tier_name", sub.Tier.Name)
+		d.Set("included_repositories", int(sub.Tier.IncludedRepositories))
+		d.Set("included_storage_bytes", int(sub.Tier.IncludedStorageBytes))
+		d.Set("included_bandwidth_bytes", int(sub.Tier.IncludedBandwidthBytes))
+		d.Set("allow_storage_overage", sub.Tier.AllowStorageOverage)
+
+		if sub.Tier.IncludedStorageBytes > 0 {
+			storageUsagePercent = float64(reg.StorageUsageBytes) / float64(sub.Tier.IncludedStorageBytes) * 100
+		}
+	}
+	d.Set("storage_usage_percent", storageUsagePercent)
+
+	count, err := countContainerRegistryRepositories(ctx, client, reg.Name)
+	if err != nil {
+		return diag.Errorf("Error retrieving container registry repositories: %s", err)
+	}
+	d.Set("repository_count", count)
+
+	return nil
+}
+
+func countContainerRegistryRepositories(ctx context.Context, client *godo.Client, registry string) (int, error) {
+	opts := &godo.TokenListOptions{
+		PerPage: 200,
+	}
+
+	count := 0
+	for {
+		repositories, resp, err := client.Registry.ListRepositoriesV2(ctx, registry, opts)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(repositories)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		token, err := resp.Links.NextPageToken()
+		if err != nil {
+			return 0, err
+		}
+
+		opts.Token = token
+	}
+
+	return count, nil
 }
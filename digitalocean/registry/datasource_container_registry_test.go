@@ -50,6 +50,20 @@ data "digitalocean_container_registry" "foobar" {
 						"data.digitalocean_container_registry.foobar", "created_at"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_container_registry.foobar", "storage_usage_bytes"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry.foobar", "subscription_tier_name", "Basic"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry.foobar", "included_repositories", "5"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry.foobar", "included_storage_bytes"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry.foobar", "included_bandwidth_bytes"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry.foobar", "allow_storage_overage"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry.foobar", "storage_usage_percent"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry.foobar", "repository_count", "0"),
 				),
 			},
 		},
@@ -81,7 +81,7 @@ func resourceDigitalOceanContainerRegistryCreate(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[DEBUG] Container Registry create configuration: %#v", opts)
-	reg, _, err := client.Registry.Create(context.Background(), opts)
+	reg, _, err := client.Registry.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating container registry: %s", err)
 	}
@@ -95,7 +95,7 @@ func resourceDigitalOceanContainerRegistryCreate(ctx context.Context, d *schema.
 func resourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	reg, resp, err := client.Registry.Get(context.Background())
+	reg, resp, err := client.Registry.Get(ctx)
 	if err != nil {
 		// If the registry is somehow already destroyed, mark as
 		// successfully gone
@@ -107,15 +107,9 @@ func resourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving container registry: %s", err)
 	}
 
-	d.SetId(reg.Name)
-	d.Set("name", reg.Name)
-	d.Set("region", reg.Region)
-	d.Set("endpoint", fmt.Sprintf("%s/%s", RegistryHostname, reg.Name))
-	d.Set("server_url", RegistryHostname)
-	d.Set("created_at", reg.CreatedAt.UTC().String())
-	d.Set("storage_usage_bytes", reg.StorageUsageBytes)
+	setContainerRegistryAttributes(d, reg)
 
-	sub, _, err := client.Registry.GetSubscription(context.Background())
+	sub, _, err := client.Registry.GetSubscription(ctx)
 	if err != nil {
 		return diag.Errorf("Error retrieving container registry subscription: %s", err)
 	}
@@ -124,6 +118,16 @@ func resourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.Re
 	return nil
 }
 
+func setContainerRegistryAttributes(d *schema.ResourceData, reg *godo.Registry) {
+	d.SetId(reg.Name)
+	d.Set("name", reg.Name)
+	d.Set("region", reg.Region)
+	d.Set("endpoint", fmt.Sprintf("%s/%s", RegistryHostname, reg.Name))
+	d.Set("server_url", RegistryHostname)
+	d.Set("created_at", reg.CreatedAt.UTC().String())
+	d.Set("storage_usage_bytes", reg.StorageUsageBytes)
+}
+
 func resourceDigitalOceanContainerRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 	if d.HasChange("subscription_tier_slug") {
@@ -143,7 +147,7 @@ func resourceDigitalOceanContainerRegistryDelete(ctx context.Context, d *schema.
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting container registry: %s", d.Id())
-	_, err := client.Registry.Delete(context.Background())
+	_, err := client.Registry.Delete(ctx)
 	if err != nil {
 		return diag.Errorf("Error deleting container registry: %s", err)
 	}
@@ -71,7 +71,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsCreate(ctx context.Co
 func resourceDigitalOceanContainerRegistryDockerCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	reg, response, err := client.Registry.Get(context.Background())
+	reg, response, err := client.Registry.Get(ctx)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -85,7 +85,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsRead(ctx context.Cont
 	d.Set("registry_name", reg.Name)
 	d.Set("write", write)
 
-	err = updateExpiredDockerCredentials(d, write, client)
+	err = updateExpiredDockerCredentials(ctx, d, write, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -101,7 +101,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsUpdate(ctx context.Co
 		currentTime := time.Now().UTC()
 		expirationTime := currentTime.Add(time.Second * time.Duration(expirySeconds))
 		d.Set("credential_expiration_time", expirationTime.Format(time.RFC3339))
-		dockerConfigJSON, err := generateDockerCredentials(write, expirySeconds, client)
+		dockerConfigJSON, err := generateDockerCredentials(ctx, write, expirySeconds, client)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -112,7 +112,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsUpdate(ctx context.Co
 			write := d.Get("write").(bool)
 			expirySeconds := d.Get("expiry_seconds").(int)
 			client := meta.(*config.CombinedConfig).GodoClient()
-			dockerConfigJSON, err := generateDockerCredentials(write, expirySeconds, client)
+			dockerConfigJSON, err := generateDockerCredentials(ctx, write, expirySeconds, client)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -180,8 +180,8 @@ func RevokeOAuthToken(token string, endpoint string) error {
 	return err
 }
 
-func generateDockerCredentials(readWrite bool, expirySeconds int, client *godo.Client) (string, error) {
-	dockerCreds, response, err := client.Registry.DockerCredentials(context.Background(), &godo.RegistryDockerCredentialsRequest{ReadWrite: readWrite, ExpirySeconds: &expirySeconds})
+func generateDockerCredentials(ctx context.Context, readWrite bool, expirySeconds int, client *godo.Client) (string, error) {
+	dockerCreds, response, err := client.Registry.DockerCredentials(ctx, &godo.RegistryDockerCredentialsRequest{ReadWrite: readWrite, ExpirySeconds: &expirySeconds})
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return "", fmt.Errorf("docker credentials not found: %s", err)
@@ -192,7 +192,7 @@ func generateDockerCredentials(readWrite bool, expirySeconds int, client *godo.C
 	return dockerConfigJSON, nil
 }
 
-func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, client *godo.Client) error {
+func updateExpiredDockerCredentials(ctx context.Context, d *schema.ResourceData, readWrite bool, client *godo.Client) error {
 	expirySeconds := d.Get("expiry_seconds").(int)
 	expirationTime := d.Get("credential_expiration_time").(string)
 	d.Set("expiry_seconds", expirySeconds)
@@ -205,7 +205,7 @@ func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, clie
 		}
 
 		if expirationTime.Before(currentTime) {
-			dockerConfigJSON, err := generateDockerCredentials(readWrite, expirySeconds, client)
+			dockerConfigJSON, err := generateDockerCredentials(ctx, readWrite, expirySeconds, client)
 			if err != nil {
 				return err
 			}
@@ -217,7 +217,7 @@ func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, clie
 	} else {
 		expirationTime := currentTime.Add(time.Second * time.Duration(expirySeconds))
 		d.Set("credential_expiration_time", expirationTime.Format(time.RFC3339))
-		dockerConfigJSON, err := generateDockerCredentials(readWrite, expirySeconds, client)
+		dockerConfigJSON, err := generateDockerCredentials(ctx, readWrite, expirySeconds, client)
 		if err != nil {
 			return err
 		}
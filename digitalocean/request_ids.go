@@ -0,0 +1,64 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// reportRequestIDs wraps the functions of the resource or data source so that
+// the ID of the API request the operation failed on is added to its error
+// diagnostics, unless they already mention it, to be given to DigitalOcean
+// support. The ID is only known when the failed request is the last one made
+// by the operation.
+func reportRequestIDs(r *schema.Resource) {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, requestID := config.WithRequestID(ctx)
+			diags := f(ctx, d, meta)
+			if !diags.HasError() {
+				return diags
+			}
+
+			return addRequestID(diags, requestID())
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap(r.DeleteContext)
+	}
+}
+
+func addRequestID(diags diag.Diagnostics, id string) diag.Diagnostics {
+	if id == "" {
+		return diags
+	}
+
+	detail := fmt.Sprintf("DigitalOcean API request ID: %s", id)
+	for i, d := range diags {
+		if d.Severity != diag.Error || strings.Contains(d.Summary+" "+d.Detail, id) {
+			continue
+		}
+
+		if d.Detail != "" {
+			diags[i].Detail = d.Detail + "\n\n" + detail
+		} else {
+			diags[i].Detail = detail
+		}
+	}
+
+	return diags
+}
@@ -3,6 +3,7 @@ package reservedip
 import (
 	"context"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,9 +15,11 @@ func DataSourceDigitalOceanReservedIP() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ip_address": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				Description:  "reserved ip address",
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"ip_address", "droplet_id"},
 			},
 			// computed attributes
 			"urn": {
@@ -30,9 +33,12 @@ func DataSourceDigitalOceanReservedIP() *schema.Resource {
 				Description: "the region that the reserved ip is reserved to",
 			},
 			"droplet_id": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "the droplet id that the reserved ip has been assigned to.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "the droplet id that the reserved ip has been assigned to.",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"ip_address", "droplet_id"},
 			},
 		},
 	}
@@ -40,7 +46,32 @@ func DataSourceDigitalOceanReservedIP() *schema.Resource {
 
 func dataSourceDigitalOceanReservedIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ipAddress := d.Get("ip_address").(string)
+
+	if v, ok := d.GetOk("droplet_id"); ok {
+		ips, err := getDigitalOceanReservedIPs(meta, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		ip := findReservedIPByDropletID(ips, v.(int))
+		if ip == nil {
+			return diag.Errorf("No reserved IP is assigned to Droplet %d", v.(int))
+		}
+		ipAddress = ip.IP
+	}
+
 	d.SetId(ipAddress)
 
 	return resourceDigitalOceanReservedIPRead(ctx, d, meta)
 }
+
+func findReservedIPByDropletID(ips []interface{}, dropletID int) *godo.ReservedIP {
+	for _, rawIP := range ips {
+		ip := rawIP.(godo.ReservedIP)
+		if ip.Droplet != nil && ip.Droplet.ID == dropletID {
+			return &ip
+		}
+	}
+
+	return nil
+}
@@ -3,13 +3,17 @@ package reservedip_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/reservedip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -61,6 +65,73 @@ func TestAccDataSourceDigitalOceanReservedIP_FindsFloatingIP(t *testing.T) {
 	})
 }
 
+func TestDataSourceDigitalOceanReservedIPReadByDropletID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/reserved_ips":
+			w.Write([]byte(`{"reserved_ips": [
+  {"ip": "192.0.2.1", "region": {"slug": "nyc3"}},
+  {"ip": "192.0.2.2", "region": {"slug": "nyc3"}, "droplet": {"id": 1234, "region": {"slug": "nyc3"}}}
+]}`))
+		case "/v2/reserved_ips/192.0.2.2":
+			w.Write([]byte(`{"reserved_ip": {"ip": "192.0.2.2", "region": {"slug": "nyc3"}, "droplet": {"id": 1234, "region": {"slug": "nyc3"}}}}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := reservedip.DataSourceDigitalOceanReservedIP()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"droplet_id": 1234})
+	if diags := ds.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if ip := d.Get("ip_address").(string); ip != "192.0.2.2" {
+		t.Errorf("Expected the reserved IP 192.0.2.2, got %q", ip)
+	}
+	if d.Id() != "192.0.2.2" {
+		t.Errorf("Expected ID 192.0.2.2, got %q", d.Id())
+	}
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"droplet_id": 5678})
+	if diags := ds.ReadContext(context.Background(), d, meta); !diags.HasError() {
+		t.Errorf("Expected an error for a Droplet without a reserved IP")
+	}
+}
+
+func TestAccDataSourceDigitalOceanReservedIP_ByDropletID(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDataSourceDigitalOceanReservedIPConfig_ByDropletID, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_reserved_ip.foobar", "ip_address", "digitalocean_reserved_ip.foo", "ip_address"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_reserved_ip.foobar", "droplet_id", "digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_reserved_ip.foobar", "region", "nyc3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceDigitalOceanReservedIPExists(n string, reservedIP *godo.ReservedIP) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -108,3 +179,20 @@ resource "digitalocean_reserved_ip" "foo" {
 data "digitalocean_reserved_ip" "foobar" {
   ip_address = digitalocean_reserved_ip.foo.ip_address
 }`
+
+const testAccCheckDataSourceDigitalOceanReservedIPConfig_ByDropletID = `
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_reserved_ip" "foo" {
+  region     = "nyc3"
+  droplet_id = digitalocean_droplet.foo.id
+}
+
+data "digitalocean_reserved_ip" "foobar" {
+  droplet_id = digitalocean_reserved_ip.foo.droplet_id
+}`
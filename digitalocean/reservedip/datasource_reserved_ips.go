@@ -0,0 +1,110 @@
+package reservedip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanReservedIPs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "the reserved ip address",
+			},
+			"urn": {
+				Type:        schema.TypeString,
+				Description: "the uniform resource name for the reserved ip",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "the region that the reserved ip is reserved to",
+			},
+			"droplet_id": {
+				Type:        schema.TypeInt,
+				Description: "the droplet id that the reserved ip has been assigned to, 0 if unassigned",
+			},
+			"assigned": {
+				Type:        schema.TypeBool,
+				Description: "whether the reserved ip is assigned to a droplet",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "whether the reserved ip is locked by an action in progress",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the project the reserved ip is assigned to",
+			},
+		},
+		ResultAttributeName: "reserved_ips",
+		FlattenRecord:       flattenDigitalOceanReservedIP,
+		GetRecords:          getDigitalOceanReservedIPs,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanReservedIPs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var ipList []interface{}
+
+	for {
+		ips, resp, err := client.ReservedIPs.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving reserved IPs: %s", err)
+		}
+
+		for _, ip := range ips {
+			ipList = append(ipList, ip)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving reserved IPs: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return ipList, nil
+}
+
+func flattenDigitalOceanReservedIP(rawIP, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	ip := rawIP.(godo.ReservedIP)
+
+	flattenedIP := map[string]interface{}{
+		"ip_address": ip.IP,
+		"urn":        ip.URN(),
+		"region":     "",
+		"droplet_id": 0,
+		"assigned":   ip.Droplet != nil,
+		"locked":     ip.Locked,
+		"project_id": ip.ProjectID,
+	}
+
+	if ip.Region != nil {
+		flattenedIP["region"] = ip.Region.Slug
+	}
+
+	if ip.Droplet != nil {
+		flattenedIP["droplet_id"] = ip.Droplet.ID
+	}
+
+	return flattenedIP, nil
+}
@@ -0,0 +1,55 @@
+package reservedip_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanReservedIPs_Unassigned(t *testing.T) {
+	resourcesConfig := `
+resource "digitalocean_reserved_ip" "foo" {
+  region = "nyc3"
+}
+`
+
+	datasourceConfig := `
+data "digitalocean_reserved_ips" "result" {
+  filter {
+    key    = "ip_address"
+    values = [digitalocean_reserved_ip.foo.ip_address]
+  }
+  filter {
+    key    = "region"
+    values = ["nyc3"]
+  }
+  filter {
+    key    = "assigned"
+    values = ["false"]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_reserved_ips.result", "reserved_ips.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_reserved_ips.result", "reserved_ips.0.ip_address",
+						"digitalocean_reserved_ip.foo", "ip_address"),
+					resource.TestCheckResourceAttr("data.digitalocean_reserved_ips.result", "reserved_ips.0.region", "nyc3"),
+					resource.TestCheckResourceAttr("data.digitalocean_reserved_ips.result", "reserved_ips.0.assigned", "false"),
+					resource.TestCheckResourceAttr("data.digitalocean_reserved_ips.result", "reserved_ips.0.droplet_id", "0"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_reserved_ips.result", "reserved_ips.0.urn"),
+				),
+			},
+		},
+	})
+}
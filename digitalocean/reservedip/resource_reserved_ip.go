@@ -9,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,7 +64,7 @@ func resourceDigitalOceanReservedIPCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Reserved IP create: %#v", regionOpts)
-	reservedIP, _, err := client.ReservedIPs.Create(context.Background(), regionOpts)
+	reservedIP, _, err := client.ReservedIPs.Create(ctx, regionOpts)
 	if err != nil {
 		return diag.Errorf("Error creating reserved IP: %s", err)
 	}
@@ -71,8 +72,14 @@ func resourceDigitalOceanReservedIPCreate(ctx context.Context, d *schema.Resourc
 	d.SetId(reservedIP.IP)
 
 	if v, ok := d.GetOk("droplet_id"); ok {
+		unlock, err := meta.(*config.CombinedConfig).LockDropletActions(ctx, v.(int))
+		if err != nil {
+			return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", v.(int), err)
+		}
+		defer unlock()
+
 		log.Printf("[INFO] Assigning the reserved IP to the Droplet %d", v.(int))
-		action, _, err := client.ReservedIPActions.Assign(context.Background(), d.Id(), v.(int))
+		action, err := assignReservedIP(ctx, client, d.Id(), v.(int))
 		if err != nil {
 			return diag.Errorf(
 				"Error Assigning reserved IP (%s) to the Droplet: %s", d.Id(), err)
@@ -89,12 +96,19 @@ func resourceDigitalOceanReservedIPCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceDigitalOceanReservedIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
 
 	if d.HasChange("droplet_id") {
 		if v, ok := d.GetOk("droplet_id"); ok {
+			unlock, err := combined.LockDropletActions(ctx, v.(int))
+			if err != nil {
+				return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", v.(int), err)
+			}
+			defer unlock()
+
 			log.Printf("[INFO] Assigning the reserved IP %s to the Droplet %d", d.Id(), v.(int))
-			action, _, err := client.ReservedIPActions.Assign(context.Background(), d.Id(), v.(int))
+			action, err := assignReservedIP(ctx, client, d.Id(), v.(int))
 			if err != nil {
 				return diag.Errorf(
 					"Error assigning reserved IP (%s) to the Droplet: %s", d.Id(), err)
@@ -106,8 +120,15 @@ func resourceDigitalOceanReservedIPUpdate(ctx context.Context, d *schema.Resourc
 					"Error waiting for reserved IP (%s) to be Assigned: %s", d.Id(), unassignedErr)
 			}
 		} else {
+			old, _ := d.GetChange("droplet_id")
+			unlock, err := combined.LockDropletActions(ctx, old.(int))
+			if err != nil {
+				return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", old.(int), err)
+			}
+			defer unlock()
+
 			log.Printf("[INFO] Unassigning the reserved IP %s", d.Id())
-			action, _, err := client.ReservedIPActions.Unassign(context.Background(), d.Id())
+			action, _, err := client.ReservedIPActions.Unassign(ctx, d.Id())
 			if err != nil {
 				return diag.Errorf(
 					"Error unassigning reserved IP (%s): %s", d.Id(), err)
@@ -128,7 +149,7 @@ func resourceDigitalOceanReservedIPRead(ctx context.Context, d *schema.ResourceD
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Reading the details of the reserved IP %s", d.Id())
-	reservedIP, resp, err := client.ReservedIPs.Get(context.Background(), d.Id())
+	reservedIP, resp, err := client.ReservedIPs.Get(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Reserved IP (%s) not found", d.Id())
@@ -153,11 +174,18 @@ func resourceDigitalOceanReservedIPRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceDigitalOceanReservedIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
+
+	if v, ok := d.GetOk("droplet_id"); ok {
+		unlock, err := combined.LockDropletActions(ctx, v.(int))
+		if err != nil {
+			return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", v.(int), err)
+		}
+		defer unlock()
 
-	if _, ok := d.GetOk("droplet_id"); ok {
 		log.Printf("[INFO] Unassigning the reserved IP from the Droplet")
-		action, resp, err := client.ReservedIPActions.Unassign(context.Background(), d.Id())
+		action, resp, err := client.ReservedIPActions.Unassign(ctx, d.Id())
 		if resp.StatusCode != 422 {
 			if err != nil {
 				return diag.Errorf(
@@ -175,8 +203,13 @@ func resourceDigitalOceanReservedIPDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[INFO] Deleting reserved IP: %s", d.Id())
-	_, err := client.ReservedIPs.Delete(context.Background(), d.Id())
+	resp, err := client.ReservedIPs.Delete(ctx, d.Id())
 	if err != nil {
+		// The reserved IP may have already been destroyed along with a Droplet
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error deleting reserved IP: %s", err)
 	}
 
@@ -186,7 +219,7 @@ func resourceDigitalOceanReservedIPDelete(ctx context.Context, d *schema.Resourc
 
 func resourceDigitalOceanReservedIPImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*config.CombinedConfig).GodoClient()
-	reservedIP, resp, err := client.ReservedIPs.Get(context.Background(), d.Id())
+	reservedIP, resp, err := client.ReservedIPs.Get(ctx, d.Id())
 	if resp.StatusCode != 404 {
 		if err != nil {
 			return nil, err
@@ -204,6 +237,28 @@ func resourceDigitalOceanReservedIPImport(ctx context.Context, d *schema.Resourc
 	return []*schema.ResourceData{d}, nil
 }
 
+// assignReservedIP assigns the reserved IP to the Droplet, retrying while the
+// Droplet has a pending event, e.g. from another reserved IP or a volume.
+func assignReservedIP(ctx context.Context, client *godo.Client, ipAddress string, dropletID int) (*godo.Action, error) {
+	var action *godo.Action
+	err := util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		var err error
+		action, _, err = client.ReservedIPActions.Assign(ctx, ipAddress, dropletID)
+		if err != nil {
+			if util.IsRetryableConflict(err) {
+				log.Printf("[DEBUG] Received %s, retrying assigning reserved IP (%s) to droplet (%d)", err, ipAddress, dropletID)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	return action, err
+}
+
 func waitForReservedIPReady(
 	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int) (interface{}, error) {
 	log.Printf(
@@ -213,7 +268,7 @@ func waitForReservedIPReady(
 	stateConf := &resource.StateChangeConf{
 		Pending:    pending,
 		Target:     []string{target},
-		Refresh:    newReservedIPStateRefreshFunc(d, attribute, meta, actionID),
+		Refresh:    newReservedIPStateRefreshFunc(ctx, d, attribute, meta, actionID),
 		Timeout:    60 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -224,13 +279,13 @@ func waitForReservedIPReady(
 	return stateConf.WaitForStateContext(ctx)
 }
 
-func newReservedIPStateRefreshFunc(
+func newReservedIPStateRefreshFunc(ctx context.Context,
 	d *schema.ResourceData, attribute string, meta interface{}, actionID int) resource.StateRefreshFunc {
 	client := meta.(*config.CombinedConfig).GodoClient()
 	return func() (interface{}, string, error) {
 
 		log.Printf("[INFO] Assigning the reserved IP to the Droplet")
-		action, _, err := client.ReservedIPActions.Get(context.Background(), d.Id(), actionID)
+		action, _, err := client.ReservedIPActions.Get(ctx, d.Id(), actionID)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error retrieving reserved IP (%s) ActionId (%d): %s", d.Id(), actionID, err)
 		}
@@ -43,13 +43,20 @@ func ResourceDigitalOceanReservedIPAssignment() *schema.Resource {
 }
 
 func resourceDigitalOceanReservedIPAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
 
 	ipAddress := d.Get("ip_address").(string)
 	dropletID := d.Get("droplet_id").(int)
 
+	unlock, err := combined.LockDropletActions(ctx, dropletID)
+	if err != nil {
+		return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", dropletID, err)
+	}
+	defer unlock()
+
 	log.Printf("[INFO] Assigning the reserved IP (%s) to the Droplet %d", ipAddress, dropletID)
-	action, _, err := client.ReservedIPActions.Assign(context.Background(), ipAddress, dropletID)
+	action, err := assignReservedIP(ctx, client, ipAddress, dropletID)
 	if err != nil {
 		return diag.Errorf(
 			"Error Assigning reserved IP (%s) to the droplet: %s", ipAddress, err)
@@ -72,7 +79,7 @@ func resourceDigitalOceanReservedIPAssignmentRead(ctx context.Context, d *schema
 	dropletID := d.Get("droplet_id").(int)
 
 	log.Printf("[INFO] Reading the details of the reserved IP %s", ipAddress)
-	reservedIP, _, err := client.ReservedIPs.Get(context.Background(), ipAddress)
+	reservedIP, _, err := client.ReservedIPs.Get(ctx, ipAddress)
 	if err != nil {
 		return diag.Errorf("Error retrieving reserved IP: %s", err)
 	}
@@ -86,20 +93,27 @@ func resourceDigitalOceanReservedIPAssignmentRead(ctx context.Context, d *schema
 }
 
 func resourceDigitalOceanReservedIPAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
 
 	ipAddress := d.Get("ip_address").(string)
 	dropletID := d.Get("droplet_id").(int)
 
 	log.Printf("[INFO] Reading the details of the reserved IP %s", ipAddress)
-	reservedIP, _, err := client.ReservedIPs.Get(context.Background(), ipAddress)
+	reservedIP, _, err := client.ReservedIPs.Get(ctx, ipAddress)
 	if err != nil {
 		return diag.Errorf("Error retrieving reserved IP: %s", err)
 	}
 
 	if reservedIP.Droplet.ID == dropletID {
+		unlock, err := combined.LockDropletActions(ctx, dropletID)
+		if err != nil {
+			return diag.Errorf("Error waiting for the lock of droplet (%d) actions: %s", dropletID, err)
+		}
+		defer unlock()
+
 		log.Printf("[INFO] Unassigning the reserved IP from the Droplet")
-		action, _, err := client.ReservedIPActions.Unassign(context.Background(), ipAddress)
+		action, _, err := client.ReservedIPActions.Unassign(ctx, ipAddress)
 		if err != nil {
 			return diag.Errorf("Error unassigning reserved IP (%s) from the droplet: %s", ipAddress, err)
 		}
@@ -126,7 +140,7 @@ func waitForReservedIPAssignmentReady(
 	stateConf := &resource.StateChangeConf{
 		Pending:    pending,
 		Target:     []string{target},
-		Refresh:    newReservedIPAssignmentStateRefreshFunc(d, attribute, meta, actionID),
+		Refresh:    newReservedIPAssignmentStateRefreshFunc(ctx, d, attribute, meta, actionID),
 		Timeout:    60 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -137,13 +151,13 @@ func waitForReservedIPAssignmentReady(
 	return stateConf.WaitForStateContext(ctx)
 }
 
-func newReservedIPAssignmentStateRefreshFunc(
+func newReservedIPAssignmentStateRefreshFunc(ctx context.Context,
 	d *schema.ResourceData, attribute string, meta interface{}, actionID int) resource.StateRefreshFunc {
 	client := meta.(*config.CombinedConfig).GodoClient()
 	return func() (interface{}, string, error) {
 
 		log.Printf("[INFO] Refreshing the reserved IP state")
-		action, _, err := client.ReservedIPActions.Get(context.Background(), d.Get("ip_address").(string), actionID)
+		action, _, err := client.ReservedIPActions.Get(ctx, d.Get("ip_address").(string), actionID)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error retrieving reserved IP (%s) ActionId (%d): %s", d.Get("ip_address").(string), actionID, err)
 		}
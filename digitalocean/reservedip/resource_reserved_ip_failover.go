@@ -0,0 +1,330 @@
+package reservedip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// reservedIPFailoverRetryInterval is the time between failed health checks.
+const reservedIPFailoverRetryInterval = 2 * time.Second
+
+func ResourceDigitalOceanReservedIPFailover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanReservedIPFailoverApply,
+		ReadContext:   resourceDigitalOceanReservedIPFailoverRead,
+		UpdateContext: resourceDigitalOceanReservedIPFailoverApply,
+		DeleteContext: resourceDigitalOceanReservedIPFailoverDelete,
+
+		CustomizeDiff: resourceDigitalOceanReservedIPFailoverDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"primary_droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"standby_droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"health_check_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "a URL which must respond with a status code below 400 for the primary Droplet to be considered healthy",
+			},
+			"health_check_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"health_check_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 10),
+				Description:  "the number of consecutive failed health checks after which the primary Droplet is considered unhealthy",
+			},
+			"failback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "whether to assign the reserved IP back to the primary Droplet once it is healthy again",
+			},
+			"active_droplet_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_over": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// reservedIPFailoverConfig holds the arguments used to choose the Droplet
+// the reserved IP is assigned to.
+type reservedIPFailoverConfig struct {
+	primary  int
+	standby  int
+	url      string
+	timeout  time.Duration
+	attempts int
+	failback bool
+}
+
+type reservedIPFailoverGetter interface {
+	Get(string) interface{}
+}
+
+func expandReservedIPFailoverConfig(d reservedIPFailoverGetter) reservedIPFailoverConfig {
+	return reservedIPFailoverConfig{
+		primary:  d.Get("primary_droplet_id").(int),
+		standby:  d.Get("standby_droplet_id").(int),
+		url:      d.Get("health_check_url").(string),
+		timeout:  time.Duration(d.Get("health_check_timeout_seconds").(int)) * time.Second,
+		attempts: d.Get("health_check_attempts").(int),
+		failback: d.Get("failback").(bool),
+	}
+}
+
+// The health of the Droplets is checked when planning, so that a failover
+// shows as a change of active_droplet_id which is then applied.
+func resourceDigitalOceanReservedIPFailoverDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"primary_droplet_id", "standby_droplet_id", "health_check_url"} {
+		if !d.NewValueKnown(k) {
+			if err := d.SetNewComputed("active_droplet_id"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("failed_over")
+		}
+	}
+
+	conf := expandReservedIPFailoverConfig(d)
+	if conf.primary == conf.standby {
+		return fmt.Errorf("primary_droplet_id and standby_droplet_id must be different Droplets")
+	}
+
+	current := d.Get("active_droplet_id").(int)
+	target, err := reservedIPFailoverTarget(ctx, meta.(*config.CombinedConfig).GodoClient(), conf, current)
+	if err != nil {
+		return err
+	}
+
+	if target == current {
+		return nil
+	}
+
+	if err := d.SetNew("active_droplet_id", target); err != nil {
+		return err
+	}
+	return d.SetNew("failed_over", target == conf.standby)
+}
+
+func resourceDigitalOceanReservedIPFailoverApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
+
+	ipAddress := d.Get("ip_address").(string)
+
+	reservedIP, _, err := client.ReservedIPs.Get(ctx, ipAddress)
+	if err != nil {
+		return diag.Errorf("Error retrieving reserved IP: %s", err)
+	}
+
+	current := 0
+	if reservedIP.Droplet != nil {
+		current = reservedIP.Droplet.ID
+	}
+
+	// The target is only unknown when planning if the Droplets were not
+	// created yet.
+	target := d.Get("active_droplet_id").(int)
+	if target == 0 {
+		target, err = reservedIPFailoverTarget(ctx, client, expandReservedIPFailoverConfig(d), current)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if target != current {
+		// Moving the reserved IP is an action against the Droplet it is
+		// currently assigned to as well as the target.
+		locked := []int{target}
+		if current != 0 {
+			locked = append(locked, current)
+		}
+		unlock, err := combined.LockDropletActions(ctx, locked...)
+		if err != nil {
+			return diag.Errorf("Error waiting for the lock of droplet %v actions: %s", locked, err)
+		}
+		defer unlock()
+
+		log.Printf("[INFO] Assigning the reserved IP (%s) to the Droplet %d", ipAddress, target)
+		action, err := assignReservedIP(ctx, client, ipAddress, target)
+		if err != nil {
+			return diag.Errorf("Error assigning reserved IP (%s) to the droplet: %s", ipAddress, err)
+		}
+
+		_, err = waitForReservedIPAssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+		if err != nil {
+			return diag.Errorf("Error waiting for reserved IP (%s) to be assigned: %s", ipAddress, err)
+		}
+	}
+
+	d.SetId(ipAddress)
+
+	return resourceDigitalOceanReservedIPFailoverRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	reservedIP, resp, err := client.ReservedIPs.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Reserved IP (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving reserved IP: %s", err)
+	}
+
+	active := 0
+	if reservedIP.Droplet != nil {
+		active = reservedIP.Droplet.ID
+	}
+
+	d.Set("ip_address", reservedIP.IP)
+	d.Set("active_droplet_id", active)
+	d.Set("failed_over", active != 0 && active == d.Get("standby_droplet_id").(int))
+
+	return nil
+}
+
+// The reserved IP is left assigned to the active Droplet so that removing the
+// failover does not interrupt traffic.
+func resourceDigitalOceanReservedIPFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// reservedIPFailoverTarget checks the health of the Droplets and returns the
+// one the reserved IP should be assigned to. An error is returned if the
+// Droplets can not be retrieved, rather than failing over because of a
+// transient API error.
+func reservedIPFailoverTarget(ctx context.Context, client *godo.Client, conf reservedIPFailoverConfig, current int) (int, error) {
+	primaryHealthy, err := reservedIPFailoverDropletActive(ctx, client, conf.primary)
+	if err != nil {
+		return 0, err
+	}
+	primaryHealthy = primaryHealthy && reservedIPFailoverURLHealthy(ctx, conf)
+
+	standbyHealthy := true
+	if !primaryHealthy {
+		standbyHealthy, err = reservedIPFailoverDropletActive(ctx, client, conf.standby)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return ReservedIPFailoverTarget(conf.primary, conf.standby, current, primaryHealthy, standbyHealthy, conf.failback), nil
+}
+
+// ReservedIPFailoverTarget returns the Droplet the reserved IP should be
+// assigned to, given the health of the primary and standby Droplets and the
+// Droplet it is currently assigned to, which is 0 if it is unassigned.
+func ReservedIPFailoverTarget(primary, standby, current int, primaryHealthy, standbyHealthy, failback bool) int {
+	if primaryHealthy {
+		if current == standby && !failback {
+			return standby
+		}
+		return primary
+	}
+
+	if standbyHealthy {
+		return standby
+	}
+
+	// Neither Droplet is healthy, so the reserved IP is left where it is.
+	if current != 0 {
+		return current
+	}
+	return primary
+}
+
+// reservedIPFailoverDropletActive reports whether the Droplet exists and is
+// active. Errors other than the Droplet not being found are returned.
+func reservedIPFailoverDropletActive(ctx context.Context, client *godo.Client, dropletID int) (bool, error) {
+	droplet, resp, err := client.Droplets.Get(ctx, dropletID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Droplet (%d) not found, considering it unhealthy", dropletID)
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving droplet (%d) for reserved IP failover: %s", dropletID, err)
+	}
+
+	if droplet.Status != "active" {
+		log.Printf("[WARN] Droplet (%d) is %s, considering it unhealthy", dropletID, droplet.Status)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func reservedIPFailoverURLHealthy(ctx context.Context, conf reservedIPFailoverConfig) bool {
+	if conf.url == "" {
+		return true
+	}
+
+	httpClient := &http.Client{Timeout: conf.timeout}
+	for attempt := 1; attempt <= conf.attempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(reservedIPFailoverRetryInterval):
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, conf.url, nil)
+		if err != nil {
+			log.Printf("[WARN] Invalid health check URL %s: %s", conf.url, err)
+			return false
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			log.Printf("[WARN] Health check %d of %d for %s failed: %s", attempt, conf.attempts, conf.url, err)
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < http.StatusBadRequest {
+			return true
+		}
+		log.Printf("[WARN] Health check %d of %d for %s returned %d", attempt, conf.attempts, conf.url, resp.StatusCode)
+	}
+
+	return false
+}
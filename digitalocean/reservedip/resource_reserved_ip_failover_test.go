@@ -0,0 +1,259 @@
+package reservedip_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/reservedip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestReservedIPFailoverTarget(t *testing.T) {
+	const primary, standby = 1, 2
+
+	cases := []struct {
+		name           string
+		current        int
+		primaryHealthy bool
+		standbyHealthy bool
+		failback       bool
+		expected       int
+	}{
+		{"unassigned and healthy", 0, true, true, true, primary},
+		{"healthy", primary, true, true, true, primary},
+		{"primary unhealthy", primary, false, true, true, standby},
+		{"primary healthy again", standby, true, true, true, primary},
+		{"primary healthy again without failback", standby, true, true, false, standby},
+		{"both unhealthy", primary, false, false, true, primary},
+		{"both unhealthy on standby", standby, false, false, true, standby},
+		{"both unhealthy and unassigned", 0, false, false, true, primary},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			target := reservedip.ReservedIPFailoverTarget(primary, standby, c.current, c.primaryHealthy, c.standbyHealthy, c.failback)
+			if target != c.expected {
+				t.Fatalf("expected %d, got %d", c.expected, target)
+			}
+		})
+	}
+}
+
+func TestReservedIPFailoverDiff(t *testing.T) {
+	primaryStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/droplets/1":
+			w.WriteHeader(primaryStatus)
+			if primaryStatus == http.StatusOK {
+				w.Write([]byte(`{"droplet": {"id": 1, "status": "active"}}`))
+			} else {
+				w.Write([]byte(`{"id": "error", "message": "unavailable"}`))
+			}
+		case "/v2/droplets/2":
+			w.Write([]byte(`{"droplet": {"id": 2, "status": "active"}}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := func(standby int) (*terraform.InstanceDiff, error) {
+		c := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"ip_address":         "192.0.2.1",
+			"primary_droplet_id": 1,
+			"standby_droplet_id": standby,
+		})
+		return reservedip.ResourceDigitalOceanReservedIPFailover().Diff(context.Background(), nil, c, meta)
+	}
+
+	cases := []struct {
+		status   int
+		expected string
+	}{
+		{http.StatusOK, "1"},
+		{http.StatusNotFound, "2"},
+	}
+	for _, c := range cases {
+		primaryStatus = c.status
+		d, err := diff(2)
+		if err != nil {
+			t.Fatalf("status %d: unexpected error: %s", c.status, err)
+		}
+		if active := d.Attributes["active_droplet_id"].New; active != c.expected {
+			t.Errorf("status %d: expected the active Droplet to be %s, got %s", c.status, c.expected, active)
+		}
+	}
+
+	// Transient API errors must not fail over.
+	for _, status := range []int{http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusInternalServerError} {
+		primaryStatus = status
+		if _, err := diff(2); err == nil {
+			t.Errorf("status %d: expected an error", status)
+		}
+	}
+
+	primaryStatus = http.StatusOK
+	if _, err := diff(1); err == nil || !strings.Contains(err.Error(), "must be different") {
+		t.Errorf("expected an error for the same primary and standby Droplets, got %v", err)
+	}
+}
+
+func TestReservedIPFailoverLocksCurrentDroplet(t *testing.T) {
+	var actions int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/reserved_ips/192.0.2.1":
+			w.Write([]byte(`{"reserved_ip": {"ip": "192.0.2.1", "droplet": {"id": 1}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/reserved_ips/192.0.2.1/actions":
+			atomic.AddInt32(&actions, 1)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"action": {"id": 1, "status": "completed", "type": "assign_ip"}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:             "12345",
+		APIEndpoint:       server.URL,
+		ActionConcurrency: 2,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another resource, e.g. a volume attachment, runs an action against the
+	// Droplet the reserved IP is moved away from.
+	unlock, err := meta.LockDropletActions(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer unlock()
+
+	r := reservedip.ResourceDigitalOceanReservedIPFailover()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ip_address":         "192.0.2.1",
+		"primary_droplet_id": 1,
+		"standby_droplet_id": 2,
+	})
+	d.Set("active_droplet_id", 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	diags := r.CreateContext(ctx, d, meta)
+	if !diags.HasError() {
+		t.Fatal("Expected an error waiting for the lock of the current Droplet")
+	}
+	if n := atomic.LoadInt32(&actions); n != 0 {
+		t.Fatalf("Expected the assignment to wait for the lock, got %d actions", n)
+	}
+}
+
+func TestAccDigitalOceanReservedIPFailover(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPFailoverConfig(name, "", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ip_failover.foobar", "active_droplet_id", "digitalocean_droplet.foobar.0", "id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ip_failover.foobar", "failed_over", "false"),
+				),
+			},
+			{
+				// Nothing listens on the port, so the primary is unhealthy.
+				Config: testAccCheckDigitalOceanReservedIPFailoverConfig(name, `health_check_url = "http://127.0.0.1:1/health"`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ip_failover.foobar", "active_droplet_id", "digitalocean_droplet.foobar.1", "id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ip_failover.foobar", "failed_over", "true"),
+					testAccCheckDigitalOceanReservedIPFailoverAssigned("digitalocean_droplet.foobar.1"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPFailoverConfig(name, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ip_failover.foobar", "active_droplet_id", "digitalocean_droplet.foobar.1", "id"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPFailoverConfig(name, "", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ip_failover.foobar", "active_droplet_id", "digitalocean_droplet.foobar.0", "id"),
+					testAccCheckDigitalOceanReservedIPFailoverAssigned("digitalocean_droplet.foobar.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanReservedIPFailoverAssigned(droplet string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrPair(
+		"data.digitalocean_reserved_ip.foobar", "droplet_id", droplet, "id")
+}
+
+func testAccCheckDigitalOceanReservedIPFailoverConfig(name string, healthCheck string, failback bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_reserved_ip" "foobar" {
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_reserved_ip_failover" "foobar" {
+  ip_address         = digitalocean_reserved_ip.foobar.ip_address
+  primary_droplet_id = digitalocean_droplet.foobar.0.id
+  standby_droplet_id = digitalocean_droplet.foobar.1.id
+  failback           = %t
+  %s
+}
+
+data "digitalocean_reserved_ip" "foobar" {
+  ip_address = digitalocean_reserved_ip_failover.foobar.ip_address
+
+  depends_on = [digitalocean_reserved_ip_failover.foobar]
+}
+`, name, failback, healthCheck)
+}
@@ -0,0 +1,126 @@
+package digitalocean
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// excludeSensitiveOutputs wraps the create, read, and update functions of the
+// resource or data source so that its computed sensitive attributes are
+// cleared before the state is saved when the provider is configured with
+// exclude_sensitive_outputs = true. Sensitive attributes nested in computed
+// blocks are cleared while the rest of the block is kept.
+func excludeSensitiveOutputs(r *schema.Resource) {
+	var keys []string
+	for k, s := range r.Schema {
+		if isComputedOnly(s) && hasSensitiveValue(s) {
+			keys = append(keys, k)
+		}
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+
+			if c, ok := meta.(*config.CombinedConfig); !ok || !c.ExcludeSensitiveOutputs() || d.Id() == "" {
+				return diags
+			}
+
+			for _, k := range keys {
+				if err := d.Set(k, redactSensitiveValue(r.Schema[k], d.Get(k))); err != nil {
+					diags = append(diags, diag.Errorf("Error removing sensitive attribute %s from state: %s", k, err)...)
+				}
+			}
+
+			return diags
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap(r.UpdateContext)
+	}
+}
+
+// isComputedOnly reports whether the attribute is set by the provider only.
+// Sensitive attributes which can be set in the configuration are kept, as
+// clearing them would produce a diff.
+func isComputedOnly(s *schema.Schema) bool {
+	return s.Computed && !s.Optional && !s.Required
+}
+
+func hasSensitiveValue(s *schema.Schema) bool {
+	if s.Sensitive {
+		return true
+	}
+
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		return hasSensitiveNestedValue(elem)
+	}
+
+	return false
+}
+
+func hasSensitiveNestedValue(r *schema.Resource) bool {
+	for _, nested := range r.Schema {
+		if hasSensitiveValue(nested) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactSensitiveValue returns the value of the attribute with its sensitive
+// parts cleared.
+func redactSensitiveValue(s *schema.Schema, v interface{}) interface{} {
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok || (s.Sensitive && !hasSensitiveNestedValue(elem)) {
+		if s.Sensitive {
+			return s.ZeroValue()
+		}
+		return v
+	}
+
+	var items []interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		items = v
+	case *schema.Set:
+		items = v.List()
+	default:
+		return v
+	}
+
+	redacted := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		block := make(map[string]interface{}, len(m))
+		for k, nested := range m {
+			if ns, ok := elem.Schema[k]; ok {
+				block[k] = redactSensitiveValue(ns, nested)
+			} else {
+				block[k] = nested
+			}
+		}
+		redacted = append(redacted, block)
+	}
+
+	return redacted
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceDigitalOceanSizes() *schema.Resource {
@@ -52,8 +53,16 @@ func DataSourceDigitalOceanSizes() *schema.Resource {
 			},
 		},
 		ResultAttributeName: "sizes",
-		FlattenRecord:       flattenDigitalOceanSize,
-		GetRecords:          getDigitalOceanSizes,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "the slug of a region to list the sizes of; available is then only true for the sizes Droplets can currently be created with in this region",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanSize,
+		GetRecords:    getDigitalOceanSizes,
 	}
 
 	return datalist.NewResource(dataListConfig)
@@ -62,7 +71,7 @@ func DataSourceDigitalOceanSizes() *schema.Resource {
 func getDigitalOceanSizes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	sizes := []interface{}{}
+	sizes := []godo.Size{}
 
 	opts := &godo.ListOptions{
 		Page:    1,
@@ -75,9 +84,7 @@ func getDigitalOceanSizes(meta interface{}, extra map[string]interface{}) ([]int
 			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
 		}
 
-		for _, partialSize := range partialSizes {
-			sizes = append(sizes, partialSize)
-		}
+		sizes = append(sizes, partialSizes...)
 
 		if resp.Links == nil || resp.Links.IsLastPage() {
 			break
@@ -91,7 +98,85 @@ func getDigitalOceanSizes(meta interface{}, extra map[string]interface{}) ([]int
 		opts.Page = page + 1
 	}
 
-	return sizes, nil
+	if slug, ok := extra["region"].(string); ok && slug != "" {
+		region, err := getDigitalOceanRegion(client, slug)
+		if err != nil {
+			return nil, err
+		}
+
+		return regionSizes(sizes, region), nil
+	}
+
+	records := make([]interface{}, 0, len(sizes))
+	for _, size := range sizes {
+		records = append(records, size)
+	}
+
+	return records, nil
+}
+
+func getDigitalOceanRegion(client *godo.Client, slug string) (*godo.Region, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		regions, resp, err := client.Regions.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving regions: %s", err)
+		}
+
+		for _, region := range regions {
+			if region.Slug == slug {
+				return &region, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving regions: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return nil, fmt.Errorf("Region not found: %s", slug)
+}
+
+// regionSizes returns the sizes offered in the region, either by the size or
+// by the region. The sizes list of a region only contains the sizes with
+// capacity in the region, so a size is only available if both the size and
+// the region are available and the region lists it.
+func regionSizes(sizes []godo.Size, region *godo.Region) []interface{} {
+	regionSizes := map[string]bool{}
+	for _, slug := range region.Sizes {
+		regionSizes[slug] = true
+	}
+
+	records := []interface{}{}
+	for _, size := range sizes {
+		offered := false
+		for _, r := range size.Regions {
+			if r == region.Slug {
+				offered = true
+				break
+			}
+		}
+
+		if !offered && !regionSizes[size.Slug] {
+			continue
+		}
+
+		size.Available = size.Available && region.Available && regionSizes[size.Slug]
+		records = append(records, size)
+	}
+
+	return records
 }
 
 func flattenDigitalOceanSize(size, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
@@ -1,12 +1,18 @@
 package size_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/size"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -41,6 +47,82 @@ func TestAccDataSourceDigitalOceanSizes_WithFilterAndSort(t *testing.T) {
 	})
 }
 
+func TestDataSourceDigitalOceanSizesRegionRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/sizes":
+			w.Write([]byte(`{"sizes": [
+  {"slug": "s-1vcpu-1gb", "available": true, "regions": ["nyc3", "sfo3"]},
+  {"slug": "s-2vcpu-2gb", "available": true, "regions": ["nyc3"]},
+  {"slug": "c-2", "available": false, "regions": ["nyc3"]},
+  {"slug": "g-2vcpu-8gb", "available": true, "regions": ["sfo3"]}
+]}`))
+		case "/v2/regions":
+			w.Write([]byte(`{"regions": [
+  {"slug": "sfo3", "available": true, "sizes": ["s-1vcpu-1gb", "g-2vcpu-8gb"]},
+  {"slug": "nyc3", "available": true, "sizes": ["s-1vcpu-1gb", "c-2"]}
+]}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := size.DataSourceDigitalOceanSizes()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"region": "nyc3"})
+	if diags := ds.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]bool{
+		"s-1vcpu-1gb": true,
+		"s-2vcpu-2gb": false,
+		"c-2":         false,
+	}
+	sizes := d.Get("sizes").([]interface{})
+	if len(sizes) != len(expected) {
+		t.Fatalf("Expected %d sizes, got %v", len(expected), sizes)
+	}
+	for _, rawSize := range sizes {
+		s := rawSize.(map[string]interface{})
+		available, ok := expected[s["slug"].(string)]
+		if !ok {
+			t.Errorf("Unexpected size %s in nyc3", s["slug"])
+			continue
+		}
+		if s["available"].(bool) != available {
+			t.Errorf("Expected size %s to have available %t, got %t", s["slug"], available, s["available"])
+		}
+	}
+}
+
+func TestAccDataSourceDigitalOceanSizes_Region(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanSizesConfigRegion,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceDigitalOceanSizesExist("data.digitalocean_sizes.foobar"),
+					resource.TestCheckTypeSetElemAttr("data.digitalocean_sizes.foobar", "sizes.0.regions.*", "nyc3"),
+					resource.TestCheckResourceAttr("data.digitalocean_sizes.foobar", "sizes.0.available", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceDigitalOceanSizesExist(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -144,3 +226,18 @@ data "digitalocean_sizes" "foobar" {
     direction = "desc"
   }
 }`
+
+const testAccCheckDataSourceDigitalOceanSizesConfigRegion = `
+data "digitalocean_sizes" "foobar" {
+  region = "nyc3"
+
+  filter {
+    key    = "available"
+    values = ["true"]
+  }
+
+  sort {
+    key       = "price_monthly"
+    direction = "asc"
+  }
+}`
@@ -0,0 +1,27 @@
+package size
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+)
+
+// FindSize returns the Droplet size with the slug, which can be used to look
+// up the price of resources built on Droplets such as Kubernetes node pools.
+// The sizes are cached by the provider.
+func FindSize(ctx context.Context, meta *config.CombinedConfig, slug string) (*godo.Size, error) {
+	sizes, err := meta.Sizes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sizes {
+		if s.Slug == slug {
+			return &s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("size %s not found", slug)
+}
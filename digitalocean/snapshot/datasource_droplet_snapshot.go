@@ -87,7 +87,7 @@ func dataSourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Re
 	var snapshotList []godo.Snapshot
 
 	for {
-		snapshots, resp, err := client.Snapshots.ListDroplet(context.Background(), opts)
+		snapshots, resp, err := client.Snapshots.ListDroplet(ctx, opts)
 
 		if err != nil {
 			return diag.Errorf("Error retrieving Droplet snapshots: %s", err)
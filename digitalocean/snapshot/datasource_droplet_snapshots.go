@@ -0,0 +1,31 @@
+package snapshot
+
+import (
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanDropletSnapshots() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        snapshotRecordSchema("droplet_id"),
+		ResultAttributeName: "snapshots",
+		ExtraQuerySchema:    snapshotPriceSchema(),
+		SummarySchema:       snapshotSummarySchema(),
+		Summarize:           summarizeDigitalOceanSnapshots,
+		GetRecords:          getDigitalOceanDropletSnapshots,
+		FlattenRecord:       flattenDigitalOceanDropletSnapshot,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDropletSnapshots(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	return getDigitalOceanSnapshots(client.Snapshots.ListDroplet)
+}
+
+func flattenDigitalOceanDropletSnapshot(rawSnapshot, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	return flattenDigitalOceanSnapshot(rawSnapshot.(godo.Snapshot), "droplet_id", extra), nil
+}
@@ -0,0 +1,103 @@
+package snapshot_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/snapshot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanDropletSnapshotsSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/snapshots" || r.URL.Query().Get("resource_type") != "droplet" {
+			t.Errorf("Unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(`{"snapshots": [
+  {"id": "1", "name": "web-nightly-1", "resource_id": "100", "regions": ["nyc3"], "size_gigabytes": 2.5},
+  {"id": "2", "name": "web-nightly-2", "resource_id": "100", "regions": ["nyc3", "ams3"], "size_gigabytes": 4},
+  {"id": "3", "name": "db-nightly-1", "resource_id": "200", "regions": ["nyc3"], "size_gigabytes": 10}
+]}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := snapshot.DataSourceDigitalOceanDropletSnapshots()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{
+				"key":    "droplet_id",
+				"values": []interface{}{"100"},
+			},
+		},
+	})
+	if diags := ds.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if n := d.Get("snapshots.#").(int); n != 2 {
+		t.Fatalf("Expected 2 snapshots, got %d", n)
+	}
+	if total := d.Get("total_size").(float64); total != 6.5 {
+		t.Errorf("Expected a total size of 6.5, got %v", total)
+	}
+	// 2.5 * 0.06 in one region and 4 * 0.06 in two regions.
+	if cost := d.Get("total_estimated_monthly_cost").(float64); cost != 0.63 {
+		t.Errorf("Expected a total estimated monthly cost of 0.63, got %v", cost)
+	}
+	if cost := d.Get("snapshots.1.estimated_monthly_cost").(float64); cost != 0.48 {
+		t.Errorf("Expected an estimated monthly cost of 0.48 for the second snapshot, got %v", cost)
+	}
+}
+
+func TestAccDataSourceDigitalOceanDropletSnapshots_basic(t *testing.T) {
+	dropletName := acceptance.RandomTestName()
+	snapName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDataSourceDigitalOceanDropletSnapshot_basic, dropletName, snapName)
+	dataSourceConfig := `
+data "digitalocean_droplet_snapshots" "foobar" {
+  filter {
+    key    = "name"
+    values = [digitalocean_droplet_snapshot.foo.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_snapshots.foobar", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_snapshots.foobar", "snapshots.0.name", snapName),
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_snapshots.foobar", "snapshots.0.droplet_id",
+						"digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_snapshots.foobar", "total_size",
+						"digitalocean_droplet_snapshot.foo", "size"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_snapshots.foobar", "total_estimated_monthly_cost"),
+				),
+			},
+		},
+	})
+}
@@ -63,6 +63,10 @@ func DataSourceDigitalOceanVolumeSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"volume_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"size": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -92,7 +96,7 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 	var snapshotList []godo.Snapshot
 
 	for {
-		snapshots, resp, err := client.Snapshots.ListVolume(context.Background(), opts)
+		snapshots, resp, err := client.Snapshots.ListVolume(ctx, opts)
 
 		if err != nil {
 			return diag.Errorf("Error retrieving volume snapshots: %s", err)
@@ -140,12 +144,18 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 
 	log.Printf("[DEBUG] do_snapshot - Single Volume Snapshot found: %s", snapshot.ID)
 
+	volumeRegion, err := volumeSnapshotRegion(ctx, client, snapshot, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(snapshot.ID)
 	d.Set("name", snapshot.Name)
 	d.Set("created_at", snapshot.Created)
 	d.Set("min_disk_size", snapshot.MinDiskSize)
 	d.Set("regions", snapshot.Regions)
 	d.Set("volume_id", snapshot.ResourceID)
+	d.Set("volume_region", volumeRegion)
 	d.Set("size", snapshot.SizeGigaBytes)
 	d.Set("tags", tag.FlattenTags(snapshot.Tags))
 
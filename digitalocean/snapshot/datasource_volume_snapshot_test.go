@@ -119,6 +119,7 @@ data "digitalocean_volume_snapshot" "foobar" {
 					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshot.foobar", "min_disk_size", "100"),
 					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshot.foobar", "regions.#", "1"),
 					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshot.foobar", "tags.#", "0"),
+					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshot.foobar", "volume_region", "lon1"),
 					resource.TestCheckResourceAttrSet("data.digitalocean_volume_snapshot.foobar", "volume_id"),
 				),
 			},
@@ -0,0 +1,31 @@
+package snapshot
+
+import (
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanVolumeSnapshots() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        snapshotRecordSchema("volume_id"),
+		ResultAttributeName: "snapshots",
+		ExtraQuerySchema:    snapshotPriceSchema(),
+		SummarySchema:       snapshotSummarySchema(),
+		Summarize:           summarizeDigitalOceanSnapshots,
+		GetRecords:          getDigitalOceanVolumeSnapshots,
+		FlattenRecord:       flattenDigitalOceanVolumeSnapshot,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanVolumeSnapshots(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	return getDigitalOceanSnapshots(client.Snapshots.ListVolume)
+}
+
+func flattenDigitalOceanVolumeSnapshot(rawSnapshot, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	return flattenDigitalOceanSnapshot(rawSnapshot.(godo.Snapshot), "volume_id", extra), nil
+}
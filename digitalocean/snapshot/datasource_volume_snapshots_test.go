@@ -0,0 +1,44 @@
+package snapshot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanVolumeSnapshots_basic(t *testing.T) {
+	testName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDataSourceDigitalOceanVolumeSnapshot_basic, testName, testName)
+	dataSourceConfig := `
+data "digitalocean_volume_snapshots" "foobar" {
+  monthly_price_per_gigabyte = 0.1
+
+  filter {
+    key    = "volume_id"
+    values = [digitalocean_volume.foo.id]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshots.foobar", "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_volume_snapshots.foobar", "snapshots.0.id",
+						"digitalocean_volume_snapshot.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_volume_snapshots.foobar", "snapshots.0.tags.#", "2"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_volume_snapshots.foobar", "total_size"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_volume_snapshots.foobar", "total_estimated_monthly_cost"),
+				),
+			},
+		},
+	})
+}
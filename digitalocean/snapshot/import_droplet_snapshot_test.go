@@ -26,6 +26,9 @@ func TestAccDigitalOceanDropletSnapshot_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The volumes attached when the snapshot was taken are not known
+				// when importing it.
+				ImportStateVerifyIgnore: []string{"excluded_volume_ids"},
 			},
 		},
 	})
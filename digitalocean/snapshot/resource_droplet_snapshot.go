@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -19,6 +20,7 @@ func ResourceDigitalOceanDropletSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDropletSnapshotCreate,
 		ReadContext:   resourceDigitalOceanDropletSnapshotRead,
+		UpdateContext: resourceDigitalOceanDropletSnapshotRead,
 		DeleteContext: resourceDigitalOceanDropletSnapshotDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -54,6 +56,23 @@ func ResourceDigitalOceanDropletSnapshot() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"attached_volumes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "warn",
+				ValidateFunc: validation.StringInSlice([]string{
+					"warn",
+					"fail",
+					"ignore",
+				}, false),
+				Description: "what to do when volumes are attached to the Droplet, as they are not included in the snapshot",
+			},
+			"excluded_volume_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the IDs of the volumes attached to the Droplet when the snapshot was taken, which are not included in it",
+			},
 		},
 	}
 }
@@ -62,17 +81,40 @@ func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.Re
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	resourceId, _ := strconv.Atoi(d.Get("droplet_id").(string))
-	action, _, err := client.DropletActions.Snapshot(context.Background(), resourceId, d.Get("name").(string))
+
+	// Volumes attached to the Droplet are not included in its snapshots.
+	droplet, _, err := client.Droplets.Get(ctx, resourceId)
+	if err != nil {
+		return diag.Errorf("Error retrieving Droplet (%d): %s", resourceId, err)
+	}
+
+	var warnings diag.Diagnostics
+	if len(droplet.VolumeIDs) > 0 {
+		switch d.Get("attached_volumes").(string) {
+		case "fail":
+			return diag.Errorf("Droplet (%d) has attached volumes (%s), which would not be included in the snapshot. Set `attached_volumes` to `warn` or `ignore` to snapshot it anyway.",
+				resourceId, strings.Join(droplet.VolumeIDs, ", "))
+		case "warn":
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Attached volumes are not included in the Droplet snapshot",
+				Detail: fmt.Sprintf("The volumes attached to Droplet (%d), %s, are not included in the snapshot %q. Use volume snapshots to back them up, or set `attached_volumes` to `ignore` to hide this warning.",
+					resourceId, strings.Join(droplet.VolumeIDs, ", "), d.Get("name").(string)),
+			})
+		}
+	}
+
+	action, _, err := client.DropletActions.Snapshot(ctx, resourceId, d.Get("name").(string))
 	if err != nil {
 		return diag.Errorf("Error creating Droplet Snapshot: %s", err)
 	}
 
-	if err = util.WaitForAction(client, action); err != nil {
+	if err = util.WaitForAction(ctx, client, action); err != nil {
 		return diag.Errorf(
 			"Error waiting for Droplet snapshot (%v) to finish: %s", resourceId, err)
 	}
 
-	snapshot, err := findSnapshotInSnapshotList(context.Background(), client, *action)
+	snapshot, err := findSnapshotInSnapshotList(ctx, client, *action)
 
 	if err != nil {
 		return diag.Errorf("Error retriving Droplet Snapshot: %s", err)
@@ -94,14 +136,17 @@ func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.Re
 	if err = d.Set("min_disk_size", snapshot.MinDiskSize); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("excluded_volume_ids", droplet.VolumeIDs); err != nil {
+		return diag.FromErr(err)
+	}
 
-	return resourceDigitalOceanDropletSnapshotRead(ctx, d, meta)
+	return append(warnings, resourceDigitalOceanDropletSnapshotRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	snapshot, resp, err := client.Snapshots.Get(context.Background(), d.Id())
+	snapshot, resp, err := client.Snapshots.Get(ctx, d.Id())
 	if err != nil {
 		// If the snapshot is somehow already destroyed, mark as
 		// successfully gone
@@ -119,6 +164,12 @@ func resourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Reso
 	d.Set("created_at", snapshot.Created)
 	d.Set("min_disk_size", snapshot.MinDiskSize)
 
+	// The argument is only set in the configuration, so it is defaulted when
+	// importing.
+	if _, ok := d.GetOk("attached_volumes"); !ok {
+		d.Set("attached_volumes", "warn")
+	}
+
 	return nil
 }
 
@@ -126,7 +177,7 @@ func resourceDigitalOceanDropletSnapshotDelete(ctx context.Context, d *schema.Re
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting snaphot: %s", d.Id())
-	_, err := client.Snapshots.Delete(context.Background(), d.Id())
+	_, err := client.Snapshots.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting snapshot: %s", err)
 	}
@@ -3,6 +3,7 @@ package snapshot_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -28,6 +29,36 @@ func TestAccDigitalOceanDropletSnapshot_Basic(t *testing.T) {
 					testAccCheckDigitalOceanDropletSnapshotExists("digitalocean_droplet_snapshot.foobar", &snapshot),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet_snapshot.foobar", "name", snapName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_snapshot.foobar", "excluded_volume_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletSnapshot_AttachedVolumes(t *testing.T) {
+	var snapshot godo.Snapshot
+	dName := acceptance.RandomTestName()
+	snapName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDropletSnapshotConfig_attachedVolumes, dName, dName, snapName, "fail"),
+				ExpectError: regexp.MustCompile("which would not be included in the snapshot"),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDropletSnapshotConfig_attachedVolumes, dName, dName, snapName, "ignore"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletSnapshotExists("digitalocean_droplet_snapshot.foobar", &snapshot),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_snapshot.foobar", "excluded_volume_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"digitalocean_droplet_snapshot.foobar", "excluded_volume_ids.*", "digitalocean_volume.foo", "id"),
 				),
 			},
 		},
@@ -95,3 +126,24 @@ resource "digitalocean_droplet_snapshot" "foobar" {
   droplet_id = digitalocean_droplet.foo.id
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDropletSnapshotConfig_attachedVolumes = `
+resource "digitalocean_volume" "foo" {
+  region = "nyc3"
+  name   = "%s"
+  size   = 5
+}
+
+resource "digitalocean_droplet" "foo" {
+  name       = "%s"
+  size       = "s-1vcpu-1gb"
+  image      = "ubuntu-22-04-x64"
+  region     = "nyc3"
+  volume_ids = [digitalocean_volume.foo.id]
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id       = digitalocean_droplet.foo.id
+  name             = "%s"
+  attached_volumes = "%s"
+}`
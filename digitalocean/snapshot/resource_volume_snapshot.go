@@ -2,6 +2,7 @@ package snapshot
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/digitalocean/godo"
@@ -43,6 +44,12 @@ func ResourceDigitalOceanVolumeSnapshot() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"volume_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the region of the volume the snapshot was created from",
+			},
+
 			"size": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -59,6 +66,8 @@ func ResourceDigitalOceanVolumeSnapshot() *schema.Resource {
 			},
 
 			"tags": tag.TagsSchema(),
+
+			"tags_authoritative": tag.TagsAuthoritativeSchema(),
 		},
 	}
 }
@@ -73,7 +82,7 @@ func resourceDigitalOceanVolumeSnapshotCreate(ctx context.Context, d *schema.Res
 	}
 
 	log.Printf("[DEBUG] Volume Snapshot create configuration: %#v", opts)
-	snapshot, _, err := client.Storage.CreateSnapshot(context.Background(), opts)
+	snapshot, _, err := client.Storage.CreateSnapshot(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Volume Snapshot: %s", err)
 	}
@@ -88,7 +97,7 @@ func resourceDigitalOceanVolumeSnapshotUpdate(ctx context.Context, d *schema.Res
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	if d.HasChange("tags") {
-		err := tag.SetTags(client, d, godo.VolumeSnapshotResourceType)
+		err := tag.SetTags(ctx, client, d, godo.VolumeSnapshotResourceType)
 		if err != nil {
 			return diag.Errorf("Error updating tags: %s", err)
 		}
@@ -100,7 +109,7 @@ func resourceDigitalOceanVolumeSnapshotUpdate(ctx context.Context, d *schema.Res
 func resourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	snapshot, resp, err := client.Snapshots.Get(context.Background(), d.Id())
+	snapshot, resp, err := client.Snapshots.Get(ctx, d.Id())
 	if err != nil {
 		// If the snapshot is somehow already destroyed, mark as
 		// successfully gone
@@ -112,13 +121,19 @@ func resourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Resou
 		return diag.Errorf("Error retrieving volume snapshot: %s", err)
 	}
 
+	volumeRegion, err := volumeSnapshotRegion(ctx, client, snapshot, d.Get("volume_region").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("name", snapshot.Name)
 	d.Set("volume_id", snapshot.ResourceID)
 	d.Set("regions", snapshot.Regions)
+	d.Set("volume_region", volumeRegion)
 	d.Set("size", snapshot.SizeGigaBytes)
 	d.Set("created_at", snapshot.Created)
 	d.Set("min_disk_size", snapshot.MinDiskSize)
-	d.Set("tags", tag.FlattenTags(snapshot.Tags))
+	tag.SetManagedTags(d, snapshot.Tags)
 
 	return nil
 }
@@ -127,7 +142,7 @@ func resourceDigitalOceanVolumeSnapshotDelete(ctx context.Context, d *schema.Res
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting snaphot: %s", d.Id())
-	_, err := client.Snapshots.Delete(context.Background(), d.Id())
+	_, err := client.Snapshots.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting snapshot: %s", err)
 	}
@@ -135,3 +150,24 @@ func resourceDigitalOceanVolumeSnapshotDelete(ctx context.Context, d *schema.Res
 	d.SetId("")
 	return nil
 }
+
+// volumeSnapshotRegion returns the region of the volume the snapshot was
+// created from, which is the region volumes can be restored from it in. It is
+// read from the volume rather than from the snapshot's regions, which may
+// change. If the volume no longer exists, the given region is returned.
+func volumeSnapshotRegion(ctx context.Context, client *godo.Client, snapshot *godo.Snapshot, fallback string) (string, error) {
+	volume, resp, err := client.Storage.GetVolume(ctx, snapshot.ResourceID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return fallback, nil
+		}
+
+		return "", fmt.Errorf("Error retrieving the volume (%s) of volume snapshot (%s): %s", snapshot.ResourceID, snapshot.ID, err)
+	}
+
+	if volume.Region == nil {
+		return fallback, nil
+	}
+
+	return volume.Region.Slug, nil
+}
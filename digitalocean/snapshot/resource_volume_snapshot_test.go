@@ -3,15 +3,73 @@ package snapshot_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/snapshot"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDigitalOceanVolumeSnapshotReadVolumeRegion(t *testing.T) {
+	volumeStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/snapshots/snap-1":
+			w.Write([]byte(`{"snapshot": {"id": "snap-1", "name": "foo", "resource_id": "vol-1", "resource_type": "volume", "regions": ["nyc1", "sfo3"]}}`))
+		case "/v2/volumes/vol-1":
+			w.WriteHeader(volumeStatus)
+			if volumeStatus == http.StatusOK {
+				w.Write([]byte(`{"volume": {"id": "vol-1", "region": {"slug": "nyc1"}}}`))
+			} else {
+				w.Write([]byte(`{"id": "not_found", "message": "The resource you were accessing could not be found."}`))
+			}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := snapshot.ResourceDigitalOceanVolumeSnapshot()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":      "foo",
+		"volume_id": "vol-1",
+	})
+	d.SetId("snap-1")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if region := d.Get("volume_region").(string); region != "nyc1" {
+		t.Fatalf("Expected the region of the volume, got %q", region)
+	}
+
+	// The region is kept once the volume is destroyed.
+	volumeStatus = http.StatusNotFound
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if region := d.Get("volume_region").(string); region != "nyc1" {
+		t.Fatalf("Expected the region to be kept, got %q", region)
+	}
+}
+
 func TestAccDigitalOceanVolumeSnapshot_Basic(t *testing.T) {
 	var snapshot godo.Snapshot
 	volName := acceptance.RandomTestName("volume")
@@ -32,6 +90,10 @@ func TestAccDigitalOceanVolumeSnapshot_Basic(t *testing.T) {
 						"digitalocean_volume_snapshot.foobar", "size", "0"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_volume_snapshot.foobar", "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"digitalocean_volume_snapshot.foobar", "regions.*", "nyc1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_snapshot.foobar", "volume_region", "nyc1"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_volume_snapshot.foobar", "min_disk_size", "100"),
 					resource.TestCheckResourceAttr(
@@ -0,0 +1,166 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultSnapshotMonthlyPricePerGigabyte is the list price of storing Droplet
+// and volume snapshots, in US dollars per gigabyte and per month.
+const defaultSnapshotMonthlyPricePerGigabyte = 0.06
+
+type listSnapshotsFunc func(ctx context.Context, opts *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error)
+
+func snapshotRecordSchema(resourceIDKey string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the snapshot",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the snapshot",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time the snapshot was created",
+		},
+		"min_disk_size": {
+			Type:        schema.TypeInt,
+			Description: "The minimum size in gigabytes required for a Droplet or volume to be created from the snapshot",
+		},
+		"regions": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The regions where the snapshot is available",
+		},
+		resourceIDKey: {
+			Type:        schema.TypeString,
+			Description: "The ID of the resource the snapshot was created from",
+		},
+		"size": {
+			Type:        schema.TypeFloat,
+			Description: "The billable size of the snapshot in gigabytes",
+		},
+		"estimated_monthly_cost": {
+			Type:        schema.TypeFloat,
+			Description: "The estimated cost of storing the snapshot for a month in all of its regions, in US dollars",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func snapshotPriceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"monthly_price_per_gigabyte": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      defaultSnapshotMonthlyPricePerGigabyte,
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "The price of storing a gigabyte of snapshot for a month in a region, in US dollars, used to estimate costs",
+		},
+	}
+}
+
+func snapshotSummarySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"total_size": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The total billable size of the matching snapshots in gigabytes",
+		},
+		"total_estimated_monthly_cost": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The estimated cost of storing all of the matching snapshots for a month, in US dollars",
+		},
+	}
+}
+
+func getDigitalOceanSnapshots(list listSnapshotsFunc) ([]interface{}, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	snapshots := []interface{}{}
+	for {
+		partialSnapshots, resp, err := list(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving snapshots: %s", err)
+		}
+
+		for _, snapshot := range partialSnapshots {
+			snapshots = append(snapshots, snapshot)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving snapshots: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return snapshots, nil
+}
+
+func flattenDigitalOceanSnapshot(s godo.Snapshot, resourceIDKey string, extra map[string]interface{}) map[string]interface{} {
+	flattenedRegions := schema.NewSet(schema.HashString, []interface{}{})
+	for _, r := range s.Regions {
+		flattenedRegions.Add(r)
+	}
+
+	return map[string]interface{}{
+		"id":                     s.ID,
+		"name":                   s.Name,
+		"created_at":             s.Created,
+		"min_disk_size":          s.MinDiskSize,
+		"regions":                flattenedRegions,
+		resourceIDKey:            s.ResourceID,
+		"size":                   s.SizeGigaBytes,
+		"estimated_monthly_cost": snapshotMonthlyCost(s.SizeGigaBytes, len(s.Regions), extra),
+		"tags":                   tag.FlattenTags(s.Tags),
+	}
+}
+
+// snapshotMonthlyCost estimates the cost of storing a snapshot, which is
+// billed for each of the regions it is stored in.
+func snapshotMonthlyCost(size float64, regions int, extra map[string]interface{}) float64 {
+	price, ok := extra["monthly_price_per_gigabyte"].(float64)
+	if !ok {
+		price = defaultSnapshotMonthlyPricePerGigabyte
+	}
+	if regions < 1 {
+		regions = 1
+	}
+
+	return roundHundredths(size * price * float64(regions))
+}
+
+func summarizeDigitalOceanSnapshots(records []map[string]interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	var totalSize, totalCost float64
+	for _, record := range records {
+		totalSize += record["size"].(float64)
+		totalCost += record["estimated_monthly_cost"].(float64)
+	}
+
+	return map[string]interface{}{
+		"total_size":                   roundHundredths(totalSize),
+		"total_estimated_monthly_cost": roundHundredths(totalCost),
+	}, nil
+}
+
+func roundHundredths(v float64) float64 {
+	return math.Round(v*100) / 100
+}
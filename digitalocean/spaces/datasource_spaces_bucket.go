@@ -43,7 +43,7 @@ func dataSourceDigitalOceanSpacesBucketRead(ctx context.Context, d *schema.Resou
 
 	svc := s3.New(client)
 
-	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err = retryOnAwsCode(ctx, "NoSuchBucket", func() (interface{}, error) {
 		return svc.HeadBucket(&s3.HeadBucketInput{
 			Bucket: aws.String(name),
 		})
@@ -2,6 +2,8 @@ package spaces
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -47,9 +49,58 @@ func DataSourceDigitalOceanSpacesBucketObjects() *schema.Resource {
 				Optional: true,
 				Default:  1000,
 			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "only list object keys after this key",
+			},
+			"continuation_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "continue listing from the next_continuation_token of a previous, truncated listing",
+			},
 
 			// computed attributes
 
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"is_truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether more keys than max_keys match the listing",
+			},
+			"next_continuation_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the token to pass as continuation_token to list the next keys when the listing is truncated",
+			},
+
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -83,8 +134,9 @@ func dataSourceDigitalOceanSpacesBucketObjectsRead(ctx context.Context, d *schem
 
 	d.SetId(resource.UniqueId())
 
-	listInput := s3.ListObjectsInput{
-		Bucket: aws.String(bucket),
+	listInput := s3.ListObjectsV2Input{
+		Bucket:     aws.String(bucket),
+		FetchOwner: aws.Bool(true),
 	}
 
 	if prefix != "" {
@@ -99,19 +151,36 @@ func dataSourceDigitalOceanSpacesBucketObjectsRead(ctx context.Context, d *schem
 		listInput.EncodingType = aws.String(s.(string))
 	}
 
+	if s, ok := d.GetOk("start_after"); ok {
+		listInput.StartAfter = aws.String(s.(string))
+	}
+
+	if s, ok := d.GetOk("continuation_token"); ok {
+		listInput.ContinuationToken = aws.String(s.(string))
+	}
+
 	// "listInput.MaxKeys" refers to max keys returned in a single request
 	// (i.e., page size), not the total number of keys returned if you page
 	// through the results. "maxKeys" does refer to total keys returned.
 	maxKeys := int64(d.Get("max_keys").(int))
-	if maxKeys <= keyRequestPageSize {
-		listInput.MaxKeys = aws.Int64(maxKeys)
-	}
 
 	var commonPrefixes []string
 	var keys []string
 	var owners []string
+	var objects []map[string]interface{}
+	var nextContinuationToken string
+	truncated := false
+
+	for maxKeys > 0 {
+		if maxKeys <= keyRequestPageSize {
+			listInput.MaxKeys = aws.Int64(maxKeys)
+		}
+
+		page, err := conn.ListObjectsV2WithContext(ctx, &listInput)
+		if err != nil {
+			return diag.Errorf("error listing Spaces Bucket (%s) Objects: %s", bucket, err)
+		}
 
-	err = conn.ListObjectsPages(&listInput, func(page *s3.ListObjectsOutput, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
 			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
 		}
@@ -122,19 +191,32 @@ func dataSourceDigitalOceanSpacesBucketObjectsRead(ctx context.Context, d *schem
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
-		}
 
-		maxKeys = maxKeys - int64(len(page.Contents))
+			lastModified := ""
+			if object.LastModified != nil {
+				lastModified = object.LastModified.UTC().Format(time.RFC3339)
+			}
 
-		if maxKeys <= keyRequestPageSize {
-			listInput.MaxKeys = aws.Int64(maxKeys)
+			objects = append(objects, map[string]interface{}{
+				"key":           aws.StringValue(object.Key),
+				"size":          int(aws.Int64Value(object.Size)),
+				"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+				"last_modified": lastModified,
+				"storage_class": aws.StringValue(object.StorageClass),
+			})
 		}
 
-		return !lastPage
-	})
+		maxKeys = maxKeys - int64(len(page.Contents)) - int64(len(page.CommonPrefixes))
 
-	if err != nil {
-		return diag.Errorf("error listing Spaces Bucket (%s) Objects: %s", bucket, err)
+		truncated = aws.BoolValue(page.IsTruncated)
+		nextContinuationToken = aws.StringValue(page.NextContinuationToken)
+		if !truncated || nextContinuationToken == "" {
+			truncated = false
+			nextContinuationToken = ""
+			break
+		}
+
+		listInput.ContinuationToken = page.NextContinuationToken
 	}
 
 	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
@@ -149,5 +231,12 @@ func dataSourceDigitalOceanSpacesBucketObjectsRead(ctx context.Context, d *schem
 		return diag.Errorf("error setting owners: %s", err)
 	}
 
+	if err := d.Set("objects", objects); err != nil {
+		return diag.Errorf("error setting objects: %s", err)
+	}
+
+	d.Set("is_truncated", truncated)
+	d.Set("next_continuation_token", nextContinuationToken)
+
 	return nil
 }
@@ -137,6 +137,21 @@ func TestAccDataSourceDigitalOceanSpacesBucketObjects_maxKeys(t *testing.T) {
 					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "keys.#", "2"),
 					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "keys.0", "arch/courthouse_towers/landscape"),
 					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "keys.1", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "is_truncated", "true"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_spaces_bucket_objects.yesh", "next_continuation_token"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.yesh", "objects.0.key", "arch/courthouse_towers/landscape"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_spaces_bucket_objects.yesh", "objects.0.etag"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_spaces_bucket_objects.yesh", "objects.0.last_modified"),
+				),
+			},
+			{
+				Config: testAccDataSourceDigitalOceanSpacesObjectsConfigContinuationToken(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.next", "keys.#", "5"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.next", "keys.0", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.next", "is_truncated", "false"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_bucket_objects.next", "next_continuation_token", ""),
 				),
 			},
 		},
@@ -291,3 +306,15 @@ data "digitalocean_spaces_bucket_objects" "yesh" {
 }
 `, testAccDataSourceDigitalOceanSpacesObjectsConfigResources(name))
 }
+
+func testAccDataSourceDigitalOceanSpacesObjectsConfigContinuationToken(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "digitalocean_spaces_bucket_objects" "next" {
+  bucket             = digitalocean_spaces_bucket.objects_bucket.name
+  region             = digitalocean_spaces_bucket.objects_bucket.region
+  continuation_token = data.digitalocean_spaces_bucket_objects.yesh.next_continuation_token
+}
+`, testAccDataSourceDigitalOceanSpacesObjectsConfigMaxKeys(name))
+}
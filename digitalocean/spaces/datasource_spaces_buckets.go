@@ -3,6 +3,7 @@ package spaces
 import (
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceDigitalOceanSpacesBuckets() *schema.Resource {
@@ -11,6 +12,14 @@ func DataSourceDigitalOceanSpacesBuckets() *schema.Resource {
 		ResultAttributeName: "buckets",
 		FlattenRecord:       flattenSpacesBucket,
 		GetRecords:          getDigitalOceanBuckets,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(SpacesRegions, true)},
+				Description: "the regions to list buckets in, defaults to all regions with Spaces",
+			},
+		},
 	}
 
 	return datalist.NewResource(dataListConfig)
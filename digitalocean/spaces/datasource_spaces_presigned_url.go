@@ -0,0 +1,124 @@
+package spaces
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maxPresignedURLExpiry is the longest validity of a URL signed with
+// Signature Version 4.
+const maxPresignedURLExpiry = 7 * 24 * 60 * 60
+
+func DataSourceDigitalOceanSpacesPresignedURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanSpacesPresignedURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodGet,
+				ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodPut}, false),
+				Description:  "The HTTP method allowed by the URL, either GET to download the object or PUT to upload it",
+			},
+			"expires_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(1, maxPresignedURLExpiry),
+				Description:  "The number of seconds for which the URL is valid",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Content-Type the upload must be made with, only used with the PUT method",
+			},
+
+			// computed attributes
+
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the URL expires, (RFC3339)",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanSpacesPresignedURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region := d.Get("region").(string)
+	client, err := meta.(*config.CombinedConfig).SpacesClient(region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conn := s3.New(client)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+	expiry := time.Duration(d.Get("expires_in").(int)) * time.Second
+
+	var req *request.Request
+	switch method {
+	case http.MethodPut:
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("content_type"); ok {
+			input.ContentType = aws.String(v.(string))
+		}
+		req, _ = conn.PutObjectRequest(input)
+	default:
+		req, _ = conn.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	}
+	req.SetContext(ctx)
+
+	log.Printf("[DEBUG] Presigning %s URL for Spaces object %s/%s valid for %s", method, bucket, key, expiry)
+	signedAt := time.Now().UTC()
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return diag.Errorf("Error presigning %s URL for Spaces object %s/%s: %s", method, bucket, key, err)
+	}
+
+	d.SetId(method + " " + bucket + "/" + key)
+	d.Set("url", url)
+	d.Set("expires_at", signedAt.Add(expiry).Format(time.RFC3339))
+
+	return nil
+}
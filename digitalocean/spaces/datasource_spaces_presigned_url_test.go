@@ -0,0 +1,108 @@
+package spaces_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDigitalOceanSpacesPresignedURL_basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDigitalOceanSpacesPresignedURLConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_presigned_url.get", "method", "GET"),
+					resource.TestMatchResourceAttr("data.digitalocean_spaces_presigned_url.get", "url",
+						regexp.MustCompile(`^https://.+X-Amz-Expires=900`)),
+					resource.TestCheckResourceAttrSet("data.digitalocean_spaces_presigned_url.get", "expires_at"),
+					testAccCheckDigitalOceanSpacesPresignedURL("data.digitalocean_spaces_presigned_url.get", http.MethodGet, "", "yes"),
+					resource.TestCheckResourceAttr("data.digitalocean_spaces_presigned_url.put", "method", "PUT"),
+					testAccCheckDigitalOceanSpacesPresignedURL("data.digitalocean_spaces_presigned_url.put", http.MethodPut, "uploaded", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanSpacesPresignedURL(n, method, body, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		req, err := http.NewRequest(method, rs.Primary.Attributes["url"], strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		if contentType := rs.Primary.Attributes["content_type"]; contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		content, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("Expected status 200 using the %s URL, got %d: %s", method, resp.StatusCode, content)
+		}
+		if expected != "" && string(content) != expected {
+			return fmt.Errorf("Expected content %q using the %s URL, got %q", expected, method, content)
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceDigitalOceanSpacesPresignedURLConfig(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_spaces_bucket" "bucket" {
+  name          = "%s"
+  region        = "nyc3"
+  force_destroy = true
+}
+
+resource "digitalocean_spaces_bucket_object" "object" {
+  bucket       = digitalocean_spaces_bucket.bucket.name
+  region       = digitalocean_spaces_bucket.bucket.region
+  key          = "download.txt"
+  content      = "yes"
+  content_type = "text/plain"
+}
+
+data "digitalocean_spaces_presigned_url" "get" {
+  bucket     = digitalocean_spaces_bucket.bucket.name
+  region     = digitalocean_spaces_bucket.bucket.region
+  key        = digitalocean_spaces_bucket_object.object.key
+  expires_in = 900
+}
+
+data "digitalocean_spaces_presigned_url" "put" {
+  bucket       = digitalocean_spaces_bucket.bucket.name
+  region       = digitalocean_spaces_bucket.bucket.region
+  key          = "upload.txt"
+  method       = "PUT"
+  content_type = "text/plain"
+}
+`, name)
+}
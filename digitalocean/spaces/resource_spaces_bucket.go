@@ -201,7 +201,41 @@ func ResourceDigitalOceanBucket() *schema.Resource {
 				Default:     false,
 			},
 		},
+
+		CustomizeDiff: resourceDigitalOceanBucketDiff,
+	}
+}
+
+// resourceDigitalOceanBucketDiff returns an error when planning to change the
+// region of a bucket which is not empty unless force_destroy is set, as the
+// bucket is replaced and its objects are deleted along with it. The objects
+// are deleted using the force_destroy value in the state, so it has to be set
+// before the region is changed.
+func resourceDigitalOceanBucketDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("region") {
+		return nil
+	}
+
+	if forceDestroy, _ := diff.GetChange("force_destroy"); forceDestroy.(bool) {
+		return nil
+	}
+
+	oldRegion, _ := diff.GetChange("region")
+	client, err := meta.(*config.CombinedConfig).SpacesClient(oldRegion.(string))
+	if err != nil {
+		return fmt.Errorf("Error checking the objects of bucket %s: %s", diff.Id(), err)
+	}
+
+	empty, err := spacesBucketEmpty(s3.New(client), diff.Get("name").(string))
+	if err != nil {
+		return fmt.Errorf("Error checking the objects of bucket %s: %s", diff.Id(), err)
 	}
+
+	if !empty {
+		return fmt.Errorf("changing the `region` of bucket %s replaces it and deletes its objects, set `force_destroy = true` and apply it before changing the region", diff.Id())
+	}
+
+	return nil
 }
 
 func resourceDigitalOceanBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -220,7 +254,7 @@ func resourceDigitalOceanBucketCreate(ctx context.Context, d *schema.ResourceDat
 		ACL:    aws.String(d.Get("acl").(string)),
 	}
 
-	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+	err = util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		log.Printf("[DEBUG] Trying to create new Spaces bucket: %q", name)
 		_, err := svc.CreateBucket(input)
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -241,7 +275,7 @@ func resourceDigitalOceanBucketCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error creating Spaces bucket: %s", err)
 	}
 
-	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+	err = util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		_, err := svc.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(name)})
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NotFound" {
@@ -272,25 +306,25 @@ func resourceDigitalOceanBucketUpdate(ctx context.Context, d *schema.ResourceDat
 	svc := s3.New(client)
 
 	if d.HasChange("acl") {
-		if err := resourceDigitalOceanBucketACLUpdate(svc, d); err != nil {
+		if err := resourceDigitalOceanBucketACLUpdate(ctx, svc, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceDigitalOceanBucketCorsUpdate(svc, d); err != nil {
+		if err := resourceDigitalOceanBucketCorsUpdate(ctx, svc, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceDigitalOceanSpacesBucketVersioningUpdate(svc, d); err != nil {
+		if err := resourceDigitalOceanSpacesBucketVersioningUpdate(ctx, svc, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
-		if err := resourceDigitalOceanBucketLifecycleUpdate(svc, d); err != nil {
+		if err := resourceDigitalOceanBucketLifecycleUpdate(ctx, svc, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -308,7 +342,7 @@ func resourceDigitalOceanBucketRead(ctx context.Context, d *schema.ResourceData,
 
 	svc := s3.New(client)
 
-	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err = retryOnAwsCode(ctx, "NoSuchBucket", func() (interface{}, error) {
 		return svc.HeadBucket(&s3.HeadBucketInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -333,7 +367,7 @@ func resourceDigitalOceanBucketRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("bucket_domain_name", BucketDomainName(d.Get("name").(string), d.Get("region").(string)))
 
 	// Add the region as an attribute
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	locationResponse, err := retryOnAwsCode(ctx, "NoSuchBucket", func() (interface{}, error) {
 		return svc.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(d.Id()),
@@ -353,7 +387,7 @@ func resourceDigitalOceanBucketRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Read the versioning configuration
-	versioningResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	versioningResponse, err := retryOnAwsCode(ctx, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return svc.GetBucketVersioning(&s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -376,7 +410,7 @@ func resourceDigitalOceanBucketRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Read the lifecycle configuration
-	lifecycleResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	lifecycleResponse, err := retryOnAwsCode(ctx, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return svc.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -515,7 +549,7 @@ func resourceDigitalOceanBucketDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func resourceDigitalOceanBucketACLUpdate(svc *s3.S3, d *schema.ResourceData) error {
+func resourceDigitalOceanBucketACLUpdate(ctx context.Context, svc *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("name").(string)
 
@@ -525,7 +559,7 @@ func resourceDigitalOceanBucketACLUpdate(svc *s3.S3, d *schema.ResourceData) err
 	}
 	log.Printf("[DEBUG] Spaces put bucket ACL: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(ctx, "NoSuchBucket", func() (interface{}, error) {
 		return svc.PutBucketAcl(i)
 	})
 	if err != nil {
@@ -535,7 +569,7 @@ func resourceDigitalOceanBucketACLUpdate(svc *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func resourceDigitalOceanBucketCorsUpdate(svc *s3.S3, d *schema.ResourceData) error {
+func resourceDigitalOceanBucketCorsUpdate(ctx context.Context, svc *s3.S3, d *schema.ResourceData) error {
 	rawCors := d.Get("cors_rule").([]interface{})
 	bucket := d.Get("name").(string)
 
@@ -592,7 +626,7 @@ func resourceDigitalOceanBucketCorsUpdate(svc *s3.S3, d *schema.ResourceData) er
 	return nil
 }
 
-func resourceDigitalOceanSpacesBucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceDigitalOceanSpacesBucketVersioningUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("name").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -615,7 +649,7 @@ func resourceDigitalOceanSpacesBucketVersioningUpdate(s3conn *s3.S3, d *schema.R
 	}
 	log.Printf("[DEBUG] Spaces PUT bucket versioning: %#v", i)
 
-	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryOnAwsCode(ctx, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3conn.PutBucketVersioning(i)
 	})
 	if err != nil {
@@ -625,7 +659,7 @@ func resourceDigitalOceanSpacesBucketVersioningUpdate(s3conn *s3.S3, d *schema.R
 	return nil
 }
 
-func resourceDigitalOceanBucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceDigitalOceanBucketLifecycleUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("name").(string)
 
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
@@ -717,7 +751,7 @@ func resourceDigitalOceanBucketLifecycleUpdate(s3conn *s3.S3, d *schema.Resource
 		},
 	}
 
-	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err := retryOnAwsCode(ctx, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3conn.PutBucketLifecycleConfiguration(i)
 	})
 	if err != nil {
@@ -752,9 +786,9 @@ func BucketEndpoint(region string) string {
 	return fmt.Sprintf("%s.digitaloceanspaces.com", region)
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+func retryOnAwsCode(ctx context.Context, code string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
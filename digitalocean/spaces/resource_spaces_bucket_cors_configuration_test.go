@@ -208,7 +208,7 @@ func testAccCheckDigitalOceanSpacesBucketCorsConfigurationExists(ctx context.Con
 			return err
 		}
 
-		response, err := s3conn.GetBucketCorsWithContext(context.Background(),
+		response, err := s3conn.GetBucketCorsWithContext(ctx,
 			&s3.GetBucketCorsInput{
 				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
 			})
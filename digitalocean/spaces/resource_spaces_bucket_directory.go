@@ -0,0 +1,529 @@
+package spaces
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+// directoryUploadConcurrency is the number of files uploaded at the same time.
+const directoryUploadConcurrency = 8
+
+func ResourceDigitalOceanSpacesBucketDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanSpacesBucketDirectorySync,
+		ReadContext:   resourceDigitalOceanSpacesBucketDirectoryRead,
+		UpdateContext: resourceDigitalOceanSpacesBucketDirectorySync,
+		DeleteContext: resourceDigitalOceanSpacesBucketDirectoryDelete,
+
+		CustomizeDiff: resourceDigitalOceanSpacesBucketDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
+			},
+
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile(`^/`), "must not start with a slash"),
+				Description:  "the prefix the files are uploaded under, e.g. site/",
+			},
+
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the path of the local directory to upload",
+			},
+
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "glob patterns of the paths, relative to the source directory, to not upload",
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  s3.ObjectCannedACLPrivate,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "content types by file extension, overriding the ones detected",
+			},
+
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether to delete objects under the prefix which do not exist in the source directory",
+			},
+
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the MD5 hashes of the uploaded files by path relative to the source directory",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanSpacesBucketDirectoryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("files")
+	}
+
+	local, err := HashDirectory(d.Get("source").(string), expandDirectoryExclude(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("files").(map[string]interface{})
+	if len(old) == len(local) {
+		changed := false
+		for rel, hash := range local {
+			if old[rel] != hash {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	files := make(map[string]interface{}, len(local))
+	for rel, hash := range local {
+		files[rel] = hash
+	}
+
+	return d.SetNew("files", files)
+}
+
+func resourceDigitalOceanSpacesBucketDirectorySync(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := s3connFromResourceData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	source, err := homedir.Expand(d.Get("source").(string))
+	if err != nil {
+		return diag.Errorf("Error expanding homedir in source (%s): %s", d.Get("source").(string), err)
+	}
+
+	local, err := HashDirectory(source, expandDirectoryExclude(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := listSpacesDirectoryObjects(ctx, conn, bucket, prefix)
+	if err != nil {
+		return diag.Errorf("Error listing objects in Spaces bucket (%s): %s", bucket, err)
+	}
+
+	// Changing the attributes of the objects requires all of them to be uploaded again.
+	uploadAll := d.IsNewResource() || d.HasChanges("acl", "cache_control", "content_types")
+
+	var uploads []string
+	for rel, hash := range local {
+		if uploadAll || remote[rel] != hash {
+			uploads = append(uploads, rel)
+		}
+	}
+	sort.Strings(uploads)
+
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	err = runDirectoryUploads(uploads, func(rel string) error {
+		input := &s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(DirectoryObjectKey(prefix, rel)),
+			ACL:         aws.String(d.Get("acl").(string)),
+			ContentType: aws.String(DirectoryContentType(rel, contentTypes)),
+		}
+
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+
+		return putSpacesDirectoryFile(ctx, conn, input, filepath.Join(source, filepath.FromSlash(rel)))
+	})
+	if err != nil {
+		return diag.Errorf("Error uploading files to Spaces bucket (%s): %s", bucket, err)
+	}
+
+	if d.Get("delete_removed").(bool) {
+		var removed []string
+		for rel := range remote {
+			if _, ok := local[rel]; !ok {
+				removed = append(removed, rel)
+			}
+		}
+
+		if err := deleteSpacesDirectoryObjects(ctx, conn, bucket, prefix, removed); err != nil {
+			return diag.Errorf("Error deleting removed files from Spaces bucket (%s): %s", bucket, err)
+		}
+	}
+
+	files := make(map[string]interface{}, len(local))
+	for rel, hash := range local {
+		files[rel] = hash
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, prefix))
+	if err := d.Set("files", files); err != nil {
+		return diag.Errorf("Error setting files: %s", err)
+	}
+
+	return resourceDigitalOceanSpacesBucketDirectoryRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanSpacesBucketDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := s3connFromResourceData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	remote, err := listSpacesDirectoryObjects(ctx, conn, bucket, d.Get("prefix").(string))
+	if err != nil {
+		if IsAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] Spaces bucket (%s) not found, removing directory from state", bucket)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error listing objects in Spaces bucket (%s): %s", bucket, err)
+	}
+
+	// Only the files uploaded by the resource are tracked, unless removed
+	// files are deleted, in which case other objects under the prefix are
+	// tracked as well so that they are shown as removed in the plan.
+	files := map[string]interface{}{}
+	for rel := range d.Get("files").(map[string]interface{}) {
+		if etag, ok := remote[rel]; ok {
+			files[rel] = etag
+		}
+	}
+
+	if d.Get("delete_removed").(bool) {
+		for rel, etag := range remote {
+			files[rel] = etag
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return diag.Errorf("Error setting files: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanSpacesBucketDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := s3connFromResourceData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	var files []string
+	for rel := range d.Get("files").(map[string]interface{}) {
+		files = append(files, rel)
+	}
+
+	if err := deleteSpacesDirectoryObjects(ctx, conn, bucket, d.Get("prefix").(string), files); err != nil {
+		if IsAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+
+		return diag.Errorf("Error deleting files from Spaces bucket (%s): %s", bucket, err)
+	}
+
+	return nil
+}
+
+// DirectoryObjectKey returns the key of the object for a file, where rel is
+// the slash-separated path of the file relative to the uploaded directory.
+func DirectoryObjectKey(prefix, rel string) string {
+	if prefix == "" {
+		return rel
+	}
+
+	return strings.TrimSuffix(prefix, "/") + "/" + rel
+}
+
+// DirectoryContentType returns the content type of a file based on its
+// extension. The overrides are keyed by extension, with or without the
+// leading dot.
+func DirectoryContentType(rel string, overrides map[string]interface{}) string {
+	ext := strings.ToLower(path.Ext(rel))
+
+	for k, v := range overrides {
+		if "."+strings.TrimPrefix(strings.ToLower(k), ".") == ext {
+			return v.(string)
+		}
+	}
+
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType
+	}
+
+	return "application/octet-stream"
+}
+
+// HashDirectory returns the hex-encoded MD5 hashes of the files in a
+// directory, keyed by their slash-separated path relative to the directory.
+// Files matching one of the exclude patterns are skipped.
+func HashDirectory(source string, exclude []string) (map[string]string, error) {
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+
+	hashes := map[string]string{}
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range exclude {
+			if matched, _ := path.Match(pattern, rel); matched {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		hash, err := hashFile(p)
+		if err != nil {
+			return err
+		}
+		hashes[rel] = hash
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source directory (%s): %s", source, err)
+	}
+
+	return hashes, nil
+}
+
+func hashFile(p string) (string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func expandDirectoryExclude(raw []interface{}) []string {
+	exclude := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok && s != "" {
+			exclude = append(exclude, s)
+		}
+	}
+
+	return exclude
+}
+
+// listSpacesDirectoryObjects returns the ETags of the objects under the
+// prefix, keyed by their path relative to the prefix.
+func listSpacesDirectoryObjects(ctx context.Context, conn *s3.S3, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	keyPrefix := ""
+	if prefix != "" {
+		keyPrefix = strings.TrimSuffix(prefix, "/") + "/"
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	objects := map[string]string{}
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			rel := strings.TrimPrefix(aws.StringValue(object.Key), keyPrefix)
+			if rel == "" || strings.HasSuffix(rel, "/") {
+				continue
+			}
+
+			objects[rel] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+func putSpacesDirectoryFile(ctx context.Context, conn *s3.S3, input *s3.PutObjectInput, p string) error {
+	file, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	input.Body = file
+
+	log.Printf("[DEBUG] Uploading %s to Spaces bucket (%s) as %s", p, aws.StringValue(input.Bucket), aws.StringValue(input.Key))
+	if _, err := conn.PutObjectWithContext(ctx, input); err != nil {
+		return fmt.Errorf("%s: %s", aws.StringValue(input.Key), err)
+	}
+
+	return nil
+}
+
+// runDirectoryUploads calls upload for each of the files, uploading up to
+// directoryUploadConcurrency files at the same time.
+func runDirectoryUploads(files []string, upload func(rel string) error) error {
+	work := make(chan string)
+	var mu sync.Mutex
+	var errs []string
+
+	var wg sync.WaitGroup
+	for i := 0; i < directoryUploadConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rel := range work {
+				if err := upload(rel); err != nil {
+					mu.Lock()
+					errs = append(errs, err.Error())
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, rel := range files {
+		work <- rel
+	}
+	close(work)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func deleteSpacesDirectoryObjects(ctx context.Context, conn *s3.S3, bucket, prefix string, files []string) error {
+	sort.Strings(files)
+
+	// DeleteObjects accepts up to 1000 keys per request.
+	for start := 0; start < len(files); start += 1000 {
+		end := start + 1000
+		if end > len(files) {
+			end = len(files)
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, rel := range files[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(DirectoryObjectKey(prefix, rel)),
+			})
+		}
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Errors) > 0 {
+			e := output.Errors[0]
+			return fmt.Errorf("%s: %s", aws.StringValue(e.Key), aws.StringValue(e.Message))
+		}
+	}
+
+	return nil
+}
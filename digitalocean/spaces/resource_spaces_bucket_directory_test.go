@@ -0,0 +1,243 @@
+package spaces_test
+
+import (
+	"crypto/md5"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/spaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDirectoryContentType(t *testing.T) {
+	overrides := map[string]interface{}{
+		"wasm": "application/wasm",
+		".MD":  "text/markdown",
+	}
+
+	cases := map[string]string{
+		"index.html":       "text/html; charset=utf-8",
+		"css/site.CSS":     "text/css; charset=utf-8",
+		"app.wasm":         "application/wasm",
+		"README.md":        "text/markdown",
+		"LICENSE":          "application/octet-stream",
+		"data.unknown-ext": "application/octet-stream",
+	}
+
+	for rel, expected := range cases {
+		if contentType := spaces.DirectoryContentType(rel, overrides); contentType != expected {
+			t.Errorf("%s: expected %s, got %s", rel, expected, contentType)
+		}
+	}
+}
+
+func TestDirectoryObjectKey(t *testing.T) {
+	cases := []struct {
+		prefix   string
+		expected string
+	}{
+		{"", "css/site.css"},
+		{"site", "site/css/site.css"},
+		{"site/", "site/css/site.css"},
+	}
+
+	for _, c := range cases {
+		if key := spaces.DirectoryObjectKey(c.prefix, "css/site.css"); key != c.expected {
+			t.Errorf("prefix %q: expected %s, got %s", c.prefix, c.expected, key)
+		}
+	}
+}
+
+func TestHashDirectory(t *testing.T) {
+	dir := t.TempDir()
+	testAccWriteDirectoryFile(t, dir, "index.html", "<h1>Hello</h1>")
+	testAccWriteDirectoryFile(t, dir, "css/site.css", "body {}")
+	testAccWriteDirectoryFile(t, dir, ".git/HEAD", "ref: refs/heads/main")
+	testAccWriteDirectoryFile(t, dir, "notes.tmp", "scratch")
+
+	hashes, err := spaces.HashDirectory(dir, []string{".git", "*.tmp"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"index.html":   fmt.Sprintf("%x", md5.Sum([]byte("<h1>Hello</h1>"))),
+		"css/site.css": fmt.Sprintf("%x", md5.Sum([]byte("body {}"))),
+	}
+	if !reflect.DeepEqual(hashes, expected) {
+		t.Fatalf("expected %v, got %v", expected, hashes)
+	}
+
+	if _, err := spaces.HashDirectory(filepath.Join(dir, "missing"), nil); err == nil {
+		t.Fatalf("expected an error for a missing directory")
+	}
+}
+
+func TestAccDigitalOceanSpacesBucketDirectory_basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dir := t.TempDir()
+	testAccWriteDirectoryFile(t, dir, "index.html", "<h1>Hello</h1>")
+	testAccWriteDirectoryFile(t, dir, "css/site.css", "body {}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanSpacesBucketDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanSpacesBucketDirectoryConfig(name, dir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_spaces_bucket_directory.site", "files.%", "2"),
+					testAccCheckDigitalOceanSpacesBucketDirectoryObject("digitalocean_spaces_bucket_directory.site", "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDigitalOceanSpacesBucketDirectoryObject("digitalocean_spaces_bucket_directory.site", "site/css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteDirectoryFile(t, dir, "index.html", "<h1>Hello, again</h1>")
+					testAccWriteDirectoryFile(t, dir, "app.wasm", "wasm")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDigitalOceanSpacesBucketDirectoryConfig(name, dir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_spaces_bucket_directory.site", "files.%", "2"),
+					resource.TestCheckNoResourceAttr("digitalocean_spaces_bucket_directory.site", "files.css/site.css"),
+					testAccCheckDigitalOceanSpacesBucketDirectoryObject("digitalocean_spaces_bucket_directory.site", "site/app.wasm", "application/wasm"),
+					testAccCheckDigitalOceanSpacesBucketDirectoryObjectMissing("digitalocean_spaces_bucket_directory.site", "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWriteDirectoryFile(t *testing.T, dir, rel, content string) {
+	p := filepath.Join(dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccGetSpacesDirectoryConn(region string) (*s3.S3, error) {
+	client, err := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).SpacesClient(region)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3.New(client), nil
+}
+
+func testAccCheckDigitalOceanSpacesBucketDirectoryObject(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn, err := testAccGetSpacesDirectoryConn(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("Error getting object %s: %s", key, err)
+		}
+
+		if aws.StringValue(out.ContentType) != contentType {
+			return fmt.Errorf("Expected content type of %s to be %s, got %s", key, contentType, aws.StringValue(out.ContentType))
+		}
+
+		if aws.StringValue(out.CacheControl) != "max-age=300" {
+			return fmt.Errorf("Expected cache control of %s to be max-age=300, got %s", key, aws.StringValue(out.CacheControl))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanSpacesBucketDirectoryObjectMissing(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn, err := testAccGetSpacesDirectoryConn(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err == nil {
+			return fmt.Errorf("Expected object %s to be deleted", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanSpacesBucketDirectoryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_spaces_bucket" {
+			continue
+		}
+
+		conn, err := testAccGetSpacesDirectoryConn(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.HeadBucket(&s3.HeadBucketInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Spaces Bucket still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDigitalOceanSpacesBucketDirectoryConfig(name, dir string, deleteRemoved bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_spaces_bucket" "site" {
+  region        = "nyc3"
+  name          = "%s"
+  force_destroy = true
+}
+
+resource "digitalocean_spaces_bucket_directory" "site" {
+  region         = digitalocean_spaces_bucket.site.region
+  bucket         = digitalocean_spaces_bucket.site.name
+  prefix         = "site/"
+  source         = "%s"
+  acl            = "public-read"
+  cache_control  = "max-age=300"
+  delete_removed = %t
+
+  content_types = {
+    wasm = "application/wasm"
+  }
+}
+`, name, filepath.ToSlash(dir), deleteRemoved)
+}
@@ -122,9 +122,24 @@ func resourceDigitalOceanBucketPolicyRead(ctx context.Context, d *schema.Resourc
 		Bucket: aws.String(d.Id()),
 	})
 
-	policy := ""
-	if err == nil && response.Policy != nil {
-		policy = aws.StringValue(response.Policy)
+	fetched := ""
+	if err != nil {
+		if IsAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] Spaces bucket (%s) not found, removing bucket policy from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if !IsAWSErr(err, "NoSuchBucketPolicy", "") {
+			return diag.Errorf("Error occurred while fetching Spaces bucket policy: %s", err)
+		}
+	} else if response.Policy != nil {
+		fetched = aws.StringValue(response.Policy)
+	}
+
+	policy, err := SpacesBucketPolicyToSet(d.Get("policy").(string), fetched)
+	if err != nil {
+		return diag.Errorf("Error occurred while fetching Spaces bucket policy: %s", err)
 	}
 
 	d.Set("bucket", d.Id())
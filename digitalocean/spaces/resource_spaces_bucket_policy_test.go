@@ -19,6 +19,56 @@ const (
 	testAccDigitalOceanSpacesBucketPolicy_TestRegion = "nyc3"
 )
 
+func TestSpacesBucketPolicyToSet(t *testing.T) {
+	existing := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`
+
+	cases := []struct {
+		name     string
+		existing string
+		fetched  string
+		expected string
+	}{
+		{
+			name:     "equivalent with different ordering and whitespace",
+			existing: existing,
+			fetched: `{
+  "Statement": [{"Resource": "arn:aws:s3:::bucket/*", "Action": "s3:GetObject", "Principal": "*", "Effect": "Allow"}],
+  "Version": "2012-10-17"
+}`,
+			expected: existing,
+		},
+		{
+			name:     "changed outside of Terraform",
+			existing: existing,
+			fetched:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*"}]}`,
+			expected: `{"Statement":[{"Action":"s3:*","Effect":"Deny","Principal":"*","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			name:     "policy removed",
+			existing: existing,
+			fetched:  "",
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			policy, err := spaces.SpacesBucketPolicyToSet(c.existing, c.fetched)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if policy != c.expected {
+				t.Fatalf("expected %s, got %s", c.expected, policy)
+			}
+		})
+	}
+
+	if _, err := spaces.SpacesBucketPolicyToSet(existing, "{"); err == nil {
+		t.Fatalf("expected an error for invalid JSON")
+	}
+}
+
 func TestAccDigitalOceanBucketPolicy_basic(t *testing.T) {
 	name := acceptance.RandomTestName()
 
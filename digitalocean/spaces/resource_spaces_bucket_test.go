@@ -439,6 +439,28 @@ resource "digitalocean_spaces_bucket" "bucket" {
 	})
 }
 
+func TestAccDigitalOceanSpacesBucket_RegionChangeNotEmpty(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanSpacesBucketConfigWithObject(name, "ams3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBucketExists("digitalocean_spaces_bucket.bucket"),
+				),
+			},
+			{
+				Config:      testAccDigitalOceanSpacesBucketConfigWithObject(name, "nyc3"),
+				ExpectError: regexp.MustCompile("set `force_destroy = true` and apply it before changing the region"),
+			},
+		},
+	})
+}
+
 func testAccGetS3ConnForSpacesBucket(rs *terraform.ResourceState) (*s3.S3, error) {
 	rawRegion := ""
 	if actualRegion, ok := rs.Primary.Attributes["region"]; ok {
@@ -656,6 +678,22 @@ resource "digitalocean_spaces_bucket" "bucket" {
 `, name)
 }
 
+func testAccDigitalOceanSpacesBucketConfigWithObject(name, region string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_spaces_bucket" "bucket" {
+  name   = "%s"
+  region = "%s"
+}
+
+resource "digitalocean_spaces_bucket_object" "object" {
+  region  = digitalocean_spaces_bucket.bucket.region
+  bucket  = digitalocean_spaces_bucket.bucket.name
+  key     = "test-key"
+  content = "test content"
+}
+`, name, region)
+}
+
 func testAccDigitalOceanBucketConfigImport(name string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_spaces_bucket" "bucket" {
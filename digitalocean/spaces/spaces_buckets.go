@@ -1,13 +1,21 @@
 package spaces
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
 
 var (
@@ -15,6 +23,10 @@ var (
 	SpacesRegions = []string{"ams3", "blr1", "fra1", "nyc3", "sfo2", "sfo3", "sgp1", "syd1"}
 )
 
+// spacesListBucketsTimeout is the time allowed to list the buckets in a single
+// region before the region is reported as unavailable.
+const spacesListBucketsTimeout = 30 * time.Second
+
 type bucketMetadataStruct struct {
 	name   string
 	region string
@@ -46,6 +58,10 @@ func spacesBucketSchema() map[string]*schema.Schema {
 }
 
 func getSpacesBucketsInRegion(meta interface{}, region string) ([]*s3.Bucket, error) {
+	return getSpacesBucketsInRegionWithContext(context.Background(), meta, region)
+}
+
+func getSpacesBucketsInRegionWithContext(ctx context.Context, meta interface{}, region string) ([]*s3.Bucket, error) {
 	client, err := meta.(*config.CombinedConfig).SpacesClient(region)
 	if err != nil {
 		return nil, err
@@ -54,7 +70,7 @@ func getSpacesBucketsInRegion(meta interface{}, region string) ([]*s3.Bucket, er
 	svc := s3.New(client)
 
 	input := s3.ListBucketsInput{}
-	output, err := svc.ListBuckets(&input)
+	output, err := svc.ListBucketsWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -65,15 +81,45 @@ func getSpacesBucketsInRegion(meta interface{}, region string) ([]*s3.Bucket, er
 func getDigitalOceanBuckets(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	// The DigitalOcean API does not currently return what regions have Spaces available. Thus, this
 	// function hard-codes the regions in which Spaces operates.
+	regions := SpacesRegions
+	if v, ok := extra["regions"].(*schema.Set); ok && v.Len() > 0 {
+		regions = make([]string, 0, v.Len())
+		for _, r := range v.List() {
+			regions = append(regions, strings.ToLower(r.(string)))
+		}
+		sort.Strings(regions)
+	}
+
+	// List the regions concurrently so that a slow or unavailable regional
+	// endpoint doesn't hold up or fail the listing of the others.
+	results := make([][]*s3.Bucket, len(regions))
+	errs := make([]error, len(regions))
+
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), spacesListBucketsTimeout)
+			defer cancel()
+
+			results[i], errs[i] = getSpacesBucketsInRegionWithContext(ctx, meta, region)
+		}(i, region)
+	}
+	wg.Wait()
+
 	var buckets []interface{}
+	var regionErrs []error
 
-	for _, region := range SpacesRegions {
-		bucketsInRegion, err := getSpacesBucketsInRegion(meta, region)
-		if err != nil {
-			return nil, err
+	for i, region := range regions {
+		if errs[i] != nil {
+			log.Printf("[WARN] Unable to list Spaces buckets in %s: %s", region, errs[i])
+			regionErrs = append(regionErrs, fmt.Errorf("Error listing Spaces buckets in %s: %s", region, errs[i]))
+			continue
 		}
 
-		for _, bucketInRegion := range bucketsInRegion {
+		for _, bucketInRegion := range results[i] {
 			metadata := &bucketMetadataStruct{
 				name:   *bucketInRegion.Name,
 				region: region,
@@ -82,6 +128,14 @@ func getDigitalOceanBuckets(meta interface{}, extra map[string]interface{}) ([]i
 		}
 	}
 
+	if len(regionErrs) == len(regions) {
+		return nil, fmt.Errorf("Error listing Spaces buckets: %s", &datalist.PartialResultError{Errors: regionErrs})
+	}
+
+	if len(regionErrs) > 0 {
+		return buckets, &datalist.PartialResultError{Errors: regionErrs}
+	}
+
 	return buckets, nil
 }
 
@@ -111,6 +165,41 @@ func CompareSpacesBucketPolicy(policy1, policy2 string) bool {
 	return equivalent
 }
 
+// SpacesBucketPolicyToSet returns the policy to store in the state for the
+// policy retrieved from the API. When it is equivalent to the existing policy,
+// the existing one is kept so that the ordering of keys or statements returned
+// by the API does not change the state.
+func SpacesBucketPolicyToSet(existing, fetched string) (string, error) {
+	if fetched == "" {
+		return "", nil
+	}
+
+	if existing != "" && CompareSpacesBucketPolicy(existing, fetched) {
+		return existing, nil
+	}
+
+	policy, err := structure.NormalizeJsonString(fetched)
+	if err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %s", fetched, err)
+	}
+
+	return policy, nil
+}
+
+// spacesBucketEmpty reports whether a Spaces bucket has no objects, including
+// noncurrent versions and delete markers.
+func spacesBucketEmpty(svc *s3.S3, bucket string) (bool, error) {
+	resp, err := svc.ListObjectVersions(&s3.ListObjectVersionsInput{
+		Bucket:  aws.String(bucket),
+		MaxKeys: aws.Int64(1),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(resp.Versions) == 0 && len(resp.DeleteMarkers) == 0, nil
+}
+
 // spacesBucketForceDelete deletes all objects in a Spaces bucket.
 func spacesBucketForceDelete(svc *s3.S3, bucket string) error {
 	listParams := &s3.ListObjectVersionsInput{
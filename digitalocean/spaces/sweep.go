@@ -39,7 +39,7 @@ func sweepSpaces(region string) error {
 		}
 
 		for _, b := range buckets {
-			if strings.HasPrefix(*b.Name, sweep.TestNamePrefix) {
+			if strings.HasPrefix(*b.Name, sweep.NamePrefix()) {
 				log.Printf("[DEBUG] Destroying Spaces bucket %s in %s", *b.Name, r)
 
 				_, err = svc.DeleteBucket(&s3.DeleteBucketInput{
@@ -60,7 +60,7 @@ func resourceDigitalOceanSSHKeyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] SSH Key create configuration: %#v", opts)
-	key, _, err := client.Keys.Create(context.Background(), opts)
+	key, _, err := client.Keys.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating SSH Key: %s", err)
 	}
@@ -79,7 +79,7 @@ func resourceDigitalOceanSSHKeyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("invalid SSH key id: %v", err)
 	}
 
-	key, resp, err := client.Keys.GetByID(context.Background(), id)
+	key, resp, err := client.Keys.GetByID(ctx, id)
 	if err != nil {
 		// If the key is somehow already destroyed, mark as
 		// successfully gone
@@ -115,7 +115,7 @@ func resourceDigitalOceanSSHKeyUpdate(ctx context.Context, d *schema.ResourceDat
 	opts := &godo.KeyUpdateRequest{
 		Name: newName,
 	}
-	_, _, err = client.Keys.UpdateByID(context.Background(), id, opts)
+	_, _, err = client.Keys.UpdateByID(ctx, id, opts)
 	if err != nil {
 		return diag.Errorf("Failed to update SSH key: %s", err)
 	}
@@ -132,7 +132,7 @@ func resourceDigitalOceanSSHKeyDelete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[INFO] Deleting SSH key: %d", id)
-	_, err = client.Keys.DeleteByID(context.Background(), id)
+	_, err = client.Keys.DeleteByID(ctx, id)
 	if err != nil {
 		return diag.Errorf("Error deleting SSH key: %s", err)
 	}
@@ -34,7 +34,7 @@ func sweepSSHKey(region string) error {
 	}
 
 	for _, k := range keys {
-		if strings.HasPrefix(k.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(k.Name, sweep.NamePrefix()) {
 			log.Printf("Destroying SSH key %s", k.Name)
 
 			if _, err := client.Keys.DeleteByID(context.Background(), k.ID); err != nil {
@@ -0,0 +1,123 @@
+package status
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanStatusRead,
+		Schema: map[string]*schema.Schema{
+			"indicator": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The overall status of the platform, one of \"none\", \"minor\", \"major\", or \"critical\".",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A human-readable description of the overall status of the platform.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the status page was last updated.",
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The components of the platform, such as the API or the Droplets of a region. Groups of components are included with the aggregated status of their members.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the component.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the component, e.g. the region slug for regional components.",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the group of the component, e.g. Droplets, or an empty string.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the component, one of \"operational\", \"degraded_performance\", \"partial_outage\", \"major_outage\", or \"under_maintenance\".",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the component.",
+						},
+					},
+				},
+			},
+			"component_statuses": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The status of each component keyed by its name, prefixed with the name of its group if any, e.g. \"Droplets/NYC3\".",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	summary, err := meta.(*config.CombinedConfig).PlatformStatusSummary(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving the status of the DigitalOcean platform: %s", err)
+	}
+
+	groups := map[string]string{}
+	for _, c := range summary.Components {
+		if c.Group {
+			groups[c.ID] = c.Name
+		}
+	}
+
+	components := make([]interface{}, 0, len(summary.Components))
+	statuses := map[string]interface{}{}
+	for _, c := range summary.Components {
+		group := groups[c.GroupID]
+		components = append(components, map[string]interface{}{
+			"id":          c.ID,
+			"name":        c.Name,
+			"group":       group,
+			"status":      c.Status,
+			"description": c.Description,
+		})
+
+		key := c.Name
+		if group != "" {
+			key = group + "/" + c.Name
+		}
+		statuses[key] = c.Status
+	}
+
+	id := summary.Page.ID
+	if id == "" {
+		id = "status"
+	}
+
+	d.SetId(id)
+	d.Set("indicator", summary.Status.Indicator)
+	d.Set("description", summary.Status.Description)
+	d.Set("updated_at", summary.Page.UpdatedAt)
+	if err := d.Set("components", components); err != nil {
+		return diag.Errorf("Error setting components: %s", err)
+	}
+	if err := d.Set("component_statuses", statuses); err != nil {
+		return diag.Errorf("Error setting component_statuses: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/status"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceDigitalOceanStatusRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/summary.json" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(`{
+  "page": {"id": "page-id", "updated_at": "2024-05-01T10:00:00.000Z"},
+  "status": {"indicator": "minor", "description": "Minor Service Outage"},
+  "components": [
+    {"id": "api", "name": "API", "status": "operational", "group_id": null, "group": false},
+    {"id": "droplets", "name": "Droplets", "status": "degraded_performance", "group_id": null, "group": true},
+    {"id": "droplets-nyc3", "name": "NYC3", "status": "degraded_performance", "group_id": "droplets", "group": false},
+    {"id": "droplets-ams3", "name": "AMS3", "status": "operational", "group_id": "droplets", "group": false}
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:              "12345",
+		PreflightStatusURL: server.URL + "/api/v2/status.json",
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := status.DataSourceDigitalOceanStatus()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	if diags := ds.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if d.Id() != "page-id" {
+		t.Errorf("Expected ID page-id, got %q", d.Id())
+	}
+	if indicator := d.Get("indicator").(string); indicator != "minor" {
+		t.Errorf("Expected indicator minor, got %q", indicator)
+	}
+	if group := d.Get("components.2.group").(string); group != "Droplets" {
+		t.Errorf("Expected the NYC3 component to be in the Droplets group, got %q", group)
+	}
+
+	expected := map[string]string{
+		"API":           "operational",
+		"Droplets":      "degraded_performance",
+		"Droplets/NYC3": "degraded_performance",
+		"Droplets/AMS3": "operational",
+	}
+	statuses := d.Get("component_statuses").(map[string]interface{})
+	if len(statuses) != len(expected) {
+		t.Errorf("Expected %d component statuses, got %v", len(expected), statuses)
+	}
+	for k, v := range expected {
+		if statuses[k] != v {
+			t.Errorf("Expected status of %s to be %q, got %v", k, v, statuses[k])
+		}
+	}
+}
+
+func TestDataSourceDigitalOceanStatusReadUnknownSummary(t *testing.T) {
+	conf := config.Config{
+		Token:              "12345",
+		PreflightStatusURL: "https://status.example.com/api/v2/health",
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := status.DataSourceDigitalOceanStatus()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	diags := ds.ReadContext(context.Background(), d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "does not end with status.json") {
+		t.Fatalf("Expected an error for the status URL, got %v", diags)
+	}
+}
+
+func TestAccDataSourceDigitalOceanStatus_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanStatusConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_status.foobar", "indicator"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_status.foobar", "components.#"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_status.foobar", "component_statuses.API"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanStatusConfig_basic = `
+data "digitalocean_status" "foobar" {
+}`
@@ -10,6 +10,18 @@ import (
 
 const TestNamePrefix = "tf-acc-test-"
 
+// NamePrefix returns the prefix of the names of the resources created by the
+// acceptance tests. When DIGITALOCEAN_TEST_RUN_ID is set, it is included in
+// the prefix so that the sweepers of parallel runs against the same account
+// only remove the resources of their own run.
+func NamePrefix() string {
+	if runID := os.Getenv("DIGITALOCEAN_TEST_RUN_ID"); runID != "" {
+		return TestNamePrefix + runID + "-"
+	}
+
+	return TestNamePrefix
+}
+
 func SharedConfigForRegion(region string) (interface{}, error) {
 	if os.Getenv("DIGITALOCEAN_TOKEN") == "" {
 		return nil, fmt.Errorf("empty DIGITALOCEAN_TOKEN")
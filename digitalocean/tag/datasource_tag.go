@@ -43,6 +43,18 @@ func DataSourceDigitalOceanTag() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"include_resource_urns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to look up the URNs of the resources the tag is applied to",
+			},
+			"resource_urns": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the URNs of the Droplets, images, volumes, volume snapshots, database clusters, and load balancers the tag is applied to, when include_resource_urns is set",
+			},
 		},
 	}
 }
@@ -52,7 +64,7 @@ func dataSourceDigitalOceanTagRead(ctx context.Context, d *schema.ResourceData,
 
 	name := d.Get("name").(string)
 
-	tag, resp, err := client.Tags.Get(context.Background(), name)
+	tag, resp, err := client.Tags.Get(ctx, name)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return diag.Errorf("tag not found: %s", err)
@@ -69,5 +81,17 @@ func dataSourceDigitalOceanTagRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("volume_snapshots_count", tag.Resources.VolumeSnapshots.Count)
 	d.Set("databases_count", tag.Resources.Databases.Count)
 
+	var urns map[string][]string
+	if d.Get("include_resource_urns").(bool) {
+		urns, err = ListTaggedResourceURNs(ctx, client, TaggedResourceTypesOf(tag))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("resource_urns", urns[tag.Name]); err != nil {
+		return diag.Errorf("Error setting resource_urns: %s", err)
+	}
+
 	return nil
 }
@@ -49,6 +49,52 @@ data "digitalocean_tag" "foobar" {
 						"data.digitalocean_tag.foobar", "volume_snapshots_count"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_tag.foobar", "databases_count"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_tag.foobar", "resource_urns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanTag_ResourceURNs(t *testing.T) {
+	tagName := acceptance.RandomTestName()
+	dropletName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  tags   = [digitalocean_tag.foo.id]
+}`, tagName, dropletName)
+	dataSourceConfig := `
+data "digitalocean_tag" "foobar" {
+  name                  = digitalocean_tag.foo.name
+  include_resource_urns = true
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_tag.foobar", "droplets_count", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_tag.foobar", "resource_urns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.digitalocean_tag.foobar", "resource_urns.*", "digitalocean_droplet.foo", "urn"),
 				),
 			},
 		},
@@ -34,18 +34,45 @@ func DataSourceDigitalOceanTags() *schema.Resource {
 			"databases_count": {
 				Type: schema.TypeInt,
 			},
+			"resource_urns": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 		},
 		ResultAttributeName: "tags",
-		FlattenRecord:       flattenDigitalOceanTag,
-		GetRecords:          getDigitalOceanTags,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"include_resource_urns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to look up the URNs of the resources each tag is applied to",
+			},
+		},
+		FlattenRecord: flattenDigitalOceanTag,
+		GetRecords:    getDigitalOceanTags,
 	}
 
 	return datalist.NewResource(dataListConfig)
 }
 
+// taggedResources pairs a tag with the URNs of the resources it is applied to.
+type taggedResources struct {
+	Tag  godo.Tag
+	URNs []string
+}
+
 func getDigitalOceanTags(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	var urns map[string][]string
+	if includeURNs, ok := extra["include_resource_urns"].(bool); ok && includeURNs {
+		var err error
+		urns, err = ListTaggedResourceURNs(context.Background(), client, AllTaggedResourceTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tagsList := []interface{}{}
 
 	opts := &godo.ListOptions{
@@ -60,7 +87,7 @@ func getDigitalOceanTags(meta interface{}, extra map[string]interface{}) ([]inte
 		}
 
 		for _, tag := range tags {
-			tagsList = append(tagsList, tag)
+			tagsList = append(tagsList, taggedResources{Tag: tag, URNs: urns[tag.Name]})
 		}
 
 		if resp.Links == nil || resp.Links.IsLastPage() {
@@ -79,7 +106,8 @@ func getDigitalOceanTags(meta interface{}, extra map[string]interface{}) ([]inte
 }
 
 func flattenDigitalOceanTag(tag, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
-	t := tag.(godo.Tag)
+	tagged := tag.(taggedResources)
+	t := tagged.Tag
 
 	flattenedTag := map[string]interface{}{}
 	flattenedTag["name"] = t.Name
@@ -90,5 +118,11 @@ func flattenDigitalOceanTag(tag, meta interface{}, extra map[string]interface{})
 	flattenedTag["volume_snapshots_count"] = t.Resources.VolumeSnapshots.Count
 	flattenedTag["databases_count"] = t.Resources.Databases.Count
 
+	urns := make([]interface{}, len(tagged.URNs))
+	for i, urn := range tagged.URNs {
+		urns[i] = urn
+	}
+	flattenedTag["resource_urns"] = urns
+
 	return flattenedTag, nil
 }
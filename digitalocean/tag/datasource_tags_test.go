@@ -54,3 +54,49 @@ data "digitalocean_tags" "foobar" {
 		},
 	})
 }
+
+func TestAccDataSourceDigitalOceanTags_ResourceURNs(t *testing.T) {
+	tagName := acceptance.RandomTestName()
+	volumeName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_volume" "foo" {
+  region = "nyc3"
+  name   = "%s"
+  size   = 10
+  tags   = [digitalocean_tag.foo.id]
+}`, tagName, volumeName)
+	dataSourceConfig := `
+data "digitalocean_tags" "foobar" {
+  include_resource_urns = true
+
+  filter {
+    key    = "name"
+    values = [digitalocean_tag.foo.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_tags.foobar", "tags.0.name", tagName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_tags.foobar", "tags.0.resource_urns.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_tags.foobar", "tags.0.resource_urns.0", "digitalocean_volume.foo", "urn"),
+				),
+			},
+		},
+	})
+}
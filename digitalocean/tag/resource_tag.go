@@ -63,7 +63,7 @@ func resourceDigitalOceanTagCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Tag create configuration: %#v", opts)
-	tag, _, err := client.Tags.Create(context.Background(), opts)
+	tag, _, err := client.Tags.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating tag: %s", err)
 	}
@@ -77,7 +77,7 @@ func resourceDigitalOceanTagCreate(ctx context.Context, d *schema.ResourceData,
 func resourceDigitalOceanTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	tag, resp, err := client.Tags.Get(context.Background(), d.Id())
+	tag, resp, err := client.Tags.Get(ctx, d.Id())
 	if err != nil {
 		// If the tag is somehow already destroyed, mark as
 		// successfully gone
@@ -104,7 +104,7 @@ func resourceDigitalOceanTagDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting tag: %s", d.Id())
-	_, err := client.Tags.Delete(context.Background(), d.Id())
+	_, err := client.Tags.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting tag: %s", err)
 	}
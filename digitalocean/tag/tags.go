@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,6 +27,17 @@ func TagsSchema() *schema.Schema {
 	}
 }
 
+// TagsAuthoritativeSchema returns the schema of the "tags_authoritative" field
+// used together with SetManagedTags.
+func TagsAuthoritativeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "whether tags not in the configuration, e.g. those applied by other systems, are removed from the resource",
+	}
+}
+
 func TagsDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -43,13 +56,13 @@ func ValidateTag(value interface{}, key string) ([]string, []error) {
 
 // SetTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func SetTags(conn *godo.Client, d *schema.ResourceData, resourceType godo.ResourceType) error {
+func SetTags(ctx context.Context, conn *godo.Client, d *schema.ResourceData, resourceType godo.ResourceType) error {
 	oraw, nraw := d.GetChange("tags")
 	remove, create := DiffTags(TagsFromSchema(oraw), TagsFromSchema(nraw))
 
 	log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
 	for _, tag := range remove {
-		_, err := conn.Tags.UntagResources(context.Background(), tag, &godo.UntagResourcesRequest{
+		_, err := conn.Tags.UntagResources(ctx, tag, &godo.UntagResourcesRequest{
 			Resources: []godo.Resource{
 				{
 					ID:   d.Id(),
@@ -65,14 +78,14 @@ func SetTags(conn *godo.Client, d *schema.ResourceData, resourceType godo.Resour
 	log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
 	for _, tag := range create {
 
-		createdTag, _, err := conn.Tags.Create(context.Background(), &godo.TagCreateRequest{
+		createdTag, _, err := conn.Tags.Create(ctx, &godo.TagCreateRequest{
 			Name: tag,
 		})
 		if err != nil {
 			return err
 		}
 
-		_, err = conn.Tags.TagResources(context.Background(), createdTag.Name, &godo.TagResourcesRequest{
+		_, err = conn.Tags.TagResources(ctx, createdTag.Name, &godo.TagResourcesRequest{
 			Resources: []godo.Resource{
 				{
 					ID:   d.Id(),
@@ -88,6 +101,64 @@ func SetTags(conn *godo.Client, d *schema.ResourceData, resourceType godo.Resour
 	return nil
 }
 
+// SetManagedTags sets the "tags" field from the tags returned by the API. If
+// "tags_authoritative" is false, tags not already tracked in the state are
+// ignored so that those applied outside of Terraform do not produce diffs.
+func SetManagedTags(d *schema.ResourceData, tags []string) error {
+	authoritative := true
+	if v, ok := d.GetOkExists("tags_authoritative"); ok {
+		authoritative = v.(bool)
+	} else {
+		// Resources imported or created before the field existed
+		d.Set("tags_authoritative", true)
+	}
+
+	if !authoritative {
+		managed := d.Get("tags").(*schema.Set)
+		filtered := make([]string, 0, len(tags))
+		for _, t := range tags {
+			if managed.Contains(t) {
+				filtered = append(filtered, t)
+			}
+		}
+		tags = filtered
+	}
+
+	return d.Set("tags", FlattenTags(tags))
+}
+
+// CreateMissingTags creates the tags referenced by a resource when the
+// provider is configured with create_missing_tags = true, so that e.g. a
+// firewall can target a tag before any Droplet is tagged with it. Creating a
+// tag which already exists has no effect.
+func CreateMissingTags(ctx context.Context, meta interface{}, tags ...[]string) error {
+	combined := meta.(*config.CombinedConfig)
+	if !combined.CreateMissingTags() {
+		return nil
+	}
+
+	created := map[string]bool{}
+	for _, names := range tags {
+		for _, name := range names {
+			if name == "" || created[strings.ToLower(name)] {
+				continue
+			}
+
+			log.Printf("[DEBUG] Creating referenced tag: %s", name)
+			_, _, err := combined.GodoClient().Tags.Create(ctx, &godo.TagCreateRequest{
+				Name: name,
+			})
+			if err != nil {
+				return fmt.Errorf("Error creating tag %s: %s", name, err)
+			}
+
+			created[strings.ToLower(name)] = true
+		}
+	}
+
+	return nil
+}
+
 // TagsFromSchema takes the raw schema tags and returns them as a
 // properly asserted map[string]string
 func TagsFromSchema(raw interface{}) map[string]string {
@@ -136,3 +207,162 @@ func FlattenTags(tags []string) *schema.Set {
 
 	return flattenedTags
 }
+
+// TaggedResourceTypes selects the types of resources whose URNs are looked up
+// by ListTaggedResourceURNs.
+type TaggedResourceTypes struct {
+	Droplets        bool
+	Images          bool
+	Volumes         bool
+	VolumeSnapshots bool
+	Databases       bool
+	LoadBalancers   bool
+}
+
+// AllTaggedResourceTypes selects every type of resource which can be tagged.
+var AllTaggedResourceTypes = TaggedResourceTypes{
+	Droplets:        true,
+	Images:          true,
+	Volumes:         true,
+	VolumeSnapshots: true,
+	Databases:       true,
+	LoadBalancers:   true,
+}
+
+// TaggedResourceTypesOf selects the types of resources the tag is applied to
+// according to its resource counts. The counts do not include load balancers,
+// so they are always selected.
+func TaggedResourceTypesOf(tag *godo.Tag) TaggedResourceTypes {
+	types := TaggedResourceTypes{LoadBalancers: true}
+	if tag.Resources == nil {
+		return types
+	}
+
+	types.Droplets = tag.Resources.Droplets != nil && tag.Resources.Droplets.Count > 0
+	types.Images = tag.Resources.Images != nil && tag.Resources.Images.Count > 0
+	types.Volumes = tag.Resources.Volumes != nil && tag.Resources.Volumes.Count > 0
+	types.VolumeSnapshots = tag.Resources.VolumeSnapshots != nil && tag.Resources.VolumeSnapshots.Count > 0
+	types.Databases = tag.Resources.Databases != nil && tag.Resources.Databases.Count > 0
+
+	return types
+}
+
+// ListTaggedResourceURNs returns the URNs of the resources of the given types
+// in the account grouped by the tags applied to them. Droplets, images,
+// volumes, volume snapshots, database clusters, and load balancers can be
+// looked up.
+func ListTaggedResourceURNs(ctx context.Context, client *godo.Client, types TaggedResourceTypes) (map[string][]string, error) {
+	urns := map[string][]string{}
+	add := func(tags []string, urn string) {
+		for _, t := range tags {
+			urns[t] = append(urns[t], urn)
+		}
+	}
+
+	if types.Droplets {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			droplets, resp, err := client.Droplets.List(ctx, opts)
+			for _, d := range droplets {
+				add(d.Tags, d.URN())
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Droplets: %s", err)
+		}
+	}
+
+	if types.Images {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			images, resp, err := client.Images.ListUser(ctx, opts)
+			for _, i := range images {
+				add(i.Tags, godo.ToURN("Image", i.ID))
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving images: %s", err)
+		}
+	}
+
+	if types.Volumes {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			volumes, resp, err := client.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opts})
+			for _, v := range volumes {
+				add(v.Tags, v.URN())
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving volumes: %s", err)
+		}
+	}
+
+	if types.VolumeSnapshots {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			snapshots, resp, err := client.Snapshots.ListVolume(ctx, opts)
+			for _, s := range snapshots {
+				add(s.Tags, godo.ToURN("VolumeSnapshot", s.ID))
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving volume snapshots: %s", err)
+		}
+	}
+
+	if types.Databases {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			databases, resp, err := client.Databases.List(ctx, opts)
+			for _, db := range databases {
+				add(db.Tags, db.URN())
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database clusters: %s", err)
+		}
+	}
+
+	if types.LoadBalancers {
+		err := forEachPage(func(opts *godo.ListOptions) (*godo.Response, error) {
+			lbs, resp, err := client.LoadBalancers.List(ctx, opts)
+			for _, lb := range lbs {
+				add(lb.Tags, lb.URN())
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving load balancers: %s", err)
+		}
+	}
+
+	return urns, nil
+}
+
+// forEachPage calls list with the options of each page of a list request
+// until the last page is reached.
+func forEachPage(list func(opts *godo.ListOptions) (*godo.Response, error)) error {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		resp, err := list(opts)
+		if err != nil {
+			return err
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return err
+		}
+
+		opts.Page = page + 1
+	}
+}
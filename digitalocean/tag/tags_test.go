@@ -1,9 +1,15 @@
 package tag_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"sort"
 	"testing"
 
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -139,3 +145,105 @@ func TestFlattenTags(t *testing.T) {
 		t.Fatalf("incorrect expected length of flattened tags")
 	}
 }
+
+func TestSetManagedTags(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"tags":               tag.TagsSchema(),
+		"tags_authoritative": tag.TagsAuthoritativeSchema(),
+	}
+	remoteTags := []string{"foo", "k8s", "k8s:worker"}
+
+	cases := []struct {
+		Name     string
+		Raw      map[string]interface{}
+		Expected []string
+	}{
+		{
+			Name:     "authoritative by default",
+			Raw:      map[string]interface{}{"tags": []interface{}{"foo"}},
+			Expected: []string{"foo", "k8s", "k8s:worker"},
+		},
+		{
+			Name:     "authoritative",
+			Raw:      map[string]interface{}{"tags": []interface{}{"foo"}, "tags_authoritative": true},
+			Expected: []string{"foo", "k8s", "k8s:worker"},
+		},
+		{
+			Name:     "non-authoritative",
+			Raw:      map[string]interface{}{"tags": []interface{}{"FOO", "bar"}, "tags_authoritative": false},
+			Expected: []string{"foo"},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceSchema, tc.Raw)
+
+		if err := tag.SetManagedTags(d, remoteTags); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+
+		got := d.Get("tags").(*schema.Set)
+		if got.Len() != len(tc.Expected) {
+			t.Fatalf("%s: expected tags %v, got %v", tc.Name, tc.Expected, got.List())
+		}
+		for _, v := range tc.Expected {
+			if !got.Contains(v) {
+				t.Fatalf("%s: expected tags %v, got %v", tc.Name, tc.Expected, got.List())
+			}
+		}
+	}
+}
+
+func TestCreateMissingTags(t *testing.T) {
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/tags" {
+			t.Errorf("Unexpected API request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var req struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, req.Name)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"tag": map[string]interface{}{"name": req.Name},
+		})
+	}))
+	defer server.Close()
+
+	for _, enabled := range []bool{false, true} {
+		created = nil
+
+		conf := config.Config{
+			Token:             "12345",
+			APIEndpoint:       server.URL,
+			CreateMissingTags: enabled,
+		}
+		meta, err := conf.Client()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = tag.CreateMissingTags(context.Background(), meta, []string{"web", "db"}, []string{"WEB", ""}, nil)
+		if err != nil {
+			t.Fatalf("create_missing_tags = %t: unexpected error: %s", enabled, err)
+		}
+
+		sort.Strings(created)
+		var expected []string
+		if enabled {
+			expected = []string{"db", "web"}
+		}
+
+		if !reflect.DeepEqual(created, expected) {
+			t.Errorf("create_missing_tags = %t: expected %v to be created, got %v", enabled, expected, created)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package digitalocean
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// recordTelemetry wraps the functions of the resource or data source so that
+// their durations are recorded when the provider is configured with a
+// metrics_endpoint.
+func recordTelemetry(name string, r *schema.Resource) {
+	wrap := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			c, ok := meta.(*config.CombinedConfig)
+			if !ok || c.Telemetry() == nil {
+				return f(ctx, d, meta)
+			}
+
+			end := c.Telemetry().StartOperation(name, operation)
+			diags := f(ctx, d, meta)
+			end(diags.HasError())
+
+			return diags
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap("create", r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap("read", r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap("update", r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap("delete", r.DeleteContext)
+	}
+}
@@ -0,0 +1,26 @@
+package uptime
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanUptimeAlerts() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        uptimeAlertSchema(),
+		ResultAttributeName: "alerts",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"check_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "the ID of the uptime check to list the alerts of; the alerts of all checks are listed if not set",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		GetRecords:    getDigitalOceanUptimeAlerts,
+		FlattenRecord: flattenDigitalOceanUptimeAlert,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
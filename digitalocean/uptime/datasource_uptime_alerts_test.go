@@ -0,0 +1,85 @@
+package uptime_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanUptimeAlerts_Basic(t *testing.T) {
+	checkName := acceptance.RandomTestName()
+	alertName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+data "digitalocean_account" "test" {
+}
+
+resource "digitalocean_uptime_check" "foo" {
+  name    = "%s"
+  target  = "https://www.landingpage.com"
+  regions = ["us_east", "eu_west"]
+}
+
+resource "digitalocean_uptime_alert" "foo" {
+  check_id   = digitalocean_uptime_check.foo.id
+  name       = "%s"
+  type       = "latency"
+  threshold  = 300
+  comparison = "greater_than"
+  period     = "2m"
+
+  notifications {
+    email = [data.digitalocean_account.test.email]
+  }
+}`, checkName, alertName)
+	dataSourceConfig := `
+data "digitalocean_uptime_alerts" "foobar" {
+  check_id = digitalocean_uptime_check.foo.id
+}
+
+data "digitalocean_uptime_alerts" "all" {
+  filter {
+    key    = "name"
+    values = [digitalocean_uptime_alert.foo.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.id", "digitalocean_uptime_alert.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.check_id", "digitalocean_uptime_check.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.name", alertName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.type", "latency"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.threshold", "300"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.comparison", "greater_than"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.period", "2m"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_uptime_alerts.foobar", "alerts.0.notifications.0.email.0", "data.digitalocean_account.test", "email"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_alerts.all", "alerts.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_uptime_alerts.all", "alerts.0.check_id", "digitalocean_uptime_check.foo", "id"),
+				),
+			},
+		},
+	})
+}
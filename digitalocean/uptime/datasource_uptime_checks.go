@@ -0,0 +1,17 @@
+package uptime
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanUptimeChecks() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        uptimeCheckSchema(),
+		ResultAttributeName: "checks",
+		GetRecords:          getDigitalOceanUptimeChecks,
+		FlattenRecord:       flattenDigitalOceanUptimeCheck,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,56 @@
+package uptime_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanUptimeChecks_Basic(t *testing.T) {
+	checkName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_uptime_check" "foo" {
+  name    = "%s"
+  target  = "https://www.landingpage.com"
+  regions = ["us_east", "eu_west"]
+}`, checkName)
+	dataSourceConfig := `
+data "digitalocean_uptime_checks" "foobar" {
+  filter {
+    key    = "name"
+    values = [digitalocean_uptime_check.foo.name]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.id", "digitalocean_uptime_check.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.name", checkName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.target", "https://www.landingpage.com"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.type", "https"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_uptime_checks.foobar", "checks.0.regions.#", "2"),
+				),
+			},
+		},
+	})
+}
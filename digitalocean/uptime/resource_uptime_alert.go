@@ -25,6 +25,8 @@ func ResourceDigitalOceanUptimeAlert() *schema.Resource {
 			StateContext: resourceDigitalOceanUptimeAlertImport,
 		},
 
+		CustomizeDiff: resourceDigitalOceanUptimeAlertDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -56,6 +58,13 @@ func ResourceDigitalOceanUptimeAlert() *schema.Resource {
 				Description: "The threshold at which the alert will enter a trigger state. The specific threshold is dependent on the alert type.",
 				Optional:    true,
 			},
+			"ssl_expiry_days": {
+				Type:          schema.TypeInt,
+				Description:   "The number of days before the SSL certificate of the target expires at which an 'ssl_expiry' alert will enter a trigger state.",
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 365),
+				ConflictsWith: []string{"threshold"},
+			},
 			"comparison": {
 				Type:        schema.TypeString,
 				Description: "The comparison operator used against the alert's threshold. Enum: 'greater_than' 'less_than",
@@ -122,6 +131,14 @@ func ResourceDigitalOceanUptimeAlert() *schema.Resource {
 	}
 }
 
+func resourceDigitalOceanUptimeAlertDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("ssl_expiry_days"); ok && diff.NewValueKnown("type") && diff.Get("type").(string) != "ssl_expiry" {
+		return errors.New("ssl_expiry_days can only be set for alerts of type ssl_expiry")
+	}
+
+	return nil
+}
+
 func resourceDigitalOceanUptimeAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -136,6 +153,13 @@ func resourceDigitalOceanUptimeAlertCreate(ctx context.Context, d *schema.Resour
 		Period:        d.Get("period").(string),
 	}
 
+	if days, ok := d.GetOk("ssl_expiry_days"); ok {
+		opts.Threshold = days.(int)
+		if opts.Comparison == "" {
+			opts.Comparison = godo.UptimeAlertLessThan
+		}
+	}
+
 	log.Printf("[DEBUG] Uptime alert create configuration: %#v", opts)
 	alert, _, err := client.UptimeChecks.CreateAlert(ctx, checkID, opts)
 	if err != nil {
@@ -177,6 +201,12 @@ func resourceDigitalOceanUptimeAlertUpdate(ctx context.Context, d *schema.Resour
 	if v, ok := d.GetOk("period"); ok {
 		opts.Period = v.(string)
 	}
+	if v, ok := d.GetOk("ssl_expiry_days"); ok {
+		opts.Threshold = v.(int)
+		if opts.Comparison == "" {
+			opts.Comparison = godo.UptimeAlertLessThan
+		}
+	}
 
 	log.Printf("[DEBUG] Uptime alert update configuration: %#v", opts)
 
@@ -227,11 +257,21 @@ func resourceDigitalOceanUptimeAlertRead(ctx context.Context, d *schema.Resource
 	d.SetId(alert.ID)
 	d.Set("name", alert.Name)
 	d.Set("type", alert.Type)
-	d.Set("threshold", alert.Threshold)
 	d.Set("notifications", flattenNotifications(alert.Notifications))
-	d.Set("comparison", alert.Comparison)
 	d.Set("period", alert.Period)
 
+	// When the threshold is set in days, the comparison defaults to
+	// less_than, which is only kept in the state if it was configured.
+	if _, ok := d.GetOk("ssl_expiry_days"); ok {
+		d.Set("ssl_expiry_days", alert.Threshold)
+		if _, ok := d.GetOk("comparison"); ok {
+			d.Set("comparison", alert.Comparison)
+		}
+	} else {
+		d.Set("threshold", alert.Threshold)
+		d.Set("comparison", alert.Comparison)
+	}
+
 	return nil
 }
 
@@ -82,6 +82,56 @@ func TestAccDigitalOceanUptimeAlert_Basic(t *testing.T) {
 	})
 }
 
+const testAccCheckDigitalOceanUptimeAlertConfig_sslExpiry = `
+data "digitalocean_account" "test" {
+}
+
+resource "digitalocean_uptime_check" "test" {
+  name    = "terraform-test"
+  target  = "https://www.landingpage.com"
+  regions = ["us_east", "eu_west"]
+}
+resource "digitalocean_uptime_alert" "foobar" {
+  check_id        = digitalocean_uptime_check.test.id
+  name            = "%s"
+  type            = "ssl_expiry"
+  ssl_expiry_days = %d
+  notifications {
+    email = [data.digitalocean_account.test.email]
+  }
+}
+`
+
+func TestAccDigitalOceanUptimeAlert_SSLExpiry(t *testing.T) {
+	alertName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeAlertConfig_sslExpiry, alertName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeAlertExists("digitalocean_uptime_alert.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "type", "ssl_expiry"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "ssl_expiry_days", "30"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeAlertConfig_sslExpiry, alertName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeAlertExists("digitalocean_uptime_alert.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "ssl_expiry_days", "14"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanUptimeAlertDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -154,7 +154,7 @@ func resourceDigitalOceanUptimeCheckDelete(ctx context.Context, d *schema.Resour
 func resourceDigitalOceanUptimeCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	check, resp, err := client.UptimeChecks.Get(context.Background(), d.Id())
+	check, resp, err := client.UptimeChecks.Get(ctx, d.Id())
 	if err != nil {
 		// If the check is somehow already destroyed, mark as
 		// successfully gone
@@ -36,7 +36,7 @@ func sweepUptimeCheck(region string) error {
 	}
 
 	for _, c := range checks {
-		if strings.HasPrefix(c.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(c.Name, sweep.NamePrefix()) {
 			log.Printf("[DEBUG] Deleting uptime check %s", c.Name)
 
 			if _, err := client.UptimeChecks.Delete(context.Background(), c.ID); err != nil {
@@ -0,0 +1,246 @@
+package uptime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func uptimeCheckSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the uptime check",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "the name of the uptime check",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of health check to perform, one of ping, http, or https",
+		},
+		"target": {
+			Type:        schema.TypeString,
+			Description: "the endpoint to perform healthchecks on",
+		},
+		"regions": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the regions the checks are performed from",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "whether the check is enabled",
+		},
+	}
+}
+
+func uptimeAlertSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the uptime alert",
+		},
+		"check_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the uptime check of the alert",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "the name of the uptime alert",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of alert, one of latency, down, down_global, or ssl_expiry",
+		},
+		"threshold": {
+			Type:        schema.TypeInt,
+			Description: "the threshold at which the alert will enter a trigger state",
+		},
+		"comparison": {
+			Type:        schema.TypeString,
+			Description: "the comparison operator used against the alert's threshold",
+		},
+		"period": {
+			Type:        schema.TypeString,
+			Description: "the period of time the threshold must be exceeded to trigger the alert",
+		},
+		"notifications": {
+			Type:        schema.TypeList,
+			Description: "the notification settings of the alert",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"slack": {
+						Type: schema.TypeList,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"channel": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "the Slack channel to send alerts to",
+								},
+								"url": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "the webhook URL for Slack",
+								},
+							},
+						},
+						Computed: true,
+					},
+					"email": {
+						Type:        schema.TypeList,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Computed:    true,
+						Description: "the email addresses notifications are sent to",
+					},
+				},
+			},
+		},
+	}
+}
+
+func listUptimeChecks(ctx context.Context, client *godo.Client) ([]godo.UptimeCheck, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allChecks []godo.UptimeCheck
+
+	for {
+		checks, resp, err := client.UptimeChecks.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving uptime checks: %s", err)
+		}
+
+		allChecks = append(allChecks, checks...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving uptime checks: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allChecks, nil
+}
+
+func getDigitalOceanUptimeChecks(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	checks, err := listUptimeChecks(context.Background(), client)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []interface{}
+	for _, check := range checks {
+		records = append(records, check)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanUptimeCheck(rawCheck, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	check := rawCheck.(godo.UptimeCheck)
+
+	flattenedCheck := map[string]interface{}{
+		"id":      check.ID,
+		"name":    check.Name,
+		"type":    check.Type,
+		"target":  check.Target,
+		"regions": flattenRegions(check.Regions),
+		"enabled": check.Enabled,
+	}
+
+	return flattenedCheck, nil
+}
+
+// uptimeAlertRecord is an alert along with the ID of its check, which the
+// API does not return as part of the alert.
+type uptimeAlertRecord struct {
+	checkID string
+	alert   godo.UptimeAlert
+}
+
+func getDigitalOceanUptimeAlerts(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var checkIDs []string
+	if checkID, ok := extra["check_id"].(string); ok && checkID != "" {
+		checkIDs = []string{checkID}
+	} else {
+		checks, err := listUptimeChecks(context.Background(), client)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, check := range checks {
+			checkIDs = append(checkIDs, check.ID)
+		}
+	}
+
+	var records []interface{}
+	for _, checkID := range checkIDs {
+		opts := &godo.ListOptions{
+			Page:    1,
+			PerPage: 200,
+		}
+
+		for {
+			alerts, resp, err := client.UptimeChecks.ListAlerts(context.Background(), checkID, opts)
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving alerts of uptime check (%s): %s", checkID, err)
+			}
+
+			for _, alert := range alerts {
+				records = append(records, uptimeAlertRecord{checkID: checkID, alert: alert})
+			}
+
+			if resp.Links == nil || resp.Links.IsLastPage() {
+				break
+			}
+
+			page, err := resp.Links.CurrentPage()
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving alerts of uptime check (%s): %s", checkID, err)
+			}
+
+			opts.Page = page + 1
+		}
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanUptimeAlert(rawAlert, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	record := rawAlert.(uptimeAlertRecord)
+	alert := record.alert
+
+	flattenedAlert := map[string]interface{}{
+		"id":            alert.ID,
+		"check_id":      record.checkID,
+		"name":          alert.Name,
+		"type":          alert.Type,
+		"threshold":     alert.Threshold,
+		"comparison":    string(alert.Comparison),
+		"period":        alert.Period,
+		"notifications": []interface{}{},
+	}
+
+	if alert.Notifications != nil {
+		flattenedAlert["notifications"] = flattenNotifications(alert.Notifications)
+	}
+
+	return flattenedAlert, nil
+}
@@ -0,0 +1,112 @@
+package urn
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// urnResourceTypes maps the resource types accepted by the digitalocean_urn
+// data source to the type used in the URN. Both the name of the Terraform
+// resource, without the digitalocean_ prefix, and the URN type are accepted.
+var urnResourceTypes = map[string]string{
+	"app":                "app",
+	"database_cluster":   "dbaas",
+	"dbaas":              "dbaas",
+	"domain":             "domain",
+	"droplet":            "droplet",
+	"firewall":           "firewall",
+	"floating_ip":        "floatingip",
+	"floatingip":         "floatingip",
+	"kubernetes":         "kubernetes",
+	"kubernetes_cluster": "kubernetes",
+	"loadbalancer":       "loadbalancer",
+	"reserved_ip":        "reservedip",
+	"reservedip":         "reservedip",
+	"space":              "space",
+	"spaces_bucket":      "space",
+	"volume":             "volume",
+	"vpc":                "vpc",
+}
+
+func DataSourceDigitalOceanURN() *schema.Resource {
+	types := make([]string, 0, len(urnResourceTypes))
+	for t := range urnResourceTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanURNRead,
+		Schema: map[string]*schema.Schema{
+			"urn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"resource_type", "resource_id"},
+				Description:   "the uniform resource name (URN) to parse into a resource type and ID",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(types, false),
+				RequiredWith: []string{"resource_id"},
+				Description:  "the type of the resource, e.g. droplet or kubernetes_cluster. When parsing a URN, the type used in the URN",
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				RequiredWith: []string{"resource_type"},
+				Description:  "the ID of the resource. For domains, floating IPs, reserved IPs and Spaces buckets this is the name or IP address",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanURNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	urn := d.Get("urn").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	switch {
+	case urn != "":
+		var err error
+		resourceType, resourceID, err = ParseURN(urn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	case resourceType != "" && resourceID != "":
+		// resource_type is kept as given, e.g. kubernetes_cluster, rather
+		// than replaced with the type used in the URN.
+		urn = fmt.Sprintf("do:%s:%s", urnResourceTypes[resourceType], resourceID)
+	default:
+		return diag.Errorf("Error building URN: either `urn` or both `resource_type` and `resource_id` must be set")
+	}
+
+	d.SetId(urn)
+	d.Set("urn", urn)
+	d.Set("resource_type", resourceType)
+	d.Set("resource_id", resourceID)
+
+	return nil
+}
+
+// ParseURN splits a URN in the format do:<type>:<id> into its resource type
+// and ID.
+func ParseURN(urn string) (string, string, error) {
+	parts := strings.SplitN(urn, ":", 3)
+	if len(parts) != 3 || parts[0] != "do" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("invalid URN %q, expected the format do:<type>:<id>", urn)
+	}
+
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,74 @@
+package urn_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/urn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestParseURN(t *testing.T) {
+	cases := []struct {
+		URN          string
+		ResourceType string
+		ResourceID   string
+		ExpectError  bool
+	}{
+		{URN: "do:droplet:123", ResourceType: "droplet", ResourceID: "123"},
+		{URN: "do:space:my-bucket", ResourceType: "space", ResourceID: "my-bucket"},
+		{URN: "do:reservedip:2001:db8::1", ResourceType: "reservedip", ResourceID: "2001:db8::1"},
+		{URN: "droplet:123", ExpectError: true},
+		{URN: "aws:droplet:123", ExpectError: true},
+		{URN: "do::123", ExpectError: true},
+		{URN: "do:droplet:", ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		resourceType, resourceID, err := urn.ParseURN(tc.URN)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected an error parsing %q", tc.URN)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", tc.URN, err)
+			continue
+		}
+
+		if resourceType != tc.ResourceType || resourceID != tc.ResourceID {
+			t.Errorf("parsing %q: expected %s/%s, got %s/%s", tc.URN, tc.ResourceType, tc.ResourceID, resourceType, resourceID)
+		}
+	}
+}
+
+func TestAccDataSourceDigitalOceanURN_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_urn" "from_id" {
+  resource_type = "kubernetes_cluster"
+  resource_id   = "6f3a8c1e-2b4d-4e5f-9a7b-0c1d2e3f4a5b"
+}
+
+data "digitalocean_urn" "from_urn" {
+  urn = "do:dbaas:0e1f2a3b-4c5d-4e6f-8a9b-1c2d3e4f5a6b"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_urn.from_id", "urn",
+						"do:kubernetes:6f3a8c1e-2b4d-4e5f-9a7b-0c1d2e3f4a5b"),
+					resource.TestCheckResourceAttr("data.digitalocean_urn.from_id", "resource_type", "kubernetes_cluster"),
+					resource.TestCheckResourceAttr("data.digitalocean_urn.from_urn", "resource_type", "dbaas"),
+					resource.TestCheckResourceAttr("data.digitalocean_urn.from_urn", "resource_id",
+						"0e1f2a3b-4c5d-4e6f-8a9b-1c2d3e4f5a6b"),
+				),
+			},
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/digitalocean/godo"
@@ -15,3 +16,67 @@ func IsDigitalOceanError(err error, code int, message string) bool {
 	}
 	return false
 }
+
+// ConflictKind classifies the errors returned by the API when a request
+// conflicts with the state of the resource, e.g. with another action.
+type ConflictKind int
+
+const (
+	// NoConflict is the kind of the errors which are not known conflicts.
+	NoConflict ConflictKind = iota
+
+	// PendingEventConflict is returned when the Droplet already runs an
+	// action. The request may succeed once the action is completed.
+	PendingEventConflict
+
+	// InProgressConflict is returned when another operation on the resource
+	// is in progress. The request may succeed once it is completed.
+	InProgressConflict
+
+	// AlreadyAttachedConflict is returned when attaching a volume which is
+	// already attached, so the request has no effect.
+	AlreadyAttachedConflict
+
+	// RateLimitConflict is returned when the API rate limit is exceeded. The
+	// request may succeed after backing off.
+	RateLimitConflict
+)
+
+// knownConflicts is the table of the known conflict errors, matched in order.
+// An empty message matches any error with one of the status codes.
+var knownConflicts = []struct {
+	codes   []int
+	message string
+	kind    ConflictKind
+}{
+	{[]int{http.StatusUnprocessableEntity, http.StatusConflict}, "pending event", PendingEventConflict},
+	{[]int{http.StatusUnprocessableEntity, http.StatusConflict}, "already attached", AlreadyAttachedConflict},
+	{[]int{http.StatusUnprocessableEntity, http.StatusConflict}, "in progress", InProgressConflict},
+	{[]int{http.StatusUnprocessableEntity, http.StatusConflict, http.StatusTooManyRequests}, "rate limit", RateLimitConflict},
+	{[]int{http.StatusTooManyRequests}, "", RateLimitConflict},
+}
+
+// DigitalOceanConflict returns the kind of conflict of the error, or
+// NoConflict if it is not a known conflict.
+func DigitalOceanConflict(err error) ConflictKind {
+	for _, conflict := range knownConflicts {
+		for _, code := range conflict.codes {
+			if IsDigitalOceanError(err, code, conflict.message) {
+				return conflict.kind
+			}
+		}
+	}
+
+	return NoConflict
+}
+
+// IsRetryableConflict reports whether the error is a known conflict which may
+// not be returned if the request is retried later.
+func IsRetryableConflict(err error) bool {
+	switch DigitalOceanConflict(err) {
+	case PendingEventConflict, InProgressConflict, RateLimitConflict:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestDigitalOceanConflict(t *testing.T) {
+	t.Parallel()
+
+	apiError := func(code int, message string) error {
+		return &godo.ErrorResponse{
+			Response: &http.Response{StatusCode: code},
+			Message:  message,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		err       error
+		kind      ConflictKind
+		retryable bool
+	}{
+		{"pending event", apiError(422, "Droplet already has a pending event."), PendingEventConflict, true},
+		{"pending event conflict", apiError(409, "Droplet already has a pending event."), PendingEventConflict, true},
+		{"operation in progress", apiError(409, "Operation in progress, please try again later."), InProgressConflict, true},
+		{"already attached", apiError(422, "Volume is already attached to this Droplet."), AlreadyAttachedConflict, false},
+		{"rate limit", apiError(429, "API Rate limit exceeded."), RateLimitConflict, true},
+		{"rate limit without message", apiError(429, ""), RateLimitConflict, true},
+		{"other unprocessable entity", apiError(422, "Invalid size."), NoConflict, false},
+		{"pending event with another code", apiError(500, "Droplet already has a pending event."), NoConflict, false},
+		{"not an API error", errors.New("Droplet already has a pending event."), NoConflict, false},
+		{"nil", nil, NoConflict, false},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if kind := DigitalOceanConflict(c.err); kind != c.kind {
+				t.Errorf("expected conflict kind %d, got %d", c.kind, kind)
+			}
+			if retryable := IsRetryableConflict(c.err); retryable != c.retryable {
+				t.Errorf("expected retryable to be %t, got %t", c.retryable, retryable)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// nameRule describes the naming rules documented by DigitalOcean for a kind
+// of resource, so invalid names are rejected at plan time rather than by the
+// API during the apply.
+type nameRule struct {
+	minLength int
+	maxLength int
+	// lowercase restricts the letters to lowercase ones.
+	lowercase bool
+	// punctuation holds the allowed characters besides letters and numbers.
+	punctuation string
+	// startWithLetter requires the name to begin with a letter.
+	startWithLetter bool
+	// notEndWith holds the characters the name must not end with.
+	notEndWith string
+	// charset describes the allowed characters in the error messages.
+	charset string
+}
+
+var (
+	dropletNameRule = nameRule{
+		minLength:   1,
+		maxLength:   255,
+		punctuation: ".-",
+		charset:     "letters, numbers, periods, and dashes",
+	}
+
+	loadBalancerNameRule = nameRule{
+		minLength:   1,
+		maxLength:   255,
+		punctuation: ".-",
+		charset:     "letters, numbers, periods, and dashes",
+	}
+
+	volumeNameRule = nameRule{
+		minLength:       1,
+		maxLength:       64,
+		lowercase:       true,
+		punctuation:     "-",
+		startWithLetter: true,
+		charset:         "lowercase letters, numbers, and dashes",
+	}
+
+	databaseClusterNameRule = nameRule{
+		minLength:       3,
+		maxLength:       63,
+		lowercase:       true,
+		punctuation:     "-",
+		startWithLetter: true,
+		notEndWith:      "-",
+		charset:         "lowercase letters, numbers, and dashes",
+	}
+)
+
+// ValidateDropletName validates the name of a Droplet, which is also used as
+// its hostname.
+func ValidateDropletName(i interface{}, k string) ([]string, []error) {
+	return dropletNameRule.validate(i, k)
+}
+
+// ValidateLoadBalancerName validates the name of a load balancer.
+func ValidateLoadBalancerName(i interface{}, k string) ([]string, []error) {
+	return loadBalancerNameRule.validate(i, k)
+}
+
+// ValidateVolumeName validates the name of a block storage volume.
+func ValidateVolumeName(i interface{}, k string) ([]string, []error) {
+	return volumeNameRule.validate(i, k)
+}
+
+// ValidateDatabaseClusterName validates the name of a database cluster.
+func ValidateDatabaseClusterName(i interface{}, k string) ([]string, []error) {
+	return databaseClusterNameRule.validate(i, k)
+}
+
+func (r nameRule) validate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if err := r.check(v); err != nil {
+		return nil, []error{fmt.Errorf("invalid %q %q: %s", k, v, err)}
+	}
+
+	return nil, nil
+}
+
+func (r nameRule) check(name string) error {
+	if len(name) < r.minLength || len(name) > r.maxLength {
+		return fmt.Errorf("must be between %d and %d characters long, got %d", r.minLength, r.maxLength, len(name))
+	}
+
+	for pos, c := range []rune(name) {
+		if !r.allows(c) {
+			return fmt.Errorf("may only contain %s, got invalid character %q at position %d", r.charset, c, pos+1)
+		}
+	}
+
+	if first := rune(name[0]); r.startWithLetter && !isLetter(first) {
+		return fmt.Errorf("must start with a letter, got %q", first)
+	}
+
+	if last := rune(name[len(name)-1]); strings.ContainsRune(r.notEndWith, last) {
+		return fmt.Errorf("must not end with %q", last)
+	}
+
+	return nil
+}
+
+func (r nameRule) allows(c rune) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		return true
+	case c >= 'A' && c <= 'Z':
+		return !r.lowercase
+	default:
+		return strings.ContainsRune(r.punctuation, c)
+	}
+}
+
+func isLetter(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		validate func(interface{}, string) ([]string, []error)
+		value    string
+		err      string
+	}{
+		{"droplet", ValidateDropletName, "web-01.example.com", ""},
+		{"droplet uppercase", ValidateDropletName, "Web-01", ""},
+		{"droplet empty", ValidateDropletName, "", "must be between 1 and 255 characters long, got 0"},
+		{"droplet too long", ValidateDropletName, strings.Repeat("a", 256), "must be between 1 and 255 characters long, got 256"},
+		{"droplet underscore", ValidateDropletName, "web_01", `invalid character '_' at position 4`},
+		{"droplet space", ValidateDropletName, "web 01", `invalid character ' ' at position 4`},
+		{"load balancer", ValidateLoadBalancerName, "lb-01.example", ""},
+		{"load balancer slash", ValidateLoadBalancerName, "lb/01", `invalid character '/' at position 3`},
+		{"volume", ValidateVolumeName, "data-01", ""},
+		{"volume uppercase", ValidateVolumeName, "Data", `may only contain lowercase letters, numbers, and dashes, got invalid character 'D' at position 1`},
+		{"volume digit first", ValidateVolumeName, "1data", "must start with a letter, got '1'"},
+		{"volume too long", ValidateVolumeName, strings.Repeat("a", 65), "must be between 1 and 64 characters long, got 65"},
+		{"volume period", ValidateVolumeName, "data.01", `invalid character '.' at position 5`},
+		{"database", ValidateDatabaseClusterName, "pg-main", ""},
+		{"database too short", ValidateDatabaseClusterName, "pg", "must be between 3 and 63 characters long, got 2"},
+		{"database trailing dash", ValidateDatabaseClusterName, "pg-main-", "must not end with '-'"},
+		{"database unicode", ValidateDatabaseClusterName, "pg-mäin", `invalid character 'ä' at position 5`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errs := tc.validate(tc.value, "name")
+			if tc.err == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected %q to be valid, got %v", tc.value, errs)
+				}
+				return
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("Expected one error for %q, got %v", tc.value, errs)
+			}
+			if !strings.Contains(errs[0].Error(), tc.err) {
+				t.Fatalf("Expected error to contain %q, got %q", tc.err, errs[0])
+			}
+		})
+	}
+}
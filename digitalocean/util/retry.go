@@ -0,0 +1,68 @@
+package util
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	retryMinBackoff = 500 * time.Millisecond
+	retryMaxBackoff = 30 * time.Second
+)
+
+// RetryContext calls f until it succeeds, returns a non-retryable error, or
+// the timeout elapses, like resource.RetryContext. The wait between attempts
+// grows exponentially and is randomized, so that resources retrying against
+// the same object in parallel, e.g. a Droplet with a pending event, do not
+// retry in lockstep.
+func RetryContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc) error {
+	deadline := time.Now().Add(timeout)
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		rerr := f()
+		if rerr == nil {
+			return nil
+		}
+		if !rerr.Retryable {
+			return rerr.Err
+		}
+		lastErr = rerr.Err
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return lastErr
+		}
+
+		wait := RetryBackoff(attempt)
+		if wait > remaining {
+			wait = remaining
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return lastErr
+		case <-timer.C:
+		}
+	}
+}
+
+// RetryBackoff returns the time to wait before retrying after the given
+// attempt, starting at 0. It doubles with each attempt up to 30 seconds, and
+// a random half of it is jittered.
+func RetryBackoff(attempt int) time.Duration {
+	backoff := retryMaxBackoff
+	if attempt < 16 {
+		if b := retryMinBackoff << attempt; b < retryMaxBackoff {
+			backoff = b
+		}
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
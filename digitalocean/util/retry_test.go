@@ -0,0 +1,95 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	for attempt := 0; attempt < 100; attempt++ {
+		expected := retryMaxBackoff
+		if attempt < 6 {
+			expected = retryMinBackoff << attempt
+		}
+
+		for i := 0; i < 10; i++ {
+			backoff := RetryBackoff(attempt)
+			if backoff < expected/2 || backoff > expected {
+				t.Fatalf("expected backoff of attempt %d to be between %s and %s, got %s", attempt, expected/2, expected, backoff)
+			}
+		}
+	}
+}
+
+func TestRetryContext(t *testing.T) {
+	t.Parallel()
+
+	errPending := errors.New("pending event")
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		err := RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+			attempts++
+			if attempts < 3 {
+				return resource.RetryableError(errPending)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+		if attempts != 3 {
+			t.Fatalf("expected 3 attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		errFatal := errors.New("fatal")
+		attempts := 0
+		err := RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+			attempts++
+			return resource.NonRetryableError(errFatal)
+		})
+		if err != errFatal {
+			t.Fatalf("expected %s, got %v", errFatal, err)
+		}
+		if attempts != 1 {
+			t.Fatalf("expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("timeout returns the last error", func(t *testing.T) {
+		t.Parallel()
+
+		err := RetryContext(context.Background(), time.Second, func() *resource.RetryError {
+			return resource.RetryableError(errPending)
+		})
+		if err != errPending {
+			t.Fatalf("expected %s, got %v", errPending, err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := RetryContext(ctx, time.Minute, func() *resource.RetryError {
+			return resource.RetryableError(errPending)
+		})
+		if err != errPending {
+			t.Fatalf("expected %s, got %v", errPending, err)
+		}
+	})
+}
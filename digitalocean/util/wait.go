@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -9,12 +10,15 @@ import (
 )
 
 // WaitForAction waits for the action to finish using the resource.StateChangeConf.
-func WaitForAction(client *godo.Client, action *godo.Action) error {
+// If the context is cancelled, e.g. when Terraform is interrupted, it stops
+// waiting and returns an error reporting the action which may still be in
+// progress.
+func WaitForAction(ctx context.Context, client *godo.Client, action *godo.Action) error {
 	var (
 		pending   = "in-progress"
 		target    = "completed"
 		refreshfn = func() (result interface{}, state string, err error) {
-			a, _, err := client.Actions.Get(context.Background(), action.ID)
+			a, _, err := client.Actions.Get(ctx, action.ID)
 			if err != nil {
 				return nil, "", err
 			}
@@ -40,6 +44,10 @@ func WaitForAction(client *godo.Client, action *godo.Action) error {
 		// https://github.com/hashicorp/terraform/issues/481
 		//
 		NotFoundChecks: 60,
-	}).WaitForState()
+	}).WaitForStateContext(ctx)
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("stopped waiting for action %d (%s) on %s %d, which may still be in progress: %s",
+			action.ID, action.Type, action.ResourceType, action.ResourceID, ctx.Err())
+	}
 	return err
 }
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestWaitForActionCancelled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action": {"id": 1234, "status": "in-progress", "type": "attach_volume"}}`))
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	action := &godo.Action{ID: 1234, Type: "attach_volume", ResourceType: "droplet", ResourceID: 5678}
+
+	start := time.Now()
+	err := WaitForAction(ctx, client, action)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected to stop waiting when the context is cancelled, waited %s", elapsed)
+	}
+
+	expected := "stopped waiting for action 1234 (attach_volume) on droplet 5678, which may still be in progress"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Expected error to contain %q, got %q", expected, err)
+	}
+}
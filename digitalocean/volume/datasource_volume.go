@@ -60,6 +60,11 @@ func DataSourceDigitalOceanVolume() *schema.Resource {
 				Computed:    true,
 				Description: "the label currently applied to the filesystem",
 			},
+			"encrypted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether the volume is encrypted at rest",
+			},
 			"droplet_ids": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
@@ -91,7 +96,7 @@ func dataSourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceDat
 	volumeList := []godo.Volume{}
 
 	for {
-		volumes, resp, err := client.Storage.ListVolumes(context.Background(), opts)
+		volumes, resp, err := client.Storage.ListVolumes(ctx, opts)
 
 		if err != nil {
 			return diag.Errorf("Error retrieving volumes: %s", err)
@@ -135,6 +140,8 @@ func dataSourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("filesystem_label", v)
 	}
 
+	d.Set("encrypted", volumesEncrypted)
+
 	if err = d.Set("droplet_ids", flattenDigitalOceanVolumeDropletIds(volume.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting droplet_ids: %#v", err)
 	}
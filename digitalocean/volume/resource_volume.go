@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,13 +42,14 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: util.ValidateVolumeName,
 			},
 			"urn": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "the uniform resource name for the volume.",
 			},
+			"project_id": project.ProjectIDSchema(),
 			"size": {
 				Type:         schema.TypeInt,
 				Required:     true,
@@ -103,11 +106,63 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 			},
 
 			"filesystem_label": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"initial_filesystem_label"},
+				Description:   "the label of the filesystem created with the volume; it cannot be changed in place, so changing it replaces the volume with an empty one and requires allow_reformat",
+			},
+
+			"encrypted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether the volume is encrypted at rest, known when planning so that it can be checked by preconditions",
+			},
+
+			"allow_reformat": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "allow changes to the filesystem type or label that replace the volume, destroying its data",
+			},
+
+			"mount": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Where the volume is mounted on the Droplets it is attached to by the cloud_init_config",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^/.+"), "must be an absolute path"),
+						},
+						"fstype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ext4", "xfs"}, false),
+							Description:  "the filesystem of the volume, defaults to the one the volume was formatted with",
+						},
+						"options": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      DefaultMountOptions,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"cloud_init_config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the cloud-init configuration formatting the volume if needed and mounting it as described by the mount block",
 			},
 
 			"tags": tag.TagsSchema(),
+
+			"tags_authoritative": tag.TagsAuthoritativeSchema(),
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -119,7 +174,56 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 				return fmt.Errorf("volumes `size` can only be expanded and not shrunk")
 			}
 
-			return nil
+			fsType := diff.Get("initial_filesystem_type").(string)
+			if fsType == "" {
+				fsType = diff.Get("filesystem_type").(string)
+			}
+
+			for _, key := range []string{"initial_filesystem_label", "filesystem_label"} {
+				label := diff.Get(key).(string)
+				if label == "" || !diff.NewValueKnown(key) {
+					continue
+				}
+
+				if fsType == "" {
+					if diff.Id() == "" {
+						return fmt.Errorf("`%s` requires `initial_filesystem_type` to be set", key)
+					}
+					continue
+				}
+
+				if err := ValidateFilesystemLabel(fsType, label); err != nil {
+					return fmt.Errorf("invalid `%s`: %s", key, err)
+				}
+			}
+
+			if diff.Id() == "" {
+				if err := diff.SetNew("encrypted", volumesEncrypted); err != nil {
+					return err
+				}
+			}
+
+			// The filesystem is only created when the volume is created, so
+			// changing it requires replacing the volume and losing its data.
+			if diff.Id() != "" {
+				for _, key := range []string{"initial_filesystem_type", "initial_filesystem_label", "filesystem_label"} {
+					if !diff.HasChange(key) {
+						continue
+					}
+
+					if !diff.Get("allow_reformat").(bool) {
+						return fmt.Errorf("changing `%s` requires replacing volume %s and destroying its data, set `allow_reformat = true` to proceed", key, diff.Id())
+					}
+
+					if key == "filesystem_label" {
+						if err := diff.ForceNew(key); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			return setVolumeCloudInitConfigDiff(diff, v.(*config.CombinedConfig), fsType)
 		},
 	}
 }
@@ -128,7 +232,7 @@ func resourceDigitalOceanVolumeCreate(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	opts := &godo.VolumeCreateRequest{
-		Name:        d.Get("name").(string),
+		Name:        meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 		Description: d.Get("description").(string),
 		Tags:        tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
 	}
@@ -141,6 +245,10 @@ func resourceDigitalOceanVolumeCreate(ctx context.Context, d *schema.ResourceDat
 	}
 	if v, ok := d.GetOk("snapshot_id"); ok {
 		opts.SnapshotID = v.(string)
+
+		if err := checkVolumeSnapshotRegion(ctx, client, opts.SnapshotID, opts.Region); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	if v, ok := d.GetOk("initial_filesystem_type"); ok {
 		opts.FilesystemType = v.(string)
@@ -150,10 +258,12 @@ func resourceDigitalOceanVolumeCreate(ctx context.Context, d *schema.ResourceDat
 	}
 	if v, ok := d.GetOk("initial_filesystem_label"); ok {
 		opts.FilesystemLabel = v.(string)
+	} else if v, ok := d.GetOk("filesystem_label"); ok {
+		opts.FilesystemLabel = v.(string)
 	}
 
 	log.Printf("[DEBUG] Volume create configuration: %#v", opts)
-	volume, _, err := client.Storage.CreateVolume(context.Background(), opts)
+	volume, _, err := client.Storage.CreateVolume(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Volume: %s", err)
 	}
@@ -161,6 +271,12 @@ func resourceDigitalOceanVolumeCreate(ctx context.Context, d *schema.ResourceDat
 	d.SetId(volume.ID)
 	log.Printf("[INFO] Volume name: %s", volume.Name)
 
+	if v, ok := d.GetOk("project_id"); ok {
+		if err := project.AssignResource(ctx, client, v.(string), volume.URN()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDigitalOceanVolumeRead(ctx, d, meta)
 }
 
@@ -174,32 +290,36 @@ func resourceDigitalOceanVolumeUpdate(ctx context.Context, d *schema.ResourceDat
 		size := d.Get("size").(int)
 
 		log.Printf("[DEBUG] Volume resize configuration: %v", size)
-		action, _, err := client.StorageActions.Resize(context.Background(), id, size, region)
+		action, _, err := client.StorageActions.Resize(ctx, id, size, region)
 		if err != nil {
 			return diag.Errorf("Error resizing volume (%s): %s", id, err)
 		}
 
 		log.Printf("[DEBUG] Volume resize action id: %d", action.ID)
-		if err = util.WaitForAction(client, action); err != nil {
+		if err = util.WaitForAction(ctx, client, action); err != nil {
 			return diag.Errorf(
 				"Error waiting for resize volume (%s) to finish: %s", id, err)
 		}
 	}
 
 	if d.HasChange("tags") {
-		err := tag.SetTags(client, d, godo.VolumeResourceType)
+		err := tag.SetTags(ctx, client, d, godo.VolumeResourceType)
 		if err != nil {
 			return diag.Errorf("Error updating tags: %s", err)
 		}
 	}
 
+	if err := project.UpdateProjectID(ctx, client, d, godo.Volume{ID: id}.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceDigitalOceanVolumeRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	volume, resp, err := client.Storage.GetVolume(context.Background(), d.Id())
+	volume, resp, err := client.Storage.GetVolume(ctx, d.Id())
 	if err != nil {
 		// If the volume is somehow already destroyed, mark as
 		// successfully gone
@@ -211,11 +331,15 @@ func resourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error retrieving volume: %s", err)
 	}
 
-	d.Set("name", volume.Name)
+	d.Set("name", meta.(*config.CombinedConfig).UnprefixName(volume.Name))
 	d.Set("region", volume.Region.Slug)
 	d.Set("size", int(volume.SizeGigaBytes))
 	d.Set("urn", volume.URN())
-	d.Set("tags", tag.FlattenTags(volume.Tags))
+	tag.SetManagedTags(d, volume.Tags)
+
+	if err := project.ReadProjectID(ctx, client, d, volume.URN()); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if v := volume.Description; v != "" {
 		d.Set("description", v)
@@ -227,6 +351,8 @@ func resourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("filesystem_label", v)
 	}
 
+	d.Set("encrypted", volumesEncrypted)
+
 	if err = d.Set("droplet_ids", flattenDigitalOceanVolumeDropletIds(volume.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting droplet_ids: %#v", err)
 	}
@@ -238,8 +364,13 @@ func resourceDigitalOceanVolumeDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*config.CombinedConfig).GodoClient()
 
 	log.Printf("[INFO] Deleting volume: %s", d.Id())
-	_, err := client.Storage.DeleteVolume(context.Background(), d.Id())
+	resp, err := client.Storage.DeleteVolume(ctx, d.Id())
 	if err != nil {
+		// The volume may have already been destroyed along with a Droplet
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error deleting volume: %s", err)
 	}
 
@@ -247,6 +378,107 @@ func resourceDigitalOceanVolumeDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// checkVolumeSnapshotRegion returns an error if the volume snapshot is not
+// available in the region, as volume snapshots can not be transferred between
+// regions and the API error does not point this out.
+func checkVolumeSnapshotRegion(ctx context.Context, client *godo.Client, snapshotID string, region string) error {
+	snapshot, _, err := client.Snapshots.Get(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving volume snapshot (%s): %s", snapshotID, err)
+	}
+
+	for _, r := range snapshot.Regions {
+		if strings.EqualFold(r, region) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("volume snapshot %s is only available in %s and can not be restored in %s, volume snapshots can not be transferred between regions",
+		snapshotID, strings.Join(snapshot.Regions, ", "), region)
+}
+
+// filesystemLabelMaxLength is the maximum length of a filesystem label
+// supported by each filesystem type.
+var filesystemLabelMaxLength = map[string]int{
+	"ext4": 16,
+	"xfs":  12,
+}
+
+// ValidateFilesystemLabel checks the label is supported by the filesystem type.
+func ValidateFilesystemLabel(fsType, label string) error {
+	maxLength, ok := filesystemLabelMaxLength[fsType]
+	if !ok {
+		return fmt.Errorf("unsupported filesystem type %q", fsType)
+	}
+
+	if len(label) > maxLength {
+		return fmt.Errorf("%s filesystem labels can be at most %d characters, got %d", fsType, maxLength, len(label))
+	}
+
+	return nil
+}
+
+// DefaultMountOptions are the options volumes are mounted with by default.
+const DefaultMountOptions = "defaults,nofail,discard,noatime"
+
+// DevicePath returns the path of the device the volume with the given name
+// is available at on the Droplets it is attached to.
+func DevicePath(volumeName string) string {
+	return "/dev/disk/by-id/scsi-0DO_Volume_" + volumeName
+}
+
+// MountEntry returns the entry of the cloud-init mounts module mounting the
+// volume with the given name.
+func MountEntry(volumeName, path, fstype, options string) string {
+	return fmt.Sprintf("[%q, %q, %q, %q, %q, %q]", DevicePath(volumeName), path, fstype, options, "0", "2")
+}
+
+// setVolumeCloudInitConfigDiff plans the cloud_init_config of the volume, so
+// that it only depends on the configuration of the volume and is known before
+// the Droplets it is passed to are created.
+func setVolumeCloudInitConfigDiff(diff *schema.ResourceDiff, combined *config.CombinedConfig, fsType string) error {
+	for _, key := range []string{"name", "initial_filesystem_type", "mount"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("cloud_init_config")
+		}
+	}
+
+	cloudInitConfig, err := volumeCloudInitConfig(combined.PrefixName(diff.Get("name").(string)), fsType, diff.Get("mount").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("cloud_init_config", cloudInitConfig)
+}
+
+// volumeCloudInitConfig returns the cloud-init configuration mounting the
+// volume as described by the mount block, formatting it first if it is created
+// without a filesystem. It returns an empty string if there is no mount block.
+func volumeCloudInitConfig(volumeName, fsType string, mounts []interface{}) (string, error) {
+	if len(mounts) == 0 || mounts[0] == nil {
+		return "", nil
+	}
+
+	mount := mounts[0].(map[string]interface{})
+	mountFSType := mount["fstype"].(string)
+	if mountFSType == "" {
+		mountFSType = fsType
+	}
+	if mountFSType == "" {
+		return "", fmt.Errorf("`mount.fstype` must be set as the volume is created without a filesystem, or set `initial_filesystem_type`")
+	}
+
+	var config strings.Builder
+	config.WriteString("#cloud-config\n")
+	if fsType == "" {
+		fmt.Fprintf(&config, "fs_setup:\n  - device: %q\n    filesystem: %q\n    partition: \"none\"\n    overwrite: false\n",
+			DevicePath(volumeName), mountFSType)
+	}
+	fmt.Fprintf(&config, "mounts:\n  - %s\n", MountEntry(volumeName, mount["path"].(string), mountFSType, mount["options"].(string)))
+
+	return config.String(), nil
+}
+
 func flattenDigitalOceanVolumeDropletIds(droplets []int) *schema.Set {
 	flattenedDroplets := schema.NewSet(schema.HashInt, []interface{}{})
 	for _, v := range droplets {
@@ -255,3 +487,9 @@ func flattenDigitalOceanVolumeDropletIds(droplets []int) *schema.Set {
 
 	return flattenedDroplets
 }
+
+// volumesEncrypted is whether volumes are encrypted at rest. The API does not
+// report it as all volumes are encrypted at rest with keys managed by
+// DigitalOcean, so it is the same for every volume and known before the volume
+// is created.
+const volumesEncrypted = true
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,55 +35,43 @@ func ResourceDigitalOceanVolumeAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			"device_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the path of the device of the volume on the Droplet",
+			},
+
+			"attachment_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the state of the attachment: attached, or attaching or detaching while an action on the volume is in progress",
+			},
 		},
 	}
 }
 
 func resourceDigitalOceanVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
+	client := combined.GodoClient()
 
 	dropletId := d.Get("droplet_id").(int)
 	volumeId := d.Get("volume_id").(string)
 
-	volume, _, err := client.Storage.GetVolume(context.Background(), volumeId)
+	volume, _, err := client.Storage.GetVolume(ctx, volumeId)
 	if err != nil {
 		return diag.Errorf("Error retrieving volume: %s", err)
 	}
 
 	if volume.DropletIDs == nil || len(volume.DropletIDs) == 0 || volume.DropletIDs[0] != dropletId {
-
-		// Only one volume can be attached at one time to a single droplet.
-		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
-
-			log.Printf("[DEBUG] Attaching Volume (%s) to Droplet (%d)", volumeId, dropletId)
-			action, _, err := client.StorageActions.Attach(context.Background(), volumeId, dropletId)
-			if err != nil {
-				if util.IsDigitalOceanError(err, 422, "Droplet already has a pending event.") {
-					log.Printf("[DEBUG] Received %s, retrying attaching volume to droplet", err)
-					return resource.RetryableError(err)
-				}
-
-				return resource.NonRetryableError(
-					fmt.Errorf("[WARN] Error attaching volume (%s) to Droplet (%d): %s", volumeId, dropletId, err))
-			}
-
-			log.Printf("[DEBUG] Volume attach action id: %d", action.ID)
-			if err = util.WaitForAction(client, action); err != nil {
-				return resource.NonRetryableError(
-					fmt.Errorf("[DEBUG] Error waiting for attach volume (%s) to Droplet (%d) to finish: %s", volumeId, dropletId, err))
-			}
-
-			return nil
-		})
-
-		if err != nil {
-			return diag.Errorf("Error attaching volume to droplet after retry timeout: %s", err)
+		if err := attachVolume(ctx, combined, volumeId, dropletId); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%s-", dropletId, volumeId)))
 
-	return nil
+	return resourceDigitalOceanVolumeAttachmentRead(ctx, d, meta)
 }
 
 func resourceDigitalOceanVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -91,7 +80,7 @@ func resourceDigitalOceanVolumeAttachmentRead(ctx context.Context, d *schema.Res
 	dropletId := d.Get("droplet_id").(int)
 	volumeId := d.Get("volume_id").(string)
 
-	volume, resp, err := client.Storage.GetVolume(context.Background(), volumeId)
+	volume, resp, err := client.Storage.GetVolume(ctx, volumeId)
 	if err != nil {
 		// If the volume is already destroyed, mark as
 		// successfully removed
@@ -106,34 +95,155 @@ func resourceDigitalOceanVolumeAttachmentRead(ctx context.Context, d *schema.Res
 	if volume.DropletIDs == nil || len(volume.DropletIDs) == 0 || volume.DropletIDs[0] != dropletId {
 		log.Printf("[DEBUG] Volume Attachment (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
+	attachmentState, err := volumeAttachmentState(ctx, client, volumeId)
+	if err != nil {
+		return diag.Errorf("Error retrieving volume actions: %s", err)
+	}
+
+	d.Set("device_path", DevicePath(volume.Name))
+	d.Set("attachment_state", attachmentState)
+
 	return nil
 }
 
+// volumeAttachmentState returns the state of the attachment of the volume
+// from its most recent actions: attaching or detaching while such an action is
+// in progress, attached otherwise.
+func volumeAttachmentState(ctx context.Context, client *godo.Client, volumeID string) (string, error) {
+	actions, _, err := client.StorageActions.List(ctx, volumeID, &godo.ListOptions{PerPage: 20})
+	if err != nil {
+		return "", err
+	}
+
+	for _, action := range actions {
+		if action.Status != godo.ActionInProgress {
+			continue
+		}
+		switch action.Type {
+		case "attach":
+			return "attaching", nil
+		case "detach":
+			return "detaching", nil
+		}
+	}
+
+	return "attached", nil
+}
+
 func resourceDigitalOceanVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*config.CombinedConfig).GodoClient()
+	combined := meta.(*config.CombinedConfig)
 
 	dropletId := d.Get("droplet_id").(int)
 	volumeId := d.Get("volume_id").(string)
 
+	if err := detachVolume(ctx, combined, volumeId, dropletId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// attachVolume attaches the volume to the Droplet, retrying while the Droplet
+// has a pending event or another action in progress.
+func attachVolume(ctx context.Context, combined *config.CombinedConfig, volumeId string, dropletId int) error {
+	client := combined.GodoClient()
+
+	// Only one volume can be attached at one time to a single droplet.
+	err := util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		unlock, err := combined.LockDropletActions(ctx, dropletId)
+		if err != nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Error waiting for the lock of Droplet (%d) actions: %s", dropletId, err))
+		}
+		defer unlock()
+
+		log.Printf("[DEBUG] Attaching Volume (%s) to Droplet (%d)", volumeId, dropletId)
+		action, _, err := client.StorageActions.Attach(ctx, volumeId, dropletId)
+		if err != nil {
+			if util.IsRetryableConflict(err) {
+				log.Printf("[DEBUG] Received %s, retrying attaching volume to droplet", err)
+				return resource.RetryableError(err)
+			}
+
+			// The volume may be attached to another Droplet rather than this one.
+			if util.DigitalOceanConflict(err) == util.AlreadyAttachedConflict {
+				volume, _, getErr := client.Storage.GetVolume(ctx, volumeId)
+				if getErr == nil && AttachedToDroplet(volume, dropletId) {
+					log.Printf("[DEBUG] Volume (%s) is already attached to Droplet (%d)", volumeId, dropletId)
+					return nil
+				}
+			}
+
+			return resource.NonRetryableError(
+				fmt.Errorf("[WARN] Error attaching volume (%s) to Droplet (%d): %s", volumeId, dropletId, err))
+		}
+
+		log.Printf("[DEBUG] Volume attach action id: %d", action.ID)
+		if err = util.WaitForAction(ctx, client, action); err != nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("[DEBUG] Error waiting for attach volume (%s) to Droplet (%d) to finish: %s", volumeId, dropletId, err))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error attaching volume to droplet after retry timeout: %s", err)
+	}
+
+	return nil
+}
+
+// detachVolume detaches the volume from the Droplet, retrying while the
+// Droplet has a pending event.
+func detachVolume(ctx context.Context, combined *config.CombinedConfig, volumeId string, dropletId int) error {
+	client := combined.GodoClient()
+
 	// Only one volume can be detached at one time to a single droplet.
-	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+	err := util.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		unlock, err := combined.LockDropletActions(ctx, dropletId)
+		if err != nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Error waiting for the lock of Droplet (%d) actions: %s", dropletId, err))
+		}
+		defer unlock()
 
 		log.Printf("[DEBUG] Detaching Volume (%s) from Droplet (%d)", volumeId, dropletId)
-		action, _, err := client.StorageActions.DetachByDropletID(context.Background(), volumeId, dropletId)
+		action, resp, err := client.StorageActions.DetachByDropletID(ctx, volumeId, dropletId)
 		if err != nil {
-			if util.IsDigitalOceanError(err, 422, "Droplet already has a pending event.") {
+			// The Droplet or volume has already been destroyed, e.g. when both
+			// are removed in the same apply, so there is nothing to detach.
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("[DEBUG] Droplet (%d) or volume (%s) not found, considering volume detached", dropletId, volumeId)
+				return nil
+			}
+
+			if util.IsRetryableConflict(err) {
 				log.Printf("[DEBUG] Received %s, retrying detaching volume from droplet", err)
 				return resource.RetryableError(err)
 			}
 
+			// The volume may have been detached by the Droplet while it was
+			// being destroyed.
+			if detached, checkErr := volumeDetachedFromDroplet(ctx, client, volumeId, dropletId); checkErr == nil && detached {
+				log.Printf("[DEBUG] Volume (%s) is no longer attached to Droplet (%d)", volumeId, dropletId)
+				return nil
+			}
+
 			return resource.NonRetryableError(
 				fmt.Errorf("[WARN] Error detaching volume (%s) from Droplet (%d): %s", volumeId, dropletId, err))
 		}
 
 		log.Printf("[DEBUG] Volume detach action id: %d", action.ID)
-		if err = util.WaitForAction(client, action); err != nil {
+		if err = util.WaitForAction(ctx, client, action); err != nil {
+			if detached, checkErr := volumeDetachedFromDroplet(ctx, client, volumeId, dropletId); checkErr == nil && detached {
+				log.Printf("[DEBUG] Volume (%s) is no longer attached to Droplet (%d)", volumeId, dropletId)
+				return nil
+			}
+
 			return resource.NonRetryableError(
 				fmt.Errorf("Error waiting for detach volume (%s) from Droplet (%d) to finish: %s", volumeId, dropletId, err))
 		}
@@ -142,8 +252,34 @@ func resourceDigitalOceanVolumeAttachmentDelete(ctx context.Context, d *schema.R
 	})
 
 	if err != nil {
-		return diag.Errorf("Error detaching volume from droplet after retry timeout: %s", err)
+		return fmt.Errorf("Error detaching volume from droplet after retry timeout: %s", err)
 	}
 
 	return nil
 }
+
+// volumeDetachedFromDroplet reports whether the volume is no longer attached
+// to the Droplet, including when either of them no longer exists.
+func volumeDetachedFromDroplet(ctx context.Context, client *godo.Client, volumeID string, dropletID int) (bool, error) {
+	volume, resp, err := client.Storage.GetVolume(ctx, volumeID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if !AttachedToDroplet(volume, dropletID) {
+		return true, nil
+	}
+
+	_, resp, err = client.Droplets.Get(ctx, dropletID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return false, nil
+}
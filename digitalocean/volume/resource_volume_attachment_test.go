@@ -3,16 +3,59 @@ package volume_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/volume"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDigitalOceanVolumeAttachmentReadDetaching(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/volumes/volume-id":
+			w.Write([]byte(`{"volume": {"id": "volume-id", "name": "foo", "droplet_ids": [123]}}`))
+		case "/v2/volumes/volume-id/actions":
+			w.Write([]byte(`{"actions": [{"id": 2, "type": "detach", "status": "in-progress"}, {"id": 1, "type": "attach", "status": "completed"}]}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := config.Config{
+		Token:       "12345",
+		APIEndpoint: server.URL,
+	}
+	meta, err := conf.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := volume.ResourceDigitalOceanVolumeAttachment()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"droplet_id": 123,
+		"volume_id":  "volume-id",
+	})
+	d.SetId("123-volume-id-")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if state := d.Get("attachment_state").(string); state != "detaching" {
+		t.Errorf("Expected attachment_state to be detaching, got %q", state)
+	}
+}
+
 func TestAccDigitalOceanVolumeAttachment_Basic(t *testing.T) {
 	var (
 		volume  = godo.Volume{Name: acceptance.RandomTestName()}
@@ -37,6 +80,10 @@ func TestAccDigitalOceanVolumeAttachment_Basic(t *testing.T) {
 						"digitalocean_volume_attachment.foobar", "droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_volume_attachment.foobar", "volume_id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachment.foobar", "device_path", "/dev/disk/by-id/scsi-0DO_Volume_"+volume.Name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachment.foobar", "attachment_state", "attached"),
 				),
 			},
 		},
@@ -0,0 +1,204 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanVolumeAttachments attaches several volumes to a Droplet.
+// The volumes are attached one at a time, in order, as a Droplet can only run
+// one action at a time.
+func ResourceDigitalOceanVolumeAttachments() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanVolumeAttachmentsCreate,
+		ReadContext:   resourceDigitalOceanVolumeAttachmentsRead,
+		UpdateContext: resourceDigitalOceanVolumeAttachmentsUpdate,
+		DeleteContext: resourceDigitalOceanVolumeAttachmentsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"volume_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Description: "the IDs of the volumes to attach to the Droplet, in the order they are attached",
+			},
+
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"volume_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanVolumeAttachmentsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	combined := meta.(*config.CombinedConfig)
+
+	dropletId := d.Get("droplet_id").(int)
+
+	for _, id := range d.Get("volume_ids").([]interface{}) {
+		if err := ensureVolumeAttached(ctx, combined, id.(string), dropletId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-", dropletId)))
+
+	return resourceDigitalOceanVolumeAttachmentsRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanVolumeAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletId := d.Get("droplet_id").(int)
+
+	volumeIds := []string{}
+	attachments := []map[string]interface{}{}
+	for _, id := range d.Get("volume_ids").([]interface{}) {
+		volume, resp, err := client.Storage.GetVolume(ctx, id.(string))
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("[DEBUG] Volume (%s) not found, removing it from volume attachments (%s)", id, d.Id())
+				continue
+			}
+
+			return diag.Errorf("Error retrieving volume: %s", err)
+		}
+
+		if !AttachedToDroplet(volume, dropletId) {
+			log.Printf("[DEBUG] Volume (%s) is not attached to Droplet (%d), removing it from volume attachments (%s)", id, dropletId, d.Id())
+			continue
+		}
+
+		volumeIds = append(volumeIds, volume.ID)
+		attachments = append(attachments, map[string]interface{}{
+			"volume_id":   volume.ID,
+			"name":        volume.Name,
+			"device_path": DevicePath(volume.Name),
+		})
+	}
+
+	if len(volumeIds) == 0 {
+		log.Printf("[DEBUG] Volume attachments (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("volume_ids", volumeIds); err != nil {
+		return diag.Errorf("Error setting volume_ids: %s", err)
+	}
+
+	if err := d.Set("attachments", attachments); err != nil {
+		return diag.Errorf("Error setting attachments: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanVolumeAttachmentsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	combined := meta.(*config.CombinedConfig)
+
+	dropletId := d.Get("droplet_id").(int)
+
+	if d.HasChange("volume_ids") {
+		old, new := d.GetChange("volume_ids")
+
+		keep := map[string]bool{}
+		for _, id := range new.([]interface{}) {
+			keep[id.(string)] = true
+		}
+
+		for _, id := range old.([]interface{}) {
+			if keep[id.(string)] {
+				continue
+			}
+
+			if err := detachVolume(ctx, combined, id.(string), dropletId); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, id := range new.([]interface{}) {
+			if err := ensureVolumeAttached(ctx, combined, id.(string), dropletId); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceDigitalOceanVolumeAttachmentsRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanVolumeAttachmentsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	combined := meta.(*config.CombinedConfig)
+
+	dropletId := d.Get("droplet_id").(int)
+
+	// The volumes are detached in the reverse order they were attached in.
+	volumeIds := d.Get("volume_ids").([]interface{})
+	for i := len(volumeIds) - 1; i >= 0; i-- {
+		if err := detachVolume(ctx, combined, volumeIds[i].(string), dropletId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// ensureVolumeAttached attaches the volume to the Droplet unless it already is.
+func ensureVolumeAttached(ctx context.Context, combined *config.CombinedConfig, volumeId string, dropletId int) error {
+	volume, _, err := combined.GodoClient().Storage.GetVolume(ctx, volumeId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving volume: %s", err)
+	}
+
+	if AttachedToDroplet(volume, dropletId) {
+		return nil
+	}
+
+	return attachVolume(ctx, combined, volumeId, dropletId)
+}
+
+// AttachedToDroplet reports whether the volume is attached to the Droplet.
+func AttachedToDroplet(volume *godo.Volume, dropletId int) bool {
+	for _, id := range volume.DropletIDs {
+		if id == dropletId {
+			return true
+		}
+	}
+	return false
+}
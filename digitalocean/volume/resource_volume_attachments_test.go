@@ -0,0 +1,131 @@
+package volume_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanVolumeAttachments_Basic(t *testing.T) {
+	dName := acceptance.RandomTestName()
+	vName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeAttachmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanVolumeAttachmentsConfig(dName, vName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVolumeAttachmentsExist("digitalocean_volume_attachments.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "volume_ids.#", "3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "attachments.#", "3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "attachments.0.name", vName+"-0"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "attachments.2.device_path", "/dev/disk/by-id/scsi-0DO_Volume_"+vName+"-2"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanVolumeAttachmentsConfig(dName, vName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVolumeAttachmentsExist("digitalocean_volume_attachments.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "volume_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume_attachments.foobar", "attachments.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanVolumeAttachmentsExist(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no volume attachments ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		dropletId, err := strconv.Atoi(rs.Primary.Attributes["droplet_id"])
+		if err != nil {
+			return err
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["volume_ids.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			volumeId := rs.Primary.Attributes[fmt.Sprintf("volume_ids.%d", i)]
+			got, _, err := client.Storage.GetVolume(context.Background(), volumeId)
+			if err != nil {
+				return err
+			}
+
+			attached := false
+			for _, id := range got.DropletIDs {
+				attached = attached || id == dropletId
+			}
+			if !attached {
+				return fmt.Errorf("volume %s is not attached to Droplet %d", volumeId, dropletId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanVolumeAttachmentsDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_volume" {
+			continue
+		}
+
+		_, _, err := client.Storage.GetVolume(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Volume still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanVolumeAttachmentsConfig(dName, vName string, count int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_volume" "foobar" {
+  count  = 3
+  region = "nyc1"
+  name   = "%s-${count.index}"
+  size   = 5
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc1"
+}
+
+resource "digitalocean_volume_attachments" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+  volume_ids = slice(digitalocean_volume.foobar[*].id, 0, %d)
+}`, vName, dName, count)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/volume"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -42,6 +43,8 @@ func TestAccDigitalOceanVolume_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"digitalocean_volume.foobar", "tags.#", "2"),
 					resource.TestMatchResourceAttr("digitalocean_volume.foobar", "urn", expectedURNRegEx),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume.foobar", "encrypted", "true"),
 				),
 			},
 		},
@@ -236,6 +239,223 @@ resource "digitalocean_volume" "foobar" {
   initial_filesystem_label = "label"
 }`
 
+func TestAccDigitalOceanVolume_FilesystemLabel(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	volume := godo.Volume{
+		Name: name,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, "xfs", "label-too-long", false),
+				ExpectError: regexp.MustCompile("xfs filesystem labels can be at most 12 characters"),
+			},
+			{
+				Config: testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, "ext4", "data", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVolumeExists("digitalocean_volume.foobar", &volume),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume.foobar", "filesystem_type", "ext4"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume.foobar", "filesystem_label", "data"),
+				),
+			},
+			{
+				Config:      testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, "ext4", "logs", false),
+				ExpectError: regexp.MustCompile("set `allow_reformat = true` to proceed"),
+			},
+			{
+				Config:      testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, "xfs", "data", false),
+				ExpectError: regexp.MustCompile("set `allow_reformat = true` to proceed"),
+			},
+			{
+				Config: testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, "ext4", "logs", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVolumeExists("digitalocean_volume.foobar", &volume),
+					resource.TestCheckResourceAttr(
+						"digitalocean_volume.foobar", "filesystem_label", "logs"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanVolumeConfig_filesystem_label(name, fsType, label string, allowReformat bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_volume" "foobar" {
+  region                  = "nyc1"
+  name                    = "%s"
+  size                    = 10
+  initial_filesystem_type = "%s"
+  filesystem_label        = "%s"
+  allow_reformat          = %t
+}`, name, fsType, label, allowReformat)
+}
+
+func TestAccDigitalOceanVolume_Mount(t *testing.T) {
+	var (
+		vName   = acceptance.RandomTestName()
+		dName   = acceptance.RandomTestName()
+		volume  = godo.Volume{Name: vName}
+		droplet godo.Droplet
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanVolumeConfig_mount(vName, dName, "/mnt/data"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVolumeExists("digitalocean_volume.foobar", &volume),
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestMatchResourceAttr("digitalocean_volume.foobar", "cloud_init_config",
+						regexp.MustCompile(`fs_setup:\n  - device: "/dev/disk/by-id/scsi-0DO_Volume_`+vName+`"\n    filesystem: "ext4"`)),
+					resource.TestMatchResourceAttr("digitalocean_volume.foobar", "cloud_init_config",
+						regexp.MustCompile(`mounts:\n  - \["/dev/disk/by-id/scsi-0DO_Volume_`+vName+`", "/mnt/data", "ext4", "defaults,nofail,discard,noatime", "0", "2"\]`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanVolumeConfig_mount(vName, dName, path string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_volume" "foobar" {
+  region = "nyc1"
+  name   = "%s"
+  size   = 5
+
+  mount {
+    path   = "%s"
+    fstype = "ext4"
+  }
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name       = "%s"
+  size       = "s-1vcpu-1gb"
+  image      = "ubuntu-22-04-x64"
+  region     = "nyc1"
+  volume_ids = [digitalocean_volume.foobar.id]
+  user_data  = digitalocean_volume.foobar.cloud_init_config
+}`, vName, path, dName)
+}
+
+func TestVolumeEncryptedPlanned(t *testing.T) {
+	conf := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": "nyc1",
+		"name":   "foobar",
+		"size":   10,
+	})
+
+	r := volume.ResourceDigitalOceanVolume()
+	diff, err := r.Diff(context.Background(), nil, conf, &config.CombinedConfig{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	attr, ok := diff.Attributes["encrypted"]
+	if !ok || attr.NewComputed || attr.New != "true" {
+		t.Fatalf("Expected encrypted to be planned as true, got %#v", attr)
+	}
+}
+
+func TestVolumeCloudInitConfigPlanned(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Config      map[string]interface{}
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name: "formatted volume",
+			Config: map[string]interface{}{
+				"initial_filesystem_type": "ext4",
+				"mount":                   []interface{}{map[string]interface{}{"path": "/mnt/data"}},
+			},
+			Expected: "#cloud-config\nmounts:\n" +
+				"  - [\"/dev/disk/by-id/scsi-0DO_Volume_foobar\", \"/mnt/data\", \"ext4\", \"defaults,nofail,discard,noatime\", \"0\", \"2\"]\n",
+		},
+		{
+			Name: "unformatted volume",
+			Config: map[string]interface{}{
+				"mount": []interface{}{map[string]interface{}{"path": "/srv", "fstype": "xfs", "options": "defaults"}},
+			},
+			Expected: "#cloud-config\nfs_setup:\n" +
+				"  - device: \"/dev/disk/by-id/scsi-0DO_Volume_foobar\"\n    filesystem: \"xfs\"\n    partition: \"none\"\n    overwrite: false\n" +
+				"mounts:\n  - [\"/dev/disk/by-id/scsi-0DO_Volume_foobar\", \"/srv\", \"xfs\", \"defaults\", \"0\", \"2\"]\n",
+		},
+		{
+			Name: "unformatted volume without fstype",
+			Config: map[string]interface{}{
+				"mount": []interface{}{map[string]interface{}{"path": "/srv"}},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"region": "nyc1",
+				"name":   "foobar",
+				"size":   10,
+			}
+			for k, v := range tc.Config {
+				raw[k] = v
+			}
+
+			r := volume.ResourceDigitalOceanVolume()
+			diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &config.CombinedConfig{})
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			attr, ok := diff.Attributes["cloud_init_config"]
+			if !ok || attr.NewComputed || attr.New != tc.Expected {
+				t.Errorf("Expected cloud_init_config to be planned as %q, got %#v", tc.Expected, attr)
+			}
+		})
+	}
+}
+
+func TestValidateFilesystemLabel(t *testing.T) {
+	cases := []struct {
+		FilesystemType string
+		Label          string
+		ExpectError    bool
+	}{
+		{FilesystemType: "ext4", Label: "sixteen-chars-ok"},
+		{FilesystemType: "ext4", Label: "seventeen-chars-x", ExpectError: true},
+		{FilesystemType: "xfs", Label: "twelve-chars"},
+		{FilesystemType: "xfs", Label: "thirteen-char", ExpectError: true},
+		{FilesystemType: "btrfs", Label: "data", ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		err := volume.ValidateFilesystemLabel(tc.FilesystemType, tc.Label)
+		if tc.ExpectError && err == nil {
+			t.Errorf("expected an error for %s label %q", tc.FilesystemType, tc.Label)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("unexpected error for %s label %q: %s", tc.FilesystemType, tc.Label, err)
+		}
+	}
+}
+
 func TestAccDigitalOceanVolume_Resize(t *testing.T) {
 	var (
 		volume  = godo.Volume{Name: acceptance.RandomTestName()}
@@ -38,7 +38,7 @@ func testSweepVolumes(region string) error {
 	}
 
 	for _, v := range volumes {
-		if strings.HasPrefix(v.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(v.Name, sweep.NamePrefix()) {
 
 			if len(v.DropletIDs) > 0 {
 				log.Printf("Detaching volume %v from Droplet %v", v.ID, v.DropletIDs[0])
@@ -48,7 +48,7 @@ func testSweepVolumes(region string) error {
 					return fmt.Errorf("Error resizing volume (%s): %s", v.ID, err)
 				}
 
-				if err = util.WaitForAction(client, action); err != nil {
+				if err = util.WaitForAction(context.Background(), client, action); err != nil {
 					return fmt.Errorf(
 						"Error waiting for volume (%s): %s", v.ID, err)
 				}
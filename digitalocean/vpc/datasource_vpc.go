@@ -65,14 +65,14 @@ func dataSourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData,
 	var foundVPC *godo.VPC
 
 	if id, ok := d.GetOk("id"); ok {
-		vpc, _, err := client.VPCs.Get(context.Background(), id.(string))
+		vpc, _, err := client.VPCs.Get(ctx, id.(string))
 		if err != nil {
 			return diag.Errorf("Error retrieving VPC: %s", err)
 		}
 
 		foundVPC = vpc
 	} else if slug, ok := d.GetOk("region"); ok {
-		vpcs, err := listVPCs(client)
+		vpcs, err := listVPCs(ctx, client)
 		if err != nil {
 			return diag.Errorf("Error retrieving VPC: %s", err)
 		}
@@ -84,7 +84,7 @@ func dataSourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData,
 
 		foundVPC = vpc
 	} else if name, ok := d.GetOk("name"); ok {
-		vpcs, err := listVPCs(client)
+		vpcs, err := listVPCs(ctx, client)
 		if err != nil {
 			return diag.Errorf("Error retrieving VPC: %s", err)
 		}
@@ -109,7 +109,7 @@ func dataSourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func listVPCs(client *godo.Client) ([]*godo.VPC, error) {
+func listVPCs(ctx context.Context, client *godo.Client) ([]*godo.VPC, error) {
 	vpcList := []*godo.VPC{}
 	opts := &godo.ListOptions{
 		Page:    1,
@@ -117,7 +117,7 @@ func listVPCs(client *godo.Client) ([]*godo.VPC, error) {
 	}
 
 	for {
-		vpcs, resp, err := client.VPCs.List(context.Background(), opts)
+		vpcs, resp, err := client.VPCs.List(ctx, opts)
 
 		if err != nil {
 			return vpcList, fmt.Errorf("Error retrieving VPCs: %s", err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -86,7 +87,7 @@ func resourceDigitalOceanVPCCreate(ctx context.Context, d *schema.ResourceData,
 
 	region := d.Get("region").(string)
 	vpcRequest := &godo.VPCCreateRequest{
-		Name:       d.Get("name").(string),
+		Name:       meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 		RegionSlug: region,
 	}
 
@@ -105,7 +106,7 @@ func resourceDigitalOceanVPCCreate(ctx context.Context, d *schema.ResourceData,
 	defer mutexKV.Unlock(key)
 
 	log.Printf("[DEBUG] VPC create request: %#v", vpcRequest)
-	vpc, _, err := client.VPCs.Create(context.Background(), vpcRequest)
+	vpc, _, err := client.VPCs.Create(ctx, vpcRequest)
 	if err != nil {
 		return diag.Errorf("Error creating VPC: %s", err)
 	}
@@ -119,7 +120,7 @@ func resourceDigitalOceanVPCCreate(ctx context.Context, d *schema.ResourceData,
 func resourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	vpc, resp, err := client.VPCs.Get(context.Background(), d.Id())
+	vpc, resp, err := client.VPCs.Get(ctx, d.Id())
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -131,7 +132,7 @@ func resourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.SetId(vpc.ID)
-	d.Set("name", vpc.Name)
+	d.Set("name", meta.(*config.CombinedConfig).UnprefixName(vpc.Name))
 	d.Set("region", vpc.RegionSlug)
 	d.Set("description", vpc.Description)
 	d.Set("ip_range", vpc.IPRange)
@@ -147,11 +148,11 @@ func resourceDigitalOceanVPCUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChanges("name", "description") {
 		vpcUpdateRequest := &godo.VPCUpdateRequest{
-			Name:        d.Get("name").(string),
+			Name:        meta.(*config.CombinedConfig).PrefixName(d.Get("name").(string)),
 			Description: d.Get("description").(string),
 			Default:     godo.Bool(d.Get("default").(bool)),
 		}
-		_, _, err := client.VPCs.Update(context.Background(), d.Id(), vpcUpdateRequest)
+		_, _, err := client.VPCs.Update(ctx, d.Id(), vpcUpdateRequest)
 
 		if err != nil {
 			return diag.Errorf("Error updating VPC : %s", err)
@@ -166,8 +167,8 @@ func resourceDigitalOceanVPCDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*config.CombinedConfig).GodoClient()
 	vpcID := d.Id()
 
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		resp, err := client.VPCs.Delete(context.Background(), vpcID)
+	err := util.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		resp, err := client.VPCs.Delete(ctx, vpcID)
 		if err != nil {
 			// Retry if VPC still contains member resources to prevent race condition
 			if resp.StatusCode == http.StatusForbidden {
@@ -38,7 +38,7 @@ func sweepVPC(region string) error {
 	}
 
 	for _, v := range vpcs {
-		if strings.HasPrefix(v.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(v.Name, sweep.NamePrefix()) {
 			log.Printf("[DEBUG] Destroying VPC %s", v.Name)
 			resp, err := client.VPCs.Delete(context.Background(), v.ID)
 			if err != nil {
@@ -58,14 +58,14 @@ func dataSourceDigitalOceanVPCPeeringRead(ctx context.Context, d *schema.Resourc
 	var foundVPCPeering *godo.VPCPeering
 
 	if id, ok := d.GetOk("id"); ok {
-		vpcPeering, _, err := client.VPCs.GetVPCPeering(context.Background(), id.(string))
+		vpcPeering, _, err := client.VPCs.GetVPCPeering(ctx, id.(string))
 		if err != nil {
 			return diag.Errorf("Error retrieving VPC Peering: %s", err)
 		}
 
 		foundVPCPeering = vpcPeering
 	} else if name, ok := d.GetOk("name"); ok {
-		vpcPeerings, err := listVPCPeerings(client)
+		vpcPeerings, err := listVPCPeerings(ctx, client)
 		if err != nil {
 			return diag.Errorf("Error retrieving VPC Peering: %s", err)
 		}
@@ -91,7 +91,7 @@ func dataSourceDigitalOceanVPCPeeringRead(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
-func listVPCPeerings(client *godo.Client) ([]*godo.VPCPeering, error) {
+func listVPCPeerings(ctx context.Context, client *godo.Client) ([]*godo.VPCPeering, error) {
 	peeringsList := []*godo.VPCPeering{}
 	opts := &godo.ListOptions{
 		Page:    1,
@@ -99,7 +99,7 @@ func listVPCPeerings(client *godo.Client) ([]*godo.VPCPeering, error) {
 	}
 
 	for {
-		peerings, resp, err := client.VPCs.ListVPCPeerings(context.Background(), opts)
+		peerings, resp, err := client.VPCs.ListVPCPeerings(ctx, opts)
 
 		if err != nil {
 			return peeringsList, fmt.Errorf("error retrieving VPC Peerings: %s", err)
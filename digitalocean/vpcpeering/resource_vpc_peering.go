@@ -9,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -84,8 +85,8 @@ func resourceDigitalOceanVPCPeeringCreate(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] VPC Peering create request: %#v", vpcPeeringRequest)
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		vpcPeering, _, err := client.VPCs.CreateVPCPeering(context.Background(), vpcPeeringRequest)
+	err := util.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		vpcPeering, _, err := client.VPCs.CreateVPCPeering(ctx, vpcPeeringRequest)
 		if err != nil {
 			return retry.NonRetryableError(fmt.Errorf("error creating VPC Peering: %s", err))
 		}
@@ -97,7 +98,7 @@ func resourceDigitalOceanVPCPeeringCreate(ctx context.Context, d *schema.Resourc
 			Delay:      5 * time.Second,
 			Pending:    []string{"PROVISIONING"},
 			Target:     []string{"ACTIVE"},
-			Refresh:    vpcPeeringStateRefreshFunc(client, d.Id()),
+			Refresh:    vpcPeeringStateRefreshFunc(ctx, client, d.Id()),
 			Timeout:    2 * time.Minute,
 			MinTimeout: 5 * time.Second,
 		}
@@ -125,7 +126,7 @@ func resourceDigitalOceanVPCPeeringUpdate(ctx context.Context, d *schema.Resourc
 			Name: d.Get("name").(string),
 		}
 
-		_, _, err := client.VPCs.UpdateVPCPeering(context.Background(), d.Id(), vpcPeeringUpdateRequest)
+		_, _, err := client.VPCs.UpdateVPCPeering(ctx, d.Id(), vpcPeeringUpdateRequest)
 		if err != nil {
 			return diag.Errorf("Error updating VPC Peering: %s", err)
 		}
@@ -139,8 +140,8 @@ func resourceDigitalOceanVPCPeeringDelete(ctx context.Context, d *schema.Resourc
 	client := meta.(*config.CombinedConfig).GodoClient()
 	vpcPeeringID := d.Id()
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
-		resp, err := client.VPCs.DeleteVPCPeering(context.Background(), vpcPeeringID)
+	err := util.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		resp, err := client.VPCs.DeleteVPCPeering(ctx, vpcPeeringID)
 		if err != nil {
 			if resp != nil && resp.StatusCode == http.StatusForbidden {
 				return retry.RetryableError(err)
@@ -153,7 +154,7 @@ func resourceDigitalOceanVPCPeeringDelete(ctx context.Context, d *schema.Resourc
 			Delay:      5 * time.Second,
 			Pending:    []string{"DELETING"},
 			Target:     []string{http.StatusText(http.StatusNotFound)},
-			Refresh:    vpcPeeringStateRefreshFunc(client, d.Id()),
+			Refresh:    vpcPeeringStateRefreshFunc(ctx, client, d.Id()),
 			Timeout:    2 * time.Minute,
 			MinTimeout: 5 * time.Second,
 		}
@@ -176,7 +177,7 @@ func resourceDigitalOceanVPCPeeringDelete(ctx context.Context, d *schema.Resourc
 func resourceDigitalOceanVPCPeeringRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	vpcPeering, resp, err := client.VPCs.GetVPCPeering(context.Background(), d.Id())
+	vpcPeering, resp, err := client.VPCs.GetVPCPeering(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[DEBUG] VPC Peering (%s) was not found - removing from state", d.Id())
@@ -195,9 +196,9 @@ func resourceDigitalOceanVPCPeeringRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func vpcPeeringStateRefreshFunc(client *godo.Client, vpcPeeringId string) retry.StateRefreshFunc {
+func vpcPeeringStateRefreshFunc(ctx context.Context, client *godo.Client, vpcPeeringId string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		vpcPeering, resp, err := client.VPCs.GetVPCPeering(context.Background(), vpcPeeringId)
+		vpcPeering, resp, err := client.VPCs.GetVPCPeering(ctx, vpcPeeringId)
 		if err != nil {
 			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return vpcPeering, http.StatusText(resp.StatusCode), nil
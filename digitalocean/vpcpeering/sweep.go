@@ -38,7 +38,7 @@ func sweepVPCPeering(region string) error {
 	}
 
 	for _, v := range vpcPeerings {
-		if strings.HasPrefix(v.Name, sweep.TestNamePrefix) {
+		if strings.HasPrefix(v.Name, sweep.NamePrefix()) {
 			log.Printf("[DEBUG] Destroying VPC Peering %s", v.Name)
 			resp, err := client.VPCs.DeleteVPCPeering(ctx, v.ID)
 			if err != nil {
@@ -52,7 +52,7 @@ func sweepVPCPeering(region string) error {
 			stateConf := &retry.StateChangeConf{
 				Pending:    []string{"DELETING"},
 				Target:     []string{http.StatusText(http.StatusNotFound)},
-				Refresh:    vpcPeeringStateRefreshFunc(client, v.ID),
+				Refresh:    vpcPeeringStateRefreshFunc(context.Background(), client, v.ID),
 				Timeout:    10 * time.Minute,
 				MinTimeout: 2 * time.Second,
 			}
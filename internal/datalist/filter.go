@@ -14,8 +14,14 @@ type commonFilter struct {
 	values  []interface{}
 	all     bool
 	matchBy string
+	// exclude inverts the filter, selecting the records not matching it.
+	exclude bool
 }
 
+// filterValueKeys are the attributes of a filter which hold its values. Each
+// filter sets exactly one of them.
+var filterValueKeys = []string{"values", "all_of", "any_of", "none_of"}
+
 func filterSchema(allowedKeys []string) *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeSet,
@@ -28,9 +34,27 @@ func filterSchema(allowedKeys []string) *schema.Schema {
 				},
 				"values": {
 					Type:     schema.TypeList,
-					Required: true,
+					Optional: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
+				"all_of": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Selects the records whose field matches all of these values",
+				},
+				"any_of": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Selects the records whose field matches one or more of these values",
+				},
+				"none_of": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Selects the records whose field matches none of these values",
+				},
 				"all": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -66,7 +90,20 @@ func expandFilters(recordSchema map[string]*schema.Schema, rawFilters []interfac
 			matchBy = v
 		}
 
-		expandedFilterValues, err := expandFilterValues(f["values"].([]interface{}), s, matchBy)
+		valuesKey := ""
+		for _, k := range filterValueKeys {
+			if v, ok := f[k].([]interface{}); ok && len(v) > 0 {
+				if valuesKey != "" {
+					return nil, fmt.Errorf("filter on '%s' must set only one of values, all_of, any_of, or none_of", key)
+				}
+				valuesKey = k
+			}
+		}
+		if valuesKey == "" {
+			return nil, fmt.Errorf("filter on '%s' must set one of values, all_of, any_of, or none_of", key)
+		}
+
+		expandedFilterValues, err := expandFilterValues(f[valuesKey].([]interface{}), s, matchBy)
 		if err != nil {
 			return nil, err
 		}
@@ -83,6 +120,16 @@ func expandFilters(recordSchema map[string]*schema.Schema, rawFilters []interfac
 			matchBy: matchBy,
 		}
 
+		switch valuesKey {
+		case "all_of":
+			expandedFilter.all = true
+		case "any_of":
+			expandedFilter.all = false
+		case "none_of":
+			expandedFilter.all = false
+			expandedFilter.exclude = true
+		}
+
 		expandedFilters[i] = expandedFilter
 	}
 
@@ -212,7 +259,7 @@ func applyFilters(recordSchema map[string]*schema.Schema, records []map[string]i
 				}
 			}
 
-			return result
+			return result != f.exclude
 		}
 
 		for _, record := range records {
@@ -51,6 +51,57 @@ func TestExpandFilters(t *testing.T) {
 	}
 }
 
+func TestExpandFiltersValueKeys(t *testing.T) {
+	recordSchema := map[string]*schema.Schema{
+		"tags": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		raw     map[string]interface{}
+		all     bool
+		exclude bool
+	}{
+		{"AllOf", map[string]interface{}{"all_of": []interface{}{"web", "prod"}}, true, false},
+		{"AnyOf", map[string]interface{}{"any_of": []interface{}{"web", "prod"}, "all": true}, false, false},
+		{"NoneOf", map[string]interface{}{"none_of": []interface{}{"web", "prod"}}, false, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.raw["key"] = "tags"
+			filters, err := expandFilters(recordSchema, []interface{}{testCase.raw})
+			if err != nil {
+				t.Fatalf("expandFilters returned error: %s", err)
+			}
+
+			assert.Equal(t, []interface{}{"web", "prod"}, filters[0].values)
+			assert.Equal(t, testCase.all, filters[0].all)
+			assert.Equal(t, testCase.exclude, filters[0].exclude)
+		})
+	}
+
+	_, err := expandFilters(recordSchema, []interface{}{
+		map[string]interface{}{
+			"key":     "tags",
+			"values":  []interface{}{"web"},
+			"none_of": []interface{}{"canary"},
+		},
+	})
+	assert.EqualError(t, err, "filter on 'tags' must set only one of values, all_of, any_of, or none_of")
+
+	_, err = expandFilters(recordSchema, []interface{}{
+		map[string]interface{}{
+			"key":    "tags",
+			"values": []interface{}{},
+		},
+	})
+	assert.EqualError(t, err, "filter on 'tags' must set one of values, all_of, any_of, or none_of")
+}
+
 func sizesTestSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"slug": {
@@ -153,6 +204,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -163,6 +215,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{1024, 8192},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb", "m-1vcpu-8gb"},
 		},
@@ -173,6 +226,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{1, 4},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb", "m-1vcpu-8gb"},
 		},
@@ -183,6 +237,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{25, 160},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -193,6 +248,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{1.0, 5.0},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -203,6 +259,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{5.0, 40.0},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -213,6 +270,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{0.00744, 0.05952},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb", "m-1vcpu-8gb"},
 		},
@@ -223,6 +281,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"sgp1", "ams2"},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -233,6 +292,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"sgp1", "ams2"},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -243,6 +303,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{true},
 				false,
 				"exact",
+				false,
 			},
 			[]string{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 		},
@@ -253,6 +314,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"nyc1", "ams1"},
 				true,
 				"exact",
+				false,
 			},
 			[]string{"m-1vcpu-8gb"},
 		},
@@ -263,6 +325,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"s-1vcpu-1gb", "s-4vcpu-8gb"},
 				true,
 				"exact",
+				false,
 			},
 			nil,
 		},
@@ -273,6 +336,7 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{regexp.MustCompile("8gb$")},
 				false,
 				"re",
+				false,
 			},
 			[]string{"s-4vcpu-8gb", "m-1vcpu-8gb"},
 		},
@@ -283,9 +347,21 @@ func TestApplyFilters(t *testing.T) {
 				[]interface{}{"nyc"},
 				false,
 				"substring",
+				false,
 			},
 			[]string{"s-2vcpu-2gb", "m-1vcpu-8gb"},
 		},
+		{
+			"ExcludeByRegionsSet",
+			commonFilter{
+				"regions_set",
+				[]interface{}{"nyc1", "sgp2"},
+				false,
+				"exact",
+				true,
+			},
+			[]string{"s-4vcpu-8gb"},
+		},
 	}
 
 	for _, testCase := range testCases {
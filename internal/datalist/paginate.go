@@ -0,0 +1,44 @@
+package datalist
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func paginationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of results to return after filtering and sorting",
+		},
+		"offset": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of filtered and sorted results to skip before returning results",
+		},
+		"total_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total number of results matching the filter criteria before applying limit and offset",
+		},
+	}
+}
+
+// applyPagination returns the slice of records starting at offset and
+// containing at most limit records. A limit of zero means no limit.
+func applyPagination(records []map[string]interface{}, offset int, limit int) []map[string]interface{} {
+	if offset >= len(records) {
+		return []map[string]interface{}{}
+	}
+
+	records = records[offset:]
+	if limit > 0 && limit < len(records) {
+		records = records[:limit]
+	}
+
+	return records
+}
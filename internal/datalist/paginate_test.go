@@ -0,0 +1,42 @@
+package datalist
+
+import (
+	"testing"
+)
+
+func TestApplyPagination(t *testing.T) {
+	records := []map[string]interface{}{
+		{"slug": "a"},
+		{"slug": "b"},
+		{"slug": "c"},
+		{"slug": "d"},
+	}
+
+	testCases := []struct {
+		name     string
+		offset   int
+		limit    int
+		expected []string
+	}{
+		{"no limit or offset", 0, 0, []string{"a", "b", "c", "d"}},
+		{"limit only", 0, 2, []string{"a", "b"}},
+		{"offset only", 1, 0, []string{"b", "c", "d"}},
+		{"limit and offset", 1, 2, []string{"b", "c"}},
+		{"limit past end", 2, 10, []string{"c", "d"}},
+		{"offset past end", 4, 2, []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := applyPagination(records, testCase.offset, testCase.limit)
+			if len(actual) != len(testCase.expected) {
+				t.Fatalf("expected %d records, got %d", len(testCase.expected), len(actual))
+			}
+			for i, record := range actual {
+				if record["slug"] != testCase.expected[i] {
+					t.Errorf("expected record %d to be %s, got %s", i, testCase.expected[i], record["slug"])
+				}
+			}
+		})
+	}
+}
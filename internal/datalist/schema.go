@@ -2,8 +2,10 @@ package datalist
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +32,35 @@ type ResourceConfig struct {
 
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
 	ExtraQuerySchema map[string]*schema.Schema
+
+	// Whether to expose `limit` and `offset` arguments for selecting a slice of
+	// the filtered and sorted results along with a computed `total_count`.
+	Paginated bool
+
+	// Computed attributes aggregating all of the filtered records, such as
+	// totals, set from the values returned by Summarize.
+	SummarySchema map[string]*schema.Schema
+
+	// Given the flattened records matching the filters, and before any
+	// pagination, return the values of the attributes of SummarySchema.
+	Summarize func(records []map[string]interface{}, extra map[string]interface{}) (map[string]interface{}, error)
+}
+
+// PartialResultError may be returned by GetRecords along with the records
+// which could be retrieved when others could not be, e.g. because a regional
+// endpoint is unavailable. The data list is built from the partial results and
+// each of the errors is reported as a warning.
+type PartialResultError struct {
+	Errors []error
+}
+
+func (e *PartialResultError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return fmt.Sprintf("unable to load some records: %s", strings.Join(messages, "; "))
 }
 
 // Returns a new "data list" resource given the specified configuration. This
@@ -68,10 +99,20 @@ func NewResource(config *ResourceConfig) *schema.Resource {
 		},
 	}
 
+	if config.Paginated {
+		for key, value := range paginationSchema() {
+			datasourceSchema[key] = value
+		}
+	}
+
 	for key, value := range config.ExtraQuerySchema {
 		datasourceSchema[key] = value
 	}
 
+	for key, value := range config.SummarySchema {
+		datasourceSchema[key] = value
+	}
+
 	return &schema.Resource{
 		ReadContext: dataListResourceRead(config),
 		Schema:      datasourceSchema,
@@ -85,9 +126,21 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			extra[key] = d.Get(key)
 		}
 
+		var diags diag.Diagnostics
 		records, err := config.GetRecords(meta, extra)
 		if err != nil {
-			return diag.Errorf("Unable to load records: %s", err)
+			var partialErr *PartialResultError
+			if !errors.As(err, &partialErr) {
+				return diag.Errorf("Unable to load records: %s", err)
+			}
+
+			for _, e := range partialErr.Errors {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Unable to load some records",
+					Detail:   e.Error(),
+				})
+			}
 		}
 
 		flattenedRecords := make([]map[string]interface{}, len(records))
@@ -112,6 +165,25 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			flattenedRecords = applySorts(config.RecordSchema, flattenedRecords, sorts)
 		}
 
+		if config.Summarize != nil {
+			summary, err := config.Summarize(flattenedRecords, extra)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for key, value := range summary {
+				if err := d.Set(key, value); err != nil {
+					return diag.Errorf("unable to set `%s` attribute: %s", key, err)
+				}
+			}
+		}
+
+		if config.Paginated {
+			if err := d.Set("total_count", len(flattenedRecords)); err != nil {
+				return diag.Errorf("unable to set `total_count` attribute: %s", err)
+			}
+			flattenedRecords = applyPagination(flattenedRecords, d.Get("offset").(int), d.Get("limit").(int))
+		}
+
 		hash, err := hashstructure.Hash(records, hashstructure.FormatV2, nil)
 		if err != nil {
 			diag.Errorf("unable to set `%s` attribute: %s", config.ResultAttributeName, err)
@@ -123,7 +195,7 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			return diag.Errorf("unable to set `%s` attribute: %s", config.ResultAttributeName, err)
 		}
 
-		return nil
+		return diags
 	}
 }
 
@@ -167,5 +239,10 @@ func validateResourceConfig(config *ResourceConfig) error {
 		return fmt.Errorf("ResultAttributeName must be specified")
 	}
 
+	// Ensure that summary attributes are computed by Summarize.
+	if (len(config.SummarySchema) > 0) != (config.Summarize != nil) {
+		return fmt.Errorf("SummarySchema and Summarize must be specified together")
+	}
+
 	return nil
 }
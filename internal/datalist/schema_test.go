@@ -0,0 +1,127 @@
+package datalist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataListResourceRead_PartialResult(t *testing.T) {
+	config := &ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"name": {
+				Type: schema.TypeString,
+			},
+		},
+		ResultAttributeName: "records",
+		FlattenRecord: func(record, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"name": record.(string)}, nil
+		},
+		GetRecords: func(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+			return []interface{}{"a", "b"}, &PartialResultError{Errors: []error{errors.New("region unavailable")}}
+		},
+	}
+
+	r := NewResource(config)
+	d := r.TestResourceData()
+
+	diags := r.ReadContext(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Detail != "region unavailable" {
+		t.Fatalf("expected a single warning for the partial result, got: %v", diags)
+	}
+
+	if records := d.Get("records").([]interface{}); len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+}
+
+func TestDataListResourceRead_Error(t *testing.T) {
+	config := &ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"name": {
+				Type: schema.TypeString,
+			},
+		},
+		ResultAttributeName: "records",
+		FlattenRecord: func(record, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"name": record.(string)}, nil
+		},
+		GetRecords: func(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+			return nil, errors.New("unavailable")
+		},
+	}
+
+	r := NewResource(config)
+	diags := r.ReadContext(context.Background(), r.TestResourceData(), nil)
+	if !diags.HasError() {
+		t.Fatalf("expected an error, got: %v", diags)
+	}
+}
+
+func TestDataListResourceRead_Summary(t *testing.T) {
+	config := &ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"name": {
+				Type: schema.TypeString,
+			},
+			"size": {
+				Type: schema.TypeInt,
+			},
+		},
+		ResultAttributeName: "records",
+		FlattenRecord: func(record, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+			return record.(map[string]interface{}), nil
+		},
+		GetRecords: func(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+			return []interface{}{
+				map[string]interface{}{"name": "a", "size": 1},
+				map[string]interface{}{"name": "b", "size": 2},
+				map[string]interface{}{"name": "c", "size": 4},
+			}, nil
+		},
+		Paginated: true,
+		SummarySchema: map[string]*schema.Schema{
+			"total_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Summarize: func(records []map[string]interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+			total := 0
+			for _, record := range records {
+				total += record["size"].(int)
+			}
+			return map[string]interface{}{"total_size": total}, nil
+		},
+	}
+
+	r := NewResource(config)
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{
+				"key":    "name",
+				"values": []interface{}{"b", "c"},
+			},
+		},
+		"limit": 1,
+	})
+
+	if diags := r.ReadContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The summary covers all the filtered records, not only the returned page.
+	if total := d.Get("total_size").(int); total != 6 {
+		t.Fatalf("expected a total size of 6, got %d", total)
+	}
+	if records := d.Get("records").([]interface{}); len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+}
@@ -0,0 +1,497 @@
+// Package mockapi provides an in-memory stand-in for the DigitalOcean API
+// which allows a subset of the acceptance tests to run without an account.
+//
+// Stateful handlers are implemented for tags, SSH keys, domains and their
+// records, and VPCs. Read-only endpoints such as the account, regions, and
+// sizes are served from recorded fixtures.
+package mockapi
+
+import (
+	"crypto/md5"
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Server is an httptest.Server emulating the DigitalOcean API.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	nextID  int
+	tags    map[string]*godo.Tag
+	keys    map[int]*godo.Key
+	domains map[string]*godo.Domain
+	records map[string]map[int]*godo.DomainRecord
+	vpcs    map[string]*godo.VPC
+}
+
+// NewServer starts and returns a new mock API server. The caller should call
+// Close when finished to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		nextID:  1000,
+		tags:    make(map[string]*godo.Tag),
+		keys:    make(map[int]*godo.Key),
+		domains: make(map[string]*godo.Domain),
+		records: make(map[string]map[int]*godo.DomainRecord),
+		vpcs:    make(map[string]*godo.VPC),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v2"), "/"), "/")
+
+	switch segments[0] {
+	case "account":
+		if len(segments) > 1 && segments[1] == "keys" {
+			s.handleKeys(w, r, segments[2:])
+			return
+		}
+		s.handleFixture(w, r, segments, "account.json")
+	case "regions":
+		s.handleFixture(w, r, segments, "regions.json")
+	case "sizes":
+		s.handleFixture(w, r, segments, "sizes.json")
+	case "tags":
+		s.handleTags(w, r, segments[1:])
+	case "domains":
+		s.handleDomains(w, r, segments[1:])
+	case "vpcs":
+		s.handleVPCs(w, r, segments[1:])
+	case "droplets", "images", "volumes", "snapshots", "databases", "load_balancers":
+		// Resources which are not emulated are always empty so that resources
+		// depending on them, e.g. the tag data sources, can be read.
+		if r.Method == http.MethodGet && len(segments) == 1 {
+			writeList(w, segments[0], []interface{}{}, 0)
+			return
+		}
+		writeNotFound(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleFixture(w http.ResponseWriter, r *http.Request, segments []string, name string) {
+	if r.Method != http.MethodGet || len(segments) != 1 {
+		writeNotFound(w)
+		return
+	}
+
+	body, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func (s *Server) handleTags(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		tags := make([]*godo.Tag, 0, len(s.tags))
+		for _, t := range s.tags {
+			tags = append(tags, t)
+		}
+		sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+		writeList(w, "tags", tags, len(tags))
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		req := new(godo.TagCreateRequest)
+		if !readJSON(w, r, req) {
+			return
+		}
+		tag, ok := s.tags[req.Name]
+		if !ok {
+			tag = &godo.Tag{
+				Name: req.Name,
+				Resources: &godo.TaggedResources{
+					Droplets:        &godo.TaggedDropletsResources{},
+					Images:          &godo.TaggedImagesResources{},
+					Volumes:         &godo.TaggedVolumesResources{},
+					VolumeSnapshots: &godo.TaggedVolumeSnapshotsResources{},
+					Databases:       &godo.TaggedDatabasesResources{},
+				},
+			}
+			s.tags[req.Name] = tag
+		}
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"tag": tag})
+	case len(segments) == 1:
+		tag, ok := s.tags[segments[0]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"tag": tag})
+		case http.MethodDelete:
+			delete(s.tags, tag.Name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeNotFound(w)
+		}
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		keys := make([]*godo.Key, 0, len(s.keys))
+		for _, k := range s.keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+		writeList(w, "ssh_keys", keys, len(keys))
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		req := new(godo.KeyCreateRequest)
+		if !readJSON(w, r, req) {
+			return
+		}
+		fingerprint, err := fingerprintPublicKey(req.PublicKey)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "Key invalid type, we support 'ssh-rsa', 'ssh-dss', 'ecdsa-sha2-nistp' or 'ssh-ed25519'")
+			return
+		}
+		for _, k := range s.keys {
+			if k.Fingerprint == fingerprint {
+				writeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "SSH Key is already in use on your account")
+				return
+			}
+		}
+		key := &godo.Key{
+			ID:          s.newID(),
+			Name:        req.Name,
+			Fingerprint: fingerprint,
+			PublicKey:   req.PublicKey,
+		}
+		s.keys[key.ID] = key
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"ssh_key": key})
+	case len(segments) == 1:
+		var key *godo.Key
+		for _, k := range s.keys {
+			if strconv.Itoa(k.ID) == segments[0] || k.Fingerprint == segments[0] {
+				key = k
+			}
+		}
+		if key == nil {
+			writeNotFound(w)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"ssh_key": key})
+		case http.MethodPut:
+			req := new(godo.KeyUpdateRequest)
+			if !readJSON(w, r, req) {
+				return
+			}
+			key.Name = req.Name
+			writeJSON(w, http.StatusOK, map[string]interface{}{"ssh_key": key})
+		case http.MethodDelete:
+			delete(s.keys, key.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeNotFound(w)
+		}
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleDomains(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		domains := make([]*godo.Domain, 0, len(s.domains))
+		for _, d := range s.domains {
+			domains = append(domains, d)
+		}
+		sort.Slice(domains, func(i, j int) bool { return domains[i].Name < domains[j].Name })
+		writeList(w, "domains", domains, len(domains))
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		req := new(godo.DomainCreateRequest)
+		if !readJSON(w, r, req) {
+			return
+		}
+		if _, ok := s.domains[req.Name]; ok {
+			writeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "Name already exists")
+			return
+		}
+		domain := &godo.Domain{Name: req.Name, TTL: 1800}
+		s.domains[req.Name] = domain
+		s.records[req.Name] = make(map[int]*godo.DomainRecord)
+		for _, ns := range []string{"ns1.digitalocean.com", "ns2.digitalocean.com", "ns3.digitalocean.com"} {
+			s.addRecord(req.Name, &godo.DomainRecord{Type: "NS", Name: "@", Data: ns, TTL: 1800})
+		}
+		s.addRecord(req.Name, &godo.DomainRecord{Type: "SOA", Name: "@", Data: "1800", TTL: 1800})
+		if req.IPAddress != "" {
+			s.addRecord(req.Name, &godo.DomainRecord{Type: "A", Name: "@", Data: req.IPAddress, TTL: 1800})
+		}
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"domain": domain})
+	case len(segments) >= 1:
+		domain, ok := s.domains[segments[0]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		if len(segments) > 1 && segments[1] == "records" {
+			s.handleRecords(w, r, domain.Name, segments[2:])
+			return
+		}
+		if len(segments) != 1 {
+			writeNotFound(w)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain": domain})
+		case http.MethodDelete:
+			delete(s.domains, domain.Name)
+			delete(s.records, domain.Name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeNotFound(w)
+		}
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleRecords(w http.ResponseWriter, r *http.Request, domain string, segments []string) {
+	records := s.records[domain]
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		recordType := r.URL.Query().Get("type")
+		recordName := r.URL.Query().Get("name")
+		list := make([]*godo.DomainRecord, 0, len(records))
+		for _, rec := range records {
+			if recordType != "" && rec.Type != recordType {
+				continue
+			}
+			if recordName != "" && recordFQDN(rec.Name, domain) != recordName {
+				continue
+			}
+			list = append(list, rec)
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+		writeList(w, "domain_records", list, len(list))
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		req := new(godo.DomainRecordEditRequest)
+		if !readJSON(w, r, req) {
+			return
+		}
+		record := recordFromRequest(req)
+		if record.TTL == 0 {
+			record.TTL = 1800
+		}
+		s.addRecord(domain, record)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"domain_record": record})
+	case len(segments) == 1:
+		id, _ := strconv.Atoi(segments[0])
+		record, ok := records[id]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain_record": record})
+		case http.MethodPut, http.MethodPatch:
+			req := new(godo.DomainRecordEditRequest)
+			if !readJSON(w, r, req) {
+				return
+			}
+			updated := recordFromRequest(req)
+			updated.ID = record.ID
+			if updated.Type == "" {
+				updated.Type = record.Type
+			}
+			if updated.TTL == 0 {
+				updated.TTL = record.TTL
+			}
+			records[id] = updated
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain_record": updated})
+		case http.MethodDelete:
+			delete(records, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeNotFound(w)
+		}
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) addRecord(domain string, record *godo.DomainRecord) {
+	record.ID = s.newID()
+	s.records[domain][record.ID] = record
+}
+
+func (s *Server) handleVPCs(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		vpcs := make([]*godo.VPC, 0, len(s.vpcs))
+		for _, v := range s.vpcs {
+			vpcs = append(vpcs, v)
+		}
+		sort.Slice(vpcs, func(i, j int) bool { return vpcs[i].CreatedAt.Before(vpcs[j].CreatedAt) })
+		writeList(w, "vpcs", vpcs, len(vpcs))
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		req := new(godo.VPCCreateRequest)
+		if !readJSON(w, r, req) {
+			return
+		}
+		id := s.newID()
+		vpc := &godo.VPC{
+			ID:          fmt.Sprintf("00000000-0000-4000-8000-%012d", id),
+			Name:        req.Name,
+			Description: req.Description,
+			IPRange:     req.IPRange,
+			RegionSlug:  req.RegionSlug,
+			CreatedAt:   time.Now().UTC(),
+		}
+		if vpc.IPRange == "" {
+			vpc.IPRange = fmt.Sprintf("10.%d.%d.0/20", 100+(id/16)%100, (id%16)*16)
+		}
+		vpc.URN = "do:vpc:" + vpc.ID
+		s.vpcs[vpc.ID] = vpc
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"vpc": vpc})
+	case len(segments) == 1:
+		vpc, ok := s.vpcs[segments[0]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": vpc})
+		case http.MethodPut, http.MethodPatch:
+			req := new(godo.VPCUpdateRequest)
+			if !readJSON(w, r, req) {
+				return
+			}
+			if req.Name != "" {
+				vpc.Name = req.Name
+			}
+			vpc.Description = req.Description
+			if req.Default != nil {
+				vpc.Default = *req.Default
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": vpc})
+		case http.MethodDelete:
+			delete(s.vpcs, vpc.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeNotFound(w)
+		}
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func recordFromRequest(req *godo.DomainRecordEditRequest) *godo.DomainRecord {
+	return &godo.DomainRecord{
+		Type:     req.Type,
+		Name:     req.Name,
+		Data:     req.Data,
+		Priority: req.Priority,
+		Port:     req.Port,
+		TTL:      req.TTL,
+		Weight:   req.Weight,
+		Flags:    req.Flags,
+		Tag:      req.Tag,
+	}
+}
+
+func recordFQDN(name, domain string) string {
+	if name == "@" {
+		return domain
+	}
+
+	return name + "." + domain
+}
+
+// fingerprintPublicKey returns the MD5 fingerprint of an authorized_keys
+// formatted public key as returned by the API.
+func fingerprintPublicKey(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("invalid public key")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(raw)
+	hexes := make([]string, len(sum))
+	for i, b := range sum {
+		hexes[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hexes, ":"), nil
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeList(w http.ResponseWriter, key string, items interface{}, total int) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key:     items,
+		"links": map[string]interface{}{},
+		"meta":  map[string]int{"total": total},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not_found", "The resource you were accessing could not be found.")
+}
+
+func writeError(w http.ResponseWriter, status int, id, message string) {
+	writeJSON(w, status, map[string]string{
+		"id":      id,
+		"message": message,
+	})
+}
@@ -0,0 +1,201 @@
+package mockapi
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+const testPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDDHr/jh2Jy4yALcK4JyWbVkPRaWmhck3IgCoeOO3z1e2dBowLh64QAM+Qb72pxekALga2oi4GvT+TlWNhzPH4V example"
+
+func newTestClient(t *testing.T) (*godo.Client, *Server) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	client := godo.NewClient(nil)
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.BaseURL = baseURL
+
+	return client, server
+}
+
+func TestServer_Fixtures(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Status != "active" {
+		t.Errorf("expected active account, got %q", account.Status)
+	}
+
+	regions, _, err := client.Regions.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) == 0 {
+		t.Error("expected regions fixture to contain regions")
+	}
+
+	sizes, _, err := client.Sizes.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sizes) == 0 {
+		t.Error("expected sizes fixture to contain sizes")
+	}
+}
+
+func TestServer_Tags(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	if _, _, err := client.Tags.Create(ctx, &godo.TagCreateRequest{Name: "foobar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tag, _, err := client.Tags.Get(ctx, "foobar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Resources == nil || tag.Resources.Droplets == nil {
+		t.Fatal("expected tagged resources to be populated")
+	}
+
+	if _, err := client.Tags.Delete(ctx, "foobar"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, resp, err := client.Tags.Get(ctx, "foobar")
+	if err == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestServer_Keys(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	key, _, err := client.Keys.Create(ctx, &godo.KeyCreateRequest{Name: "foobar", PublicKey: testPublicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Fingerprint == "" {
+		t.Error("expected fingerprint to be set")
+	}
+
+	if _, _, err := client.Keys.Create(ctx, &godo.KeyCreateRequest{Name: "duplicate", PublicKey: testPublicKey}); err == nil {
+		t.Error("expected error creating a duplicate key")
+	}
+
+	updated, _, err := client.Keys.UpdateByID(ctx, key.ID, &godo.KeyUpdateRequest{Name: "renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "renamed" {
+		t.Errorf("expected renamed key, got %q", updated.Name)
+	}
+
+	byFingerprint, _, err := client.Keys.GetByFingerprint(ctx, key.Fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byFingerprint.ID != key.ID {
+		t.Errorf("expected key %d, got %d", key.ID, byFingerprint.ID)
+	}
+
+	if _, err := client.Keys.DeleteByID(ctx, key.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_DomainsAndRecords(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	if _, _, err := client.Domains.Create(ctx, &godo.DomainCreateRequest{Name: "example.com", IPAddress: "192.168.0.10"}); err != nil {
+		t.Fatal(err)
+	}
+
+	records, _, err := client.Domains.Records(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 {
+		t.Errorf("expected 5 default records, got %d", len(records))
+	}
+
+	record, _, err := client.Domains.CreateRecord(ctx, "example.com", &godo.DomainRecordEditRequest{Type: "CNAME", Name: "www", Data: "@"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filtered, _, err := client.Domains.RecordsByTypeAndName(ctx, "example.com", "CNAME", "www.example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 1 || filtered[0].ID != record.ID {
+		t.Errorf("expected to find record %d, got %v", record.ID, filtered)
+	}
+
+	edited, _, err := client.Domains.EditRecord(ctx, "example.com", record.ID, &godo.DomainRecordEditRequest{Name: "www", Data: "example.org.", TTL: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Type != "CNAME" || edited.Data != "example.org." || edited.TTL != 60 {
+		t.Errorf("unexpected edited record: %v", edited)
+	}
+
+	if _, err := client.Domains.DeleteRecord(ctx, "example.com", record.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Domains.Delete(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, resp, err := client.Domains.Records(ctx, "example.com", nil)
+	if err == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestServer_VPCs(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	vpc, _, err := client.VPCs.Create(ctx, &godo.VPCCreateRequest{Name: "foobar", RegionSlug: "nyc3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpc.IPRange == "" || vpc.URN == "" {
+		t.Errorf("expected IP range and URN to be set: %v", vpc)
+	}
+
+	updated, _, err := client.VPCs.Update(ctx, vpc.ID, &godo.VPCUpdateRequest{Name: "renamed", Description: "updated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "renamed" || updated.Description != "updated" {
+		t.Errorf("unexpected updated VPC: %v", updated)
+	}
+
+	vpcs, _, err := client.VPCs.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vpcs) != 1 {
+		t.Errorf("expected 1 VPC, got %d", len(vpcs))
+	}
+
+	if _, err := client.VPCs.Delete(ctx, vpc.ID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,442 @@
+// Package telemetry records anonymous spans of the work done by the provider,
+// i.e. resource operations and DigitalOcean API requests, and exports them as
+// OpenTelemetry traces using the OTLP/HTTP JSON encoding.
+//
+// No identifiers, names, or values of resources are recorded; only resource
+// types, the API collection of requests (e.g. "droplets"), status codes,
+// durations, and retry counts.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+)
+
+const (
+	serviceName = "terraform-provider-digitalocean"
+
+	// exportTimeout bounds the time spent sending the spans of an operation,
+	// so that an unavailable endpoint does not slow down applies.
+	exportTimeout = 2 * time.Second
+
+	// shutdownTimeout bounds the time spent sending the summary once the
+	// provider stops serving. Terraform kills providers that have not exited
+	// 2 seconds after being asked to stop.
+	shutdownTimeout = 1 * time.Second
+
+	spanKindInternal = 1
+	spanKindClient   = 3
+	statusCodeError  = 2
+)
+
+var (
+	recordersMu sync.Mutex
+	recorders   []*Recorder
+)
+
+// Recorder records spans and exports them to an OTLP/HTTP endpoint once each
+// operation finishes. All spans of a provider process share a trace, whose
+// root span summarizes the run when Shutdown is called. It is named "apply"
+// if a resource was created, updated, or deleted, and "refresh" if resources
+// and data sources were only read. A nil Recorder records nothing.
+type Recorder struct {
+	endpoint   string
+	httpClient *http.Client
+	traceID    string
+	rootSpanID string
+	start      time.Time
+	attributes []attribute
+
+	mu         sync.Mutex
+	pending    []span
+	operations int64
+	changes    int64
+	failures   int64
+	requests   int64
+	retries    int64
+	apiTime    time.Duration
+	actionTime time.Duration
+
+	// exportMu serializes exports and guards disabled, which is set once an
+	// export fails so that an unavailable endpoint is not retried by every
+	// operation.
+	exportMu sync.Mutex
+	disabled bool
+}
+
+// New returns a Recorder exporting spans to the given endpoint, e.g.
+// http://localhost:4318/v1/traces.
+func New(endpoint, terraformVersion string) *Recorder {
+	r := &Recorder{
+		endpoint:   endpoint,
+		httpClient: &http.Client{},
+		traceID:    randomID(16),
+		rootSpanID: randomID(8),
+		start:      time.Now(),
+		attributes: []attribute{
+			stringAttribute("service.name", serviceName),
+			stringAttribute("terraform.version", terraformVersion),
+		},
+	}
+
+	recordersMu.Lock()
+	recorders = append(recorders, r)
+	recordersMu.Unlock()
+
+	return r
+}
+
+// Shutdown exports the summary of every Recorder that recorded anything,
+// waiting at most a second. It is meant to be called once the provider stops
+// serving.
+func Shutdown() {
+	recordersMu.Lock()
+	rs := recorders
+	recorders = nil
+	recordersMu.Unlock()
+
+	for _, r := range rs {
+		r.shutdown()
+	}
+}
+
+// StartOperation records the start of an operation, e.g. "create", of the
+// resource or data source of the given type. The returned function must be
+// called with whether the operation failed once it finished.
+func (r *Recorder) StartOperation(typeName, operation string) func(failed bool) {
+	if r == nil {
+		return func(bool) {}
+	}
+
+	start := time.Now()
+	return func(failed bool) {
+		s := r.newSpan(typeName+"."+operation, spanKindInternal, start, time.Now(), failed,
+			stringAttribute("terraform.type_name", typeName),
+			stringAttribute("terraform.operation", operation),
+		)
+
+		r.mu.Lock()
+		r.operations++
+		if operation != "read" {
+			r.changes++
+		}
+		if failed {
+			r.failures++
+		}
+		r.pending = append(r.pending, s)
+		r.mu.Unlock()
+
+		r.flush(exportTimeout)
+	}
+}
+
+// Instrument records a span for every request made by the client, counting
+// the attempts made by the retrying transport of godo when it is used. It
+// expects the client returned by godo.New.
+func (r *Recorder) Instrument(client *http.Client) {
+	if r == nil {
+		return
+	}
+
+	if t, ok := client.Transport.(*oauth2.Transport); ok {
+		if rt, ok := t.Base.(*retryablehttp.RoundTripper); ok && rt.Client != nil && rt.Client.HTTPClient != nil {
+			rt.Client.HTTPClient.Transport = &attemptTransport{base: rt.Client.HTTPClient.Transport}
+		}
+		t.Base = &requestTransport{recorder: r, base: t.Base}
+		return
+	}
+
+	client.Transport = &requestTransport{recorder: r, base: client.Transport}
+}
+
+type attemptsKey struct{}
+
+// requestTransport records a span for each request, including its retries.
+type requestTransport struct {
+	recorder *Recorder
+	base     http.RoundTripper
+}
+
+func (t *requestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	attempts := new(int64)
+	req = req.WithContext(context.WithValue(req.Context(), attemptsKey{}, attempts))
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	end := time.Now()
+
+	retries := int64(0)
+	if *attempts > 1 {
+		retries = *attempts - 1
+	}
+
+	collection := APICollection(req.URL.Path)
+	action := strings.Contains(req.URL.Path, "/actions")
+	attrs := []attribute{
+		stringAttribute("http.request.method", req.Method),
+		stringAttribute("digitalocean.api.collection", collection),
+		boolAttribute("digitalocean.api.action", action),
+		intAttribute("http.request.resend_count", retries),
+	}
+
+	failed := err != nil
+	if resp != nil {
+		attrs = append(attrs, intAttribute("http.response.status_code", int64(resp.StatusCode)))
+		failed = failed || resp.StatusCode >= http.StatusBadRequest
+	}
+
+	r := t.recorder
+	s := r.newSpan(req.Method+" "+collection, spanKindClient, start, end, failed, attrs...)
+
+	r.mu.Lock()
+	r.requests++
+	r.retries += retries
+	if action {
+		r.actionTime += end.Sub(start)
+	} else {
+		r.apiTime += end.Sub(start)
+	}
+	r.pending = append(r.pending, s)
+	r.mu.Unlock()
+
+	return resp, err
+}
+
+// attemptTransport counts the attempts made for a request by the retrying
+// transport.
+type attemptTransport struct {
+	base http.RoundTripper
+}
+
+func (t *attemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if attempts, ok := req.Context().Value(attemptsKey{}).(*int64); ok {
+		*attempts++
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// APICollection returns the first segment of the path of a DigitalOcean API
+// request after the version, e.g. "droplets" for /v2/droplets/123/actions.
+// The rest of the path is dropped as it contains identifiers.
+func APICollection(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || segments[1] == "" {
+		return "unknown"
+	}
+	return segments[1]
+}
+
+func (r *Recorder) shutdown() {
+	end := time.Now()
+
+	r.mu.Lock()
+	if r.operations == 0 && r.requests == 0 {
+		// e.g. the provider only validated a configuration.
+		r.mu.Unlock()
+		return
+	}
+
+	name := "refresh"
+	if r.changes > 0 {
+		name = "apply"
+	}
+
+	s := span{
+		TraceID:           r.traceID,
+		SpanID:            r.rootSpanID,
+		Name:              name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(r.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes: []attribute{
+			intAttribute("digitalocean.operations", r.operations),
+			intAttribute("digitalocean.operations.failed", r.failures),
+			intAttribute("digitalocean.api.requests", r.requests),
+			intAttribute("digitalocean.api.retries", r.retries),
+			intAttribute("digitalocean.api.duration_ms", r.apiTime.Milliseconds()),
+			intAttribute("digitalocean.api.action_duration_ms", r.actionTime.Milliseconds()),
+		},
+	}
+	if r.failures > 0 {
+		s.Status = &status{Code: statusCodeError}
+	}
+	r.pending = append(r.pending, s)
+	r.mu.Unlock()
+
+	r.flush(shutdownTimeout)
+}
+
+// flush exports the pending spans, waiting at most the given timeout. The
+// spans are exported before returning, as the provider process may be killed
+// at any time once it has replied to Terraform.
+func (r *Recorder) flush(timeout time.Duration) {
+	r.mu.Lock()
+	spans := r.pending
+	r.pending = nil
+	r.mu.Unlock()
+
+	if len(spans) == 0 {
+		return
+	}
+
+	r.exportMu.Lock()
+	defer r.exportMu.Unlock()
+
+	if r.disabled {
+		return
+	}
+
+	if err := r.export(spans, timeout); err != nil {
+		log.Printf("[WARN] Unable to export telemetry to %s, disabling it: %s", r.endpoint, err)
+		r.disabled = true
+	}
+}
+
+func (r *Recorder) export(spans []span, timeout time.Duration) error {
+	body, err := json.Marshal(exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{Attributes: r.attributes},
+			ScopeSpans: []scopeSpans{{
+				Scope: scope{Name: serviceName},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (r *Recorder) newSpan(name string, kind int, start, end time.Time, failed bool, attrs ...attribute) span {
+	s := span{
+		TraceID:           r.traceID,
+		SpanID:            randomID(8),
+		ParentSpanID:      r.rootSpanID,
+		Name:              name,
+		Kind:              kind,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attrs,
+	}
+	if failed {
+		s.Status = &status{Code: statusCodeError}
+	}
+	return s
+}
+
+func randomID(size int) string {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		log.Printf("[WARN] Unable to generate telemetry span ID: %s", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// The types below follow the OTLP/HTTP JSON encoding of traces, in which
+// 64-bit integers are encoded as strings.
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []attribute `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []attribute `json:"attributes,omitempty"`
+	Status            *status     `json:"status,omitempty"`
+}
+
+type status struct {
+	Code int `json:"code"`
+}
+
+type attribute struct {
+	Key   string         `json:"key"`
+	Value attributeValue `json:"value"`
+}
+
+type attributeValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func stringAttribute(key, value string) attribute {
+	return attribute{Key: key, Value: attributeValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int64) attribute {
+	v := strconv.FormatInt(value, 10)
+	return attribute{Key: key, Value: attributeValue{IntValue: &v}}
+}
+
+func boolAttribute(key string, value bool) attribute {
+	return attribute{Key: key, Value: attributeValue{BoolValue: &value}}
+}
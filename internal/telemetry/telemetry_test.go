@@ -0,0 +1,239 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"golang.org/x/oauth2"
+)
+
+func TestAPICollection(t *testing.T) {
+	cases := map[string]string{
+		"/v2/droplets":                     "droplets",
+		"/v2/droplets/123/actions/456":     "droplets",
+		"/v2/kubernetes/clusters/abc/pool": "kubernetes",
+		"/v2/domains/example.com/records":  "domains",
+		"/v2/":                             "unknown",
+		"/":                                "unknown",
+	}
+
+	for path, expected := range cases {
+		if actual := APICollection(path); actual != expected {
+			t.Errorf("expected %q for %s, got %q", expected, path, actual)
+		}
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		spans []span
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req exportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid export request: %s", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	attempts := 0
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"droplet": {"id": 123}}`))
+	}))
+	defer api.Close()
+
+	client, err := godo.New(
+		oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})),
+		godo.WithRetryAndBackoffs(godo.RetryConfig{
+			RetryMax:     2,
+			RetryWaitMin: godo.PtrTo(0.001),
+			RetryWaitMax: godo.PtrTo(0.001),
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.BaseURL, _ = url.Parse(api.URL)
+
+	r := New(collector.URL, "1.5.0")
+	r.Instrument(client.HTTPClient)
+
+	end := r.StartOperation("digitalocean_droplet", "read")
+	if _, _, err := client.Droplets.Get(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+	end(false)
+
+	mu.Lock()
+	if len(spans) != 2 {
+		t.Errorf("expected the spans of the operation to be exported once it finished, got %v", spans)
+	}
+	mu.Unlock()
+
+	Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	byName := map[string]span{}
+	for _, s := range spans {
+		if s.TraceID != r.traceID {
+			t.Errorf("expected span %s in trace %s, got %s", s.Name, r.traceID, s.TraceID)
+		}
+		byName[s.Name] = s
+	}
+
+	expected := map[string]map[string]string{
+		"GET droplets": {
+			"http.request.method":         "GET",
+			"digitalocean.api.collection": "droplets",
+			"http.request.resend_count":   "1",
+			"http.response.status_code":   "200",
+		},
+		"digitalocean_droplet.read": {
+			"terraform.type_name": "digitalocean_droplet",
+			"terraform.operation": "read",
+		},
+		"refresh": {
+			"digitalocean.operations":   "1",
+			"digitalocean.api.requests": "1",
+			"digitalocean.api.retries":  "1",
+		},
+	}
+
+	for name, attrs := range expected {
+		s, ok := byName[name]
+		if !ok {
+			t.Errorf("expected span %s to be exported, got %v", name, spans)
+			continue
+		}
+
+		for k, v := range attrs {
+			if actual := attributeString(s, k); actual != v {
+				t.Errorf("expected %s of span %s to be %q, got %q", k, name, v, actual)
+			}
+		}
+	}
+
+	if s := byName["refresh"]; s.ParentSpanID != "" {
+		t.Errorf("expected the refresh span to be the root span, got parent %s", s.ParentSpanID)
+	}
+	if s := byName["GET droplets"]; s.ParentSpanID != r.rootSpanID {
+		t.Errorf("expected the request span to be a child of the refresh span, got parent %s", s.ParentSpanID)
+	}
+}
+
+func TestRecorderSummary(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		names []string
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req exportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid export request: %s", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					names = append(names, s.Name)
+				}
+			}
+		}
+	}))
+	defer collector.Close()
+
+	// Nothing is exported by a provider that did no work, e.g. validating.
+	New(collector.URL, "1.5.0")
+
+	r := New(collector.URL, "1.5.0")
+	r.StartOperation("digitalocean_droplet", "read")(false)
+	r.StartOperation("digitalocean_droplet", "update")(false)
+
+	Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	expected := []string{"digitalocean_droplet.read", "digitalocean_droplet.update", "apply"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected spans %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected spans %v, got %v", expected, names)
+		}
+	}
+}
+
+func TestRecorderDisabledOnExportFailure(t *testing.T) {
+	exports := 0
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exports++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer collector.Close()
+
+	r := New(collector.URL, "1.5.0")
+	r.StartOperation("digitalocean_droplet", "create")(false)
+	r.StartOperation("digitalocean_droplet", "create")(false)
+
+	Shutdown()
+
+	if exports != 1 {
+		t.Errorf("expected a single export attempt, got %d", exports)
+	}
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+
+	client := &http.Client{}
+	r.Instrument(client)
+	if client.Transport != nil {
+		t.Errorf("expected the client to not be instrumented")
+	}
+
+	r.StartOperation("digitalocean_droplet", "create")(true)
+}
+
+func attributeString(s span, key string) string {
+	for _, a := range s.Attributes {
+		if a.Key != key {
+			continue
+		}
+
+		switch {
+		case a.Value.StringValue != nil:
+			return *a.Value.StringValue
+		case a.Value.IntValue != nil:
+			return *a.Value.IntValue
+		}
+	}
+
+	return ""
+}
@@ -2,10 +2,14 @@ package main
 
 import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/telemetry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: digitalocean.Provider})
+
+	// Export the summary of the run to the metrics_endpoint, if any.
+	telemetry.Shutdown()
 }